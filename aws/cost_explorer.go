@@ -268,6 +268,30 @@ func getCEStartDateForGranularity(granularity string) time.Time {
 	return time.Now().AddDate(0, 0, -13)
 }
 
+// getCETimePeriodFromQuals returns the start and end of the time period to
+// query, narrowed by any period_start and period_end quals
+func getCETimePeriodFromQuals(d *plugin.QueryData, granularity string) (string, string) {
+	timeFormat := "2006-01-02"
+	if granularity == "HOURLY" {
+		timeFormat = "2006-01-02T15:04:05Z"
+	}
+	endTime := time.Now().Format(timeFormat)
+	startTime := getCEStartDateForGranularity(granularity).Format(timeFormat)
+
+	if d.Quals["period_start"] != nil {
+		for _, q := range d.Quals["period_start"].Quals {
+			startTime = q.Value.GetTimestampValue().AsTime().Format(timeFormat)
+		}
+	}
+	if d.Quals["period_end"] != nil {
+		for _, q := range d.Quals["period_end"].Quals {
+			endTime = q.Value.GetTimestampValue().AsTime().Format(timeFormat)
+		}
+	}
+
+	return startTime, endTime
+}
+
 type CEQuals struct {
 	// Quals stuff
 	SearchStartTime *timestamp.Timestamp
@@ -48,19 +48,39 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_account":                                                  tableAwsAccount(ctx),
 			"aws_account_alternate_contact":                                tableAwsAccountAlternateContact(ctx),
 			"aws_account_contact":                                          tableAwsAccountContact(ctx),
+			"aws_account_region":                                           tableAwsAccountRegion(ctx),
 			"aws_acm_certificate":                                          tableAwsAcmCertificate(ctx),
+			"aws_amp_rule_groups_namespace":                                tableAwsAMPRuleGroupsNamespace(ctx),
+			"aws_amp_scraper":                                              tableAwsAMPScraper(ctx),
+			"aws_amp_workspace":                                            tableAwsAMPWorkspace(ctx),
 			"aws_amplify_app":                                              tableAwsAmplifyApp(ctx),
+			"aws_amplify_branch":                                           tableAwsAmplifyBranch(ctx),
+			"aws_amplify_domain_association":                               tableAwsAmplifyDomainAssociation(ctx),
 			"aws_api_gateway_api_key":                                      tableAwsAPIGatewayAPIKey(ctx),
 			"aws_api_gateway_authorizer":                                   tableAwsAPIGatewayAuthorizer(ctx),
+			"aws_api_gateway_method":                                       tableAwsAPIGatewayMethod(ctx),
+			"aws_api_gateway_resource":                                     tableAwsAPIGatewayResource(ctx),
 			"aws_api_gateway_rest_api":                                     tableAwsAPIGatewayRestAPI(ctx),
 			"aws_api_gateway_stage":                                        tableAwsAPIGatewayStage(ctx),
 			"aws_api_gateway_usage_plan":                                   tableAwsAPIGatewayUsagePlan(ctx),
 			"aws_api_gatewayv2_api":                                        tableAwsAPIGatewayV2Api(ctx),
 			"aws_api_gatewayv2_domain_name":                                tableAwsAPIGatewayV2DomainName(ctx),
 			"aws_api_gatewayv2_integration":                                tableAwsAPIGatewayV2Integration(ctx),
+			"aws_api_gatewayv2_route":                                      tableAwsAPIGatewayV2Route(ctx),
 			"aws_api_gatewayv2_stage":                                      tableAwsAPIGatewayV2Stage(ctx),
+			"aws_api_gatewayv2_vpc_link":                                   tableAwsAPIGatewayV2VpcLink(ctx),
 			"aws_appautoscaling_target":                                    tableAwsAppAutoScalingTarget(ctx),
 			"aws_appconfig_application":                                    tableAwsAppConfigApplication(ctx),
+			"aws_appflow_connector_profile":                                tableAwsAppFlowConnectorProfile(ctx),
+			"aws_appflow_flow":                                             tableAwsAppFlowFlow(ctx),
+			"aws_appmesh_mesh":                                             tableAwsAppMeshMesh(ctx),
+			"aws_appmesh_route":                                            tableAwsAppMeshRoute(ctx),
+			"aws_appmesh_virtual_node":                                     tableAwsAppMeshVirtualNode(ctx),
+			"aws_appmesh_virtual_service":                                  tableAwsAppMeshVirtualService(ctx),
+			"aws_appsync_data_source":                                      tableAwsAppSyncDataSource(ctx),
+			"aws_appsync_graphql_api":                                      tableAwsAppSyncGraphQLApi(ctx),
+			"aws_appsync_resolver":                                         tableAwsAppSyncResolver(ctx),
+			"aws_athena_query":                                             tableAwsAthenaQuery(ctx),
 			"aws_auditmanager_assessment":                                  tableAwsAuditManagerAssessment(ctx),
 			"aws_auditmanager_control":                                     tableAwsAuditManagerControl(ctx),
 			"aws_auditmanager_evidence":                                    tableAwsAuditManagerEvidence(ctx),
@@ -68,45 +88,85 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_auditmanager_framework":                                   tableAwsAuditManagerFramework(ctx),
 			"aws_availability_zone":                                        tableAwsAvailabilityZone(ctx),
 			"aws_backup_framework":                                         tableAwsBackupFramework(ctx),
+			"aws_backup_framework_control":                                 tableAwsBackupFrameworkControl(ctx),
 			"aws_backup_legal_hold":                                        tableAwsBackupLegalHold(ctx),
 			"aws_backup_plan":                                              tableAwsBackupPlan(ctx),
 			"aws_backup_protected_resource":                                tableAwsBackupProtectedResource(ctx),
 			"aws_backup_recovery_point":                                    tableAwsBackupRecoveryPoint(ctx),
+			"aws_backup_report_plan":                                       tableAwsBackupReportPlan(ctx),
+			"aws_backup_restore_testing_plan":                              tableAwsBackupRestoreTestingPlan(ctx),
+			"aws_backup_restore_testing_selection":                         tableAwsBackupRestoreTestingSelection(ctx),
 			"aws_backup_selection":                                         tableAwsBackupSelection(ctx),
 			"aws_backup_vault":                                             tableAwsBackupVault(ctx),
+			"aws_billingconductor_billing_group":                           tableAwsBillingConductorBillingGroup(ctx),
+			"aws_billingconductor_custom_line_item":                        tableAwsBillingConductorCustomLineItem(ctx),
+			"aws_billingconductor_pricing_plan":                            tableAwsBillingConductorPricingPlan(ctx),
+			"aws_billingconductor_pricing_rule":                            tableAwsBillingConductorPricingRule(ctx),
+			"aws_budgets_action":                                           tableAwsBudgetsAction(ctx),
+			"aws_cloud9_environment":                                       tableAwsCloud9Environment(ctx),
 			"aws_cloudcontrol_resource":                                    tableAwsCloudControlResource(ctx),
 			"aws_cloudformation_stack":                                     tableAwsCloudFormationStack(ctx),
+			"aws_cloudformation_stack_resource_drift":                      tableAwsCloudFormationStackResourceDrift(ctx),
+			"aws_cloudformation_stack_set_instance":                        tableAwsCloudFormationStackSetInstance(ctx),
+			"aws_cloudformation_stack_set_operation":                       tableAwsCloudFormationStackSetOperation(ctx),
 			"aws_cloudfront_cache_policy":                                  tableAwsCloudFrontCachePolicy(ctx),
+			"aws_cloudfront_continuous_deployment_policy":                  tableAwsCloudFrontContinuousDeploymentPolicy(ctx),
 			"aws_cloudfront_distribution":                                  tableAwsCloudFrontDistribution(ctx),
 			"aws_cloudfront_function":                                      tableAwsCloudFrontFunction(ctx),
+			"aws_cloudfront_key_value_store":                               tableAwsCloudFrontKeyValueStore(ctx),
+			"aws_cloudfront_key_value_store_key":                           tableAwsCloudFrontKeyValueStoreKey(ctx),
+			"aws_cloudfront_origin_access_control":                         tableAwsCloudFrontOriginAccessControl(ctx),
 			"aws_cloudfront_origin_access_identity":                        tableAwsCloudFrontOriginAccessIdentity(ctx),
 			"aws_cloudfront_origin_request_policy":                         tableAwsCloudFrontOriginRequestPolicy(ctx),
+			"aws_cloudfront_realtime_log_config":                           tableAwsCloudFrontRealtimeLogConfig(ctx),
 			"aws_cloudfront_response_headers_policy":                       tableAwsCloudFrontResponseHeadersPolicy(ctx),
 			"aws_cloudsearch_domain":                                       tableAwsCloudSearchDomain(ctx),
 			"aws_cloudtrail_event_data_store":                              tableAwsCloudtrailEventDataStore(ctx),
 			"aws_cloudtrail_trail":                                         tableAwsCloudtrailTrail(ctx),
 			"aws_cloudtrail_trail_event":                                   tableAwsCloudtrailTrailEvent(ctx),
 			"aws_cloudwatch_alarm":                                         tableAwsCloudWatchAlarm(ctx),
+			"aws_cloudwatch_anomaly_detector":                              tableAwsCloudWatchAnomalyDetector(ctx),
 			"aws_cloudwatch_log_event":                                     tableAwsCloudwatchLogEvent(ctx),
 			"aws_cloudwatch_log_group":                                     tableAwsCloudwatchLogGroup(ctx),
+			"aws_cloudwatch_log_insights_query":                            tableAwsCloudWatchLogInsightsQuery(ctx),
 			"aws_cloudwatch_log_metric_filter":                             tableAwsCloudwatchLogMetricFilter(ctx),
 			"aws_cloudwatch_log_resource_policy":                           tableAwsCloudwatchLogResourcePolicy(ctx),
 			"aws_cloudwatch_log_stream":                                    tableAwsCloudwatchLogStream(ctx),
 			"aws_cloudwatch_log_subscription_filter":                       tableAwsCloudwatchLogSubscriptionFilter(ctx),
 			"aws_cloudwatch_metric":                                        tableAwsCloudWatchMetric(ctx),
+			"aws_cloudwatch_metric_data":                                   tableAwsCloudWatchMetricData(ctx),
+			"aws_cloudwatch_metric_stream":                                 tableAwsCloudWatchMetricStream(ctx),
+			"aws_cloudwatch_synthetics_canary_run":                         tableAwsCloudWatchSyntheticsCanaryRun(ctx),
 			"aws_codeartifact_domain":                                      tableAwsCodeArtifactDomain(ctx),
+			"aws_codeartifact_package":                                     tableAwsCodeArtifactPackage(ctx),
+			"aws_codeartifact_package_version":                             tableAwsCodeArtifactPackageVersion(ctx),
 			"aws_codeartifact_repository":                                  tableAwsCodeArtifactRepository(ctx),
+			"aws_codebuild_build":                                          tableAwsCodeBuildBuild(ctx),
 			"aws_codebuild_project":                                        tableAwsCodeBuildProject(ctx),
 			"aws_codebuild_source_credential":                              tableAwsCodeBuildSourceCredential(ctx),
+			"aws_codecommit_branch":                                        tableAwsCodeCommitBranch(ctx),
+			"aws_codecommit_pull_request":                                  tableAwsCodeCommitPullRequest(ctx),
 			"aws_codecommit_repository":                                    tableAwsCodeCommitRepository(ctx),
 			"aws_codedeploy_app":                                           tableAwsCodeDeployApplication(ctx),
+			"aws_codedeploy_deployment":                                    tableAwsCodeDeployDeployment(ctx),
+			"aws_codeguruprofiler_profiling_group":                         tableAwsCodeGuruProfilerProfilingGroup(ctx),
+			"aws_codegurureviewer_repository_association":                  tableAwsCodeGuruReviewerRepositoryAssociation(ctx),
+			"aws_codepipeline_action_execution":                            tableAwsCodepipelineActionExecution(ctx),
 			"aws_codepipeline_pipeline":                                    tableAwsCodepipelinePipeline(ctx),
+			"aws_codepipeline_pipeline_execution":                          tableAwsCodepipelinePipelineExecution(ctx),
+			"aws_computeoptimizer_asg_recommendation":                      tableAwsComputeOptimizerAsgRecommendation(ctx),
+			"aws_computeoptimizer_ebs_recommendation":                      tableAwsComputeOptimizerEbsRecommendation(ctx),
+			"aws_computeoptimizer_ec2_recommendation":                      tableAwsComputeOptimizerEc2Recommendation(ctx),
+			"aws_computeoptimizer_lambda_recommendation":                   tableAwsComputeOptimizerLambdaRecommendation(ctx),
 			"aws_config_aggregate_authorization":                           tableAwsConfigAggregateAuthorization(ctx),
 			"aws_config_configuration_recorder":                            tableAwsConfigConfigurationRecorder(ctx),
 			"aws_config_conformance_pack":                                  tableAwsConfigConformancePack(ctx),
 			"aws_config_rule":                                              tableAwsConfigRule(ctx),
+			"aws_cost_allocation_tag":                                      tableAwsCostAllocationTag(ctx),
+			"aws_cost_allocation_tag_backfill_history":                     tableAwsCostAllocationTagBackfillHistory(ctx),
 			"aws_cost_by_account_daily":                                    tableAwsCostByLinkedAccountDaily(ctx),
 			"aws_cost_by_account_monthly":                                  tableAwsCostByLinkedAccountMonthly(ctx),
+			"aws_cost_by_dimension":                                        tableAwsCostByDimension(ctx),
 			"aws_cost_by_record_type_daily":                                tableAwsCostByRecordTypeDaily(ctx),
 			"aws_cost_by_record_type_monthly":                              tableAwsCostByRecordTypeMonthly(ctx),
 			"aws_cost_by_service_daily":                                    tableAwsCostByServiceDaily(ctx),
@@ -116,18 +176,35 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_cost_forecast_daily":                                      tableAwsCostForecastDaily(ctx),
 			"aws_cost_forecast_monthly":                                    tableAwsCostForecastMonthly(ctx),
 			"aws_cost_usage":                                               tableAwsCostAndUsage(ctx),
+			"aws_cur_report_definition":                                    tableAwsCurReportDefinition(ctx),
+			"aws_dataexchange_data_set":                                    tableAwsDataExchangeDataSet(ctx),
+			"aws_dataexchange_job":                                         tableAwsDataExchangeJob(ctx),
+			"aws_dataexchange_revision":                                    tableAwsDataExchangeRevision(ctx),
+			"aws_datapipeline_pipeline":                                    tableAwsDataPipelinePipeline(ctx),
+			"aws_datasync_location":                                        tableAwsDataSyncLocation(ctx),
+			"aws_datasync_task":                                            tableAwsDataSyncTask(ctx),
+			"aws_datasync_task_execution":                                  tableAwsDataSyncTaskExecution(ctx),
 			"aws_dax_cluster":                                              tableAwsDaxCluster(ctx),
 			"aws_dax_parameter":                                            tableAwsDaxParameter(ctx),
 			"aws_dax_parameter_group":                                      tableAwsDaxParameterGroup(ctx),
 			"aws_dax_subnet_group":                                         tableAwsDaxSubnetGroup(ctx),
+			"aws_devopsguru_insight":                                       tableAwsDevOpsGuruInsight(ctx),
+			"aws_devopsguru_recommendation":                                tableAwsDevOpsGuruRecommendation(ctx),
 			"aws_directory_service_directory":                              tableAwsDirectoryServiceDirectory(ctx),
+			"aws_discovery_agent":                                          tableAwsDiscoveryAgent(ctx),
+			"aws_discovery_server":                                         tableAwsDiscoveryServer(ctx),
 			"aws_dlm_lifecycle_policy":                                     tableAwsDLMLifecyclePolicy(ctx),
 			"aws_dms_replication_instance":                                 tableAwsDmsReplicationInstance(ctx),
 			"aws_docdb_cluster":                                            tableAwsDocDBCluster(ctx),
+			"aws_docdb_elastic_cluster":                                    tableAwsDocDBElasticCluster(ctx),
+			"aws_docdb_elastic_cluster_snapshot":                           tableAwsDocDBElasticClusterSnapshot(ctx),
 			"aws_dynamodb_backup":                                          tableAwsDynamoDBBackup(ctx),
 			"aws_dynamodb_global_table":                                    tableAwsDynamoDBGlobalTable(ctx),
+			"aws_dynamodb_import":                                          tableAwsDynamoDBImport(ctx),
+			"aws_dynamodb_item":                                            tableAwsDynamoDBItem(ctx),
 			"aws_dynamodb_metric_account_provisioned_read_capacity_util":   tableAwsDynamoDBMetricAccountProvisionedReadCapacityUtilization(ctx),
 			"aws_dynamodb_metric_account_provisioned_write_capacity_util":  tableAwsDynamoDBMetricAccountProvisionedWriteCapacityUtilization(ctx),
+			"aws_dynamodb_stream":                                          tableAwsDynamoDBStream(ctx),
 			"aws_dynamodb_table":                                           tableAwsDynamoDBTable(ctx),
 			"aws_dynamodb_table_export":                                    tableAwsDynamoDBTableExport(ctx),
 			"aws_ebs_snapshot":                                             tableAwsEBSSnapshot(ctx),
@@ -167,6 +244,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_ec2_ssl_policy":                                           tableAwsEc2SslPolicy(ctx),
 			"aws_ec2_target_group":                                         tableAwsEc2TargetGroup(ctx),
 			"aws_ec2_transit_gateway":                                      tableAwsEc2TransitGateway(ctx),
+			"aws_ec2_transit_gateway_connect":                              tableAwsEc2TransitGatewayConnect(ctx),
+			"aws_ec2_transit_gateway_peering_attachment":                   tableAwsEc2TransitGatewayPeeringAttachment(ctx),
+			"aws_ec2_transit_gateway_policy_table":                         tableAwsEc2TransitGatewayPolicyTable(ctx),
 			"aws_ec2_transit_gateway_route":                                tableAwsEc2TransitGatewayRoute(ctx),
 			"aws_ec2_transit_gateway_route_table":                          tableAwsEc2TransitGatewayRouteTable(ctx),
 			"aws_ec2_transit_gateway_vpc_attachment":                       tableAwsEc2TransitGatewayVpcAttachment(ctx),
@@ -185,6 +265,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_efs_access_point":                                         tableAwsEfsAccessPoint(ctx),
 			"aws_efs_file_system":                                          tableAwsElasticFileSystem(ctx),
 			"aws_efs_mount_target":                                         tableAwsEfsMountTarget(ctx),
+			"aws_efs_replication_configuration":                            tableAwsEfsReplicationConfiguration(ctx),
 			"aws_eks_addon":                                                tableAwsEksAddon(ctx),
 			"aws_eks_addon_version":                                        tableAwsEksAddonVersion(ctx),
 			"aws_eks_cluster":                                              tableAwsEksCluster(ctx),
@@ -194,6 +275,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_elastic_beanstalk_application":                            tableAwsElasticBeanstalkApplication(ctx),
 			"aws_elastic_beanstalk_environment":                            tableAwsElasticBeanstalkEnvironment(ctx),
 			"aws_elasticache_cluster":                                      tableAwsElastiCacheCluster(ctx),
+			"aws_elasticache_global_replication_group":                     tableAwsElastiCacheGlobalReplicationGroup(ctx),
 			"aws_elasticache_parameter_group":                              tableAwsElastiCacheParameterGroup(ctx),
 			"aws_elasticache_redis_metric_cache_hits_hourly":               tableAwsElasticacheRedisMetricCacheHitsHourly(ctx),
 			"aws_elasticache_redis_metric_curr_connections_hourly":         tableAwsElasticacheRedisMetricCurrConnectionsHourly(ctx),
@@ -204,6 +286,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_elasticache_redis_metric_new_connections_hourly":          tableAwsElasticacheRedisMetricNewConnectionsHourly(ctx),
 			"aws_elasticache_replication_group":                            tableAwsElastiCacheReplicationGroup(ctx),
 			"aws_elasticache_reserved_cache_node":                          tableAwsElastiCacheReservedCacheNode(ctx),
+			"aws_elasticache_serverless_cache":                             tableAwsElastiCacheServerlessCache(ctx),
 			"aws_elasticache_subnet_group":                                 tableAwsElastiCacheSubnetGroup(ctx),
 			"aws_elasticsearch_domain":                                     tableAwsElasticsearchDomain(ctx),
 			"aws_emr_cluster":                                              tableAwsEmrCluster(ctx),
@@ -213,9 +296,18 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_emr_instance_group":                                       tableAwsEmrInstanceGroup(ctx),
 			"aws_eventbridge_bus":                                          tableAwsEventBridgeBus(ctx),
 			"aws_eventbridge_rule":                                         tableAwsEventBridgeRule(ctx),
+			"aws_evidently_feature":                                        tableAwsEvidentlyFeature(ctx),
+			"aws_evidently_launch":                                         tableAwsEvidentlyLaunch(ctx),
+			"aws_evidently_project":                                        tableAwsEvidentlyProject(ctx),
+			"aws_fsx_backup":                                               tableAwsFsxBackup(ctx),
 			"aws_fsx_file_system":                                          tableAwsFsxFileSystem(ctx),
+			"aws_fsx_snapshot":                                             tableAwsFsxSnapshot(ctx),
+			"aws_fsx_volume":                                               tableAwsFsxVolume(ctx),
 			"aws_glacier_vault":                                            tableAwsGlacierVault(ctx),
 			"aws_globalaccelerator_accelerator":                            tableAwsGlobalAcceleratorAccelerator(ctx),
+			"aws_globalaccelerator_custom_routing_accelerator":             tableAwsGlobalAcceleratorCustomRoutingAccelerator(ctx),
+			"aws_globalaccelerator_custom_routing_endpoint_group":          tableAwsGlobalAcceleratorCustomRoutingEndpointGroup(ctx),
+			"aws_globalaccelerator_endpoint":                               tableAwsGlobalAcceleratorEndpoint(ctx),
 			"aws_globalaccelerator_endpoint_group":                         tableAwsGlobalAcceleratorEndpointGroup(ctx),
 			"aws_globalaccelerator_listener":                               tableAwsGlobalAcceleratorListener(ctx),
 			"aws_glue_catalog_database":                                    tableAwsGlueCatalogDatabase(ctx),
@@ -223,9 +315,15 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_glue_connection":                                          tableAwsGlueConnection(ctx),
 			"aws_glue_crawler":                                             tableAwsGlueCrawler(ctx),
 			"aws_glue_data_catalog_encryption_settings":                    tableAwsGlueDataCatalogEncryptionSettings(ctx),
+			"aws_glue_data_quality_ruleset":                                tableAwsGlueDataQualityRuleset(ctx),
 			"aws_glue_dev_endpoint":                                        tableAwsGlueDevEndpoint(ctx),
 			"aws_glue_job":                                                 tableAwsGlueJob(ctx),
 			"aws_glue_security_configuration":                              tableAwsGlueSecurityConfiguration(ctx),
+			"aws_glue_table_optimizer":                                     tableAwsGlueTableOptimizer(ctx),
+			"aws_grafana_workspace":                                        tableAwsGrafanaWorkspace(ctx),
+			"aws_greengrassv2_component":                                   tableAwsGreengrassV2Component(ctx),
+			"aws_greengrassv2_core_device":                                 tableAwsGreengrassV2CoreDevice(ctx),
+			"aws_greengrassv2_deployment":                                  tableAwsGreengrassV2Deployment(ctx),
 			"aws_guardduty_detector":                                       tableAwsGuardDutyDetector(ctx),
 			"aws_guardduty_filter":                                         tableAwsGuardDutyFilter(ctx),
 			"aws_guardduty_finding":                                        tableAwsGuardDutyFinding(ctx),
@@ -233,7 +331,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_guardduty_member":                                         tableAwsGuardDutyMember(ctx),
 			"aws_guardduty_publishing_destination":                         tableAwsGuardDutyPublishingDestination(ctx),
 			"aws_guardduty_threat_intel_set":                               tableAwsGuardDutyThreatIntelSet(ctx),
+			"aws_health_affected_entity":                                   tableAwsHealthAffectedEntity(ctx),
 			"aws_health_event":                                             tableAwsHealthEvent(ctx),
+			"aws_health_organization_event":                                tableAwsHealthOrganizationEvent(ctx),
 			"aws_iam_access_advisor":                                       tableAwsIamAccessAdvisor(ctx),
 			"aws_iam_access_key":                                           tableAwsIamAccessKey(ctx),
 			"aws_iam_account_password_policy":                              tableAwsIamAccountPasswordPolicy(ctx),
@@ -257,13 +357,26 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_inspector_assessment_template":                            tableAwsInspectorAssessmentTemplate(ctx),
 			"aws_inspector_exclusion":                                      tableAwsInspectorExclusion(ctx),
 			"aws_inspector_finding":                                        tableAwsInspectorFinding(ctx),
+			"aws_internetmonitor_health_event":                             tableAwsInternetMonitorHealthEvent(ctx),
+			"aws_internetmonitor_monitor":                                  tableAwsInternetMonitorMonitor(ctx),
+			"aws_iot_certificate":                                          tableAwsIoTCertificate(ctx),
+			"aws_iot_policy":                                               tableAwsIoTPolicy(ctx),
+			"aws_iot_thing":                                                tableAwsIoTThing(ctx),
+			"aws_iot_thing_group":                                          tableAwsIoTThingGroup(ctx),
+			"aws_ivs_channel":                                              tableAwsIVSChannel(ctx),
+			"aws_ivs_recording_configuration":                              tableAwsIVSRecordingConfiguration(ctx),
+			"aws_ivs_stream_key":                                           tableAwsIVSStreamKey(ctx),
 			"aws_kinesis_consumer":                                         tableAwsKinesisConsumer(ctx),
 			"aws_kinesis_firehose_delivery_stream":                         tableAwsKinesisFirehoseDeliveryStream(ctx),
 			"aws_kinesis_stream":                                           tableAwsKinesisStream(ctx),
 			"aws_kinesis_video_stream":                                     tableAwsKinesisVideoStream(ctx),
 			"aws_kinesisanalyticsv2_application":                           tableAwsKinesisAnalyticsV2Application(ctx),
+			"aws_kinesisanalyticsv2_application_version":                   tableAwsKinesisAnalyticsV2ApplicationVersion(ctx),
 			"aws_kms_key":                                                  tableAwsKmsKey(ctx),
 			"aws_kms_alias":                                                tableAwsKmsAlias(ctx),
+			"aws_lakeformation_lf_tag":                                     tableAwsLakeFormationLFTag(ctx),
+			"aws_lakeformation_permission":                                 tableAwsLakeFormationPermission(ctx),
+			"aws_lakeformation_resource":                                   tableAwsLakeFormationResource(ctx),
 			"aws_lambda_alias":                                             tableAwsLambdaAlias(ctx),
 			"aws_lambda_function":                                          tableAwsLambdaFunction(ctx),
 			"aws_lambda_function_metric_duration_daily":                    tableAwsLambdaFunctionMetricDurationDaily(ctx),
@@ -272,26 +385,63 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_lambda_layer":                                             tableAwsLambdaLayer(ctx),
 			"aws_lambda_layer_version":                                     tableAwsLambdaLayerVersion(ctx),
 			"aws_lambda_version":                                           tableAwsLambdaVersion(ctx),
+			"aws_licensemanager_grant":                                     tableAwsLicenseManagerGrant(ctx),
+			"aws_licensemanager_license":                                   tableAwsLicenseManagerLicense(ctx),
+			"aws_licensemanager_license_configuration":                     tableAwsLicenseManagerLicenseConfiguration(ctx),
 			"aws_lightsail_instance":                                       tableAwsLightsailInstance(ctx),
 			"aws_macie2_classification_job":                                tableAwsMacie2ClassificationJob(ctx),
+			"aws_marketplace_agreement":                                    tableAwsMarketplaceAgreement(ctx),
+			"aws_marketplace_entitlement":                                  tableAwsMarketplaceEntitlement(ctx),
 			"aws_media_store_container":                                    tableAwsMediaStoreContainer(ctx),
+			"aws_mediaconvert_job_template":                                tableAwsMediaConvertJobTemplate(ctx),
+			"aws_mediaconvert_queue":                                       tableAwsMediaConvertQueue(ctx),
+			"aws_medialive_channel":                                        tableAwsMediaLiveChannel(ctx),
+			"aws_medialive_input":                                          tableAwsMediaLiveInput(ctx),
+			"aws_mediapackage_channel":                                     tableAwsMediaPackageChannel(ctx),
+			"aws_mediapackage_origin_endpoint":                             tableAwsMediaPackageOriginEndpoint(ctx),
+			"aws_memorydb_acl":                                             tableAwsMemoryDBACL(ctx),
+			"aws_memorydb_cluster":                                         tableAwsMemoryDBCluster(ctx),
+			"aws_memorydb_user":                                            tableAwsMemoryDBUser(ctx),
+			"aws_migrationhub_migration_task":                              tableAwsMigrationHubMigrationTask(ctx),
+			"aws_mq_broker_configuration_revision":                         tableAwsMQBrokerConfigurationRevision(ctx),
 			"aws_msk_cluster":                                              tableAwsMSKCluster(ctx),
 			"aws_msk_serverless_cluster":                                   tableAwsMSKServerlessCluster(ctx),
+			"aws_mskconnect_connector":                                     tableAwsMSKConnectConnector(ctx),
+			"aws_mskconnect_custom_plugin":                                 tableAwsMSKConnectCustomPlugin(ctx),
+			"aws_mskconnect_worker_configuration":                          tableAwsMSKConnectWorkerConfiguration(ctx),
 			"aws_neptune_db_cluster":                                       tableAwsNeptuneDBCluster(ctx),
+			"aws_neptune_db_cluster_snapshot":                              tableAwsNeptuneDBClusterSnapshot(ctx),
+			"aws_neptune_db_instance":                                      tableAwsNeptuneDBInstance(ctx),
 			"aws_networkfirewall_firewall_policy":                          tableAwsNetworkFirewallPolicy(ctx),
 			"aws_networkfirewall_rule_group":                               tableAwsNetworkFirewallRuleGroup(ctx),
+			"aws_networkmanager_attachment":                                tableAwsNetworkManagerAttachment(ctx),
+			"aws_networkmanager_core_network":                              tableAwsNetworkManagerCoreNetwork(ctx),
+			"aws_networkmanager_global_network":                            tableAwsNetworkManagerGlobalNetwork(ctx),
 			"aws_opensearch_domain":                                        tableAwsOpenSearchDomain(ctx),
 			"aws_organizations_account":                                    tableAwsOrganizationsAccount(ctx),
+			"aws_organizations_delegated_administrator":                    tableAwsOrganizationsDelegatedAdministrator(ctx),
+			"aws_organizations_delegated_service":                          tableAwsOrganizationsDelegatedService(ctx),
 			"aws_pinpoint_app":                                             tableAwsPinpointApp(ctx),
+			"aws_pipes_pipe":                                               tableAwsPipesPipe(ctx),
 			"aws_pricing_product":                                          tableAwsPricingProduct(ctx),
 			"aws_pricing_service_attribute":                                tableAwsPricingServiceAttribute(ctx),
+			"aws_qldb_journal_s3_export":                                   tableAwsQLDBJournalS3Export(ctx),
+			"aws_qldb_ledger":                                              tableAwsQLDBLedger(ctx),
+			"aws_quicksight_dashboard":                                     tableAwsQuickSightDashboard(ctx),
+			"aws_quicksight_data_set":                                      tableAwsQuickSightDataSet(ctx),
+			"aws_quicksight_data_source":                                   tableAwsQuickSightDataSource(ctx),
+			"aws_quicksight_user":                                          tableAwsQuickSightUser(ctx),
 			"aws_ram_principal_association":                                tableAwsRAMPrincipalAssociation(ctx),
 			"aws_ram_resource_association":                                 tableAwsRAMResourceAssociation(ctx),
+			"aws_rds_blue_green_deployment":                                tableAwsRDSBlueGreenDeployment(ctx),
 			"aws_rds_db_cluster":                                           tableAwsRDSDBCluster(ctx),
+			"aws_rds_db_cluster_endpoint":                                  tableAwsRDSDBClusterEndpoint(ctx),
 			"aws_rds_db_cluster_parameter_group":                           tableAwsRDSDBClusterParameterGroup(ctx),
 			"aws_rds_db_cluster_snapshot":                                  tableAwsRDSDBClusterSnapshot(ctx),
 			"aws_rds_db_event_subscription":                                tableAwsRDSDBEventSubscription(ctx),
+			"aws_rds_db_global_cluster":                                    tableAwsRDSDBGlobalCluster(ctx),
 			"aws_rds_db_instance":                                          tableAwsRDSDBInstance(ctx),
+			"aws_rds_db_instance_automated_backup":                         tableAwsRDSDBInstanceAutomatedBackup(ctx),
 			"aws_rds_db_instance_metric_connections":                       tableAwsRdsInstanceMetricConnections(ctx),
 			"aws_rds_db_instance_metric_connections_daily":                 tableAwsRdsInstanceMetricConnectionsDaily(ctx),
 			"aws_rds_db_instance_metric_connections_hourly":                tableAwsRdsInstanceMetricConnectionsHourly(ctx),
@@ -307,16 +457,22 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_rds_db_option_group":                                      tableAwsRDSDBOptionGroup(ctx),
 			"aws_rds_db_parameter_group":                                   tableAwsRDSDBParameterGroup(ctx),
 			"aws_rds_db_proxy":                                             tableAwsRDSDBProxy(ctx),
+			"aws_rds_db_proxy_target":                                      tableAwsRDSDBProxyTarget(ctx),
+			"aws_rds_db_proxy_target_group":                                tableAwsRDSDBProxyTargetGroup(ctx),
 			"aws_rds_db_snapshot":                                          tableAwsRDSDBSnapshot(ctx),
 			"aws_rds_db_subnet_group":                                      tableAwsRDSDBSubnetGroup(ctx),
+			"aws_rds_recommendation":                                       tableAwsRDSRecommendation(ctx),
 			"aws_rds_reserved_db_instance":                                 tableAwsRDSReservedDBInstance(ctx),
 			"aws_redshift_cluster":                                         tableAwsRedshiftCluster(ctx),
 			"aws_redshift_cluster_metric_cpu_utilization_daily":            tableAwsRedshiftClusterMetricCpuUtilizationDaily(ctx),
+			"aws_redshift_data_statement":                                  tableAwsRedshiftDataStatement(ctx),
 			"aws_redshift_event_subscription":                              tableAwsRedshiftEventSubscription(ctx),
 			"aws_redshift_parameter_group":                                 tableAwsRedshiftParameterGroup(ctx),
 			"aws_redshift_snapshot":                                        tableAwsRedshiftSnapshot(ctx),
 			"aws_redshift_subnet_group":                                    tableAwsRedshiftSubnetGroup(ctx),
 			"aws_redshiftserverless_namespace":                             tableAwsRedshiftServerlessNamespace(ctx),
+			"aws_redshiftserverless_snapshot":                              tableAwsRedshiftServerlessSnapshot(ctx),
+			"aws_redshiftserverless_usage_limit":                           tableAwsRedshiftServerlessUsageLimit(ctx),
 			"aws_redshiftserverless_workgroup":                             tableAwsRedshiftServerlessWorkgroup(ctx),
 			"aws_region":                                                   tableAwsRegion(ctx),
 			"aws_resource_explorer_index":                                  tableAWSResourceExplorerIndex(ctx),
@@ -326,10 +482,14 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_route53_health_check":                                     tableAwsRoute53HealthCheck(ctx),
 			"aws_route53_record":                                           tableAwsRoute53Record(ctx),
 			"aws_route53_resolver_endpoint":                                tableAwsRoute53ResolverEndpoint(ctx),
+			"aws_route53_resolver_firewall_rule":                           tableAwsRoute53ResolverFirewallRule(ctx),
+			"aws_route53_resolver_firewall_rule_group":                     tableAwsRoute53ResolverFirewallRuleGroup(ctx),
+			"aws_route53_resolver_query_log_config":                        tableAwsRoute53ResolverQueryLogConfig(ctx),
 			"aws_route53_resolver_rule":                                    tableAwsRoute53ResolverRule(ctx),
 			"aws_route53_traffic_policy":                                   tableAwsRoute53TrafficPolicy(ctx),
 			"aws_route53_traffic_policy_instance":                          tableAwsRoute53TrafficPolicyInstance(ctx),
 			"aws_route53_zone":                                             tableAwsRoute53Zone(ctx),
+			"aws_rum_app_monitor":                                          tableAwsRUMAppMonitor(ctx),
 			"aws_s3_access_point":                                          tableAwsS3AccessPoint(ctx),
 			"aws_s3_account_settings":                                      tableAwsS3AccountSettings(ctx),
 			"aws_s3_bucket":                                                tableAwsS3Bucket(ctx),
@@ -339,6 +499,13 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_sagemaker_model":                                          tableAwsSageMakerModel(ctx),
 			"aws_sagemaker_notebook_instance":                              tableAwsSageMakerNotebookInstance(ctx),
 			"aws_sagemaker_training_job":                                   tableAwsSageMakerTrainingJob(ctx),
+			"aws_savingsplan":                                              tableAwsSavingsPlan(ctx),
+			"aws_savingsplan_coverage":                                     tableAwsSavingsPlanCoverage(ctx),
+			"aws_savingsplan_utilization":                                  tableAwsSavingsPlanUtilization(ctx),
+			"aws_scheduler_schedule":                                       tableAwsSchedulerSchedule(ctx),
+			"aws_scheduler_schedule_group":                                 tableAwsSchedulerScheduleGroup(ctx),
+			"aws_schemas_registry":                                         tableAwsSchemasRegistry(ctx),
+			"aws_schemas_schema":                                           tableAwsSchemasSchema(ctx),
 			"aws_secretsmanager_secret":                                    tableAwsSecretsManagerSecret(ctx),
 			"aws_securityhub_action_target":                                tableAwsSecurityHubActionTarget(ctx),
 			"aws_securityhub_finding":                                      tableAwsSecurityHubFinding(ctx),
@@ -351,6 +518,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_securityhub_standards_subscription":                       tableAwsSecurityHubStandardsSubscription(ctx),
 			"aws_securitylake_subscriber":                                  tableAwsSecurityLakeSubscriber(ctx),
 			"aws_serverlessapplicationrepository_application":              tableAwsServerlessApplicationRepositoryApplication(ctx),
+			"aws_servicecatalog_launch_path":                               tableAwsServiceCatalogLaunchPath(ctx),
+			"aws_servicecatalog_provisioned_product":                       tableAwsServiceCatalogProvisionedProduct(ctx),
 			"aws_servicequotas_default_service_quota":                      tableAwsServiceQuotasDefaultServiceQuota(ctx),
 			"aws_servicequotas_service_quota":                              tableAwsServiceQuotasServiceQuota(ctx),
 			"aws_servicequotas_service_quota_change_request":               tableAwsServiceQuotasServiceQuotaChangeRequest(ctx),
@@ -359,21 +528,42 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_sfn_state_machine":                                        tableAwsStepFunctionsStateMachine(ctx),
 			"aws_sfn_state_machine_execution":                              tableAwsStepFunctionsStateMachineExecution(ctx),
 			"aws_sfn_state_machine_execution_history":                      tableAwsStepFunctionsStateMachineExecutionHistory(ctx),
+			"aws_sns_platform_application":                                 tableAwsSnsPlatformApplication(ctx),
+			"aws_sns_sms_sandbox_phone_number":                             tableAwsSnsSmsSandboxPhoneNumber(ctx),
+			"aws_sns_sms_settings":                                         tableAwsSnsSmsSettings(ctx),
 			"aws_sns_topic":                                                tableAwsSnsTopic(ctx),
 			"aws_sns_topic_subscription":                                   tableAwsSnsTopicSubscription(ctx),
 			"aws_sqs_queue":                                                tableAwsSqsQueue(ctx),
+			"aws_sqs_queue_message":                                        tableAwsSqsQueueMessage(ctx),
 			"aws_ssm_association":                                          tableAwsSSMAssociation(ctx),
+			"aws_ssm_automation_step_execution":                            tableAwsSSMAutomationStepExecution(ctx),
 			"aws_ssm_document":                                             tableAwsSSMDocument(ctx),
+			"aws_ssm_instance_patch":                                       tableAwsSSMInstancePatch(ctx),
+			"aws_ssm_instance_patch_state":                                 tableAwsSSMInstancePatchState(ctx),
 			"aws_ssm_inventory":                                            tableAwsSSMInventory(ctx),
 			"aws_ssm_maintenance_window":                                   tableAwsSSMMaintenanceWindow(ctx),
 			"aws_ssm_managed_instance":                                     tableAwsSSMManagedInstance(ctx),
 			"aws_ssm_managed_instance_compliance":                          tableAwsSSMManagedInstanceCompliance(ctx),
+			"aws_ssm_ops_item":                                             tableAwsSSMOpsItem(ctx),
 			"aws_ssm_parameter":                                            tableAwsSSMParameter(ctx),
 			"aws_ssm_patch_baseline":                                       tableAwsSSMPatchBaseline(ctx),
+			"aws_ssm_patch_group":                                          tableAwsSSMPatchGroup(ctx),
+			"aws_ssm_session":                                              tableAwsSSMSession(ctx),
 			"aws_ssoadmin_instance":                                        tableAwsSsoAdminInstance(ctx),
 			"aws_ssoadmin_managed_policy_attachment":                       tableAwsSsoAdminManagedPolicyAttachment(ctx),
 			"aws_ssoadmin_permission_set":                                  tableAwsSsoAdminPermissionSet(ctx),
+			"aws_storagegateway_file_share":                                tableAwsStorageGatewayFileShare(ctx),
+			"aws_storagegateway_gateway":                                   tableAwsStorageGatewayGateway(ctx),
+			"aws_storagegateway_volume":                                    tableAwsStorageGatewayVolume(ctx),
+			"aws_swf_activity_type":                                        tableAwsSWFActivityType(ctx),
+			"aws_swf_domain":                                               tableAwsSWFDomain(ctx),
+			"aws_swf_workflow_type":                                        tableAwsSWFWorkflowType(ctx),
 			"aws_tagging_resource":                                         tableAwsTaggingResource(ctx),
+			"aws_timestream_database":                                      tableAwsTimestreamDatabase(ctx),
+			"aws_timestream_scheduled_query":                               tableAwsTimestreamScheduledQuery(ctx),
+			"aws_timestream_table":                                         tableAwsTimestreamTable(ctx),
+			"aws_trustedadvisor_check":                                     tableAwsTrustedAdvisorCheck(ctx),
+			"aws_trustedadvisor_check_result":                              tableAwsTrustedAdvisorCheckResult(ctx),
 			"aws_vpc":                                                      tableAwsVpc(ctx),
 			"aws_vpc_customer_gateway":                                     tableAwsVpcCustomerGateway(ctx),
 			"aws_vpc_dhcp_options":                                         tableAwsVpcDhcpOptions(ctx),
@@ -381,9 +571,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_vpc_eip":                                                  tableAwsVpcEip(ctx),
 			"aws_vpc_endpoint":                                             tableAwsVpcEndpoint(ctx),
 			"aws_vpc_endpoint_service":                                     tableAwsVpcEndpointService(ctx),
+			"aws_vpc_endpoint_service_permission":                          tableAwsVpcEndpointServicePermission(ctx),
 			"aws_vpc_flow_log":                                             tableAwsVpcFlowlog(ctx),
 			"aws_vpc_flow_log_event":                                       tableAwsVpcFlowLogEvent(ctx),
 			"aws_vpc_internet_gateway":                                     tableAwsVpcInternetGateway(ctx),
+			"aws_vpc_managed_prefix_list_entry":                            tableAwsVpcManagedPrefixListEntry(ctx),
 			"aws_vpc_nat_gateway":                                          tableAwsVpcNatGateway(ctx),
 			"aws_vpc_network_acl":                                          tableAwsVpcNetworkACL(ctx),
 			"aws_vpc_peering_connection":                                   tableAwsVpcPeeringConnection(ctx),
@@ -405,6 +597,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"aws_wafv2_web_acl":                                            tableAwsWafv2WebAcl(ctx),
 			"aws_wellarchitected_workload":                                 tableAwsWellArchitectedWorkload(ctx),
 			"aws_workspaces_workspace":                                     tableAwsWorkspace(ctx),
+			"aws_xray_group":                                               tableAwsXRayGroup(ctx),
+			"aws_xray_sampling_rule":                                       tableAwsXRaySamplingRule(ctx),
+			"aws_xray_service_graph":                                       tableAwsXRayServiceGraph(ctx),
+			"aws_xray_trace_summary":                                       tableAwsXRayTraceSummary(ctx),
 		},
 	}
 
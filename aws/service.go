@@ -17,17 +17,27 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go-v2/service/account"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
 	"github.com/aws/aws-sdk-go-v2/service/amplify"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appflow"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationdiscoveryservice"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/cloud9"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
 	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
@@ -36,15 +46,25 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go-v2/service/codecommit"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	"github.com/aws/aws-sdk-go-v2/service/codegurureviewer"
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/aws/aws-sdk-go-v2/service/datapipeline"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	"github.com/aws/aws-sdk-go-v2/service/dax"
+	"github.com/aws/aws-sdk-go-v2/service/devopsguru"
 	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	"github.com/aws/aws-sdk-go-v2/service/dlm"
 	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
@@ -58,47 +78,74 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/evidently"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
 	"github.com/aws/aws-sdk-go-v2/service/fsx"
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/aws/aws-sdk-go-v2/service/health"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
 	"github.com/aws/aws-sdk-go-v2/service/inspector"
+	"github.com/aws/aws-sdk-go-v2/service/internetmonitor"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/ivs"
 	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
 	"github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2"
 	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceagreement"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceentitlementservice"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
 	"github.com/aws/aws-sdk-go-v2/service/mediastore"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	"github.com/aws/aws-sdk-go-v2/service/migrationhub"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/neptune"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
 	"github.com/aws/aws-sdk-go-v2/service/pricing"
-	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	"github.com/aws/aws-sdk-go-v2/service/ram"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
 	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/rum"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
 	"github.com/aws/aws-sdk-go-v2/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
@@ -106,57 +153,104 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
 	"github.com/aws/aws-sdk-go-v2/service/waf"
 	"github.com/aws/aws-sdk-go-v2/service/wafregional"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	amplifyEndpoint "github.com/aws/aws-sdk-go/service/amplify"
+	appflowEndpoint "github.com/aws/aws-sdk-go/service/appflow"
+	applicationdiscoveryserviceEndpoint "github.com/aws/aws-sdk-go/service/applicationdiscoveryservice"
+	appmeshEndpoint "github.com/aws/aws-sdk-go/service/appmesh"
+	appsyncEndpoint "github.com/aws/aws-sdk-go/service/appsync"
 	auditmanagerEndpoint "github.com/aws/aws-sdk-go/service/auditmanager"
 	backupEndpoint "github.com/aws/aws-sdk-go/service/backup"
+	cloud9Endpoint "github.com/aws/aws-sdk-go/service/cloud9"
 	cloudsearchEndpoint "github.com/aws/aws-sdk-go/service/cloudsearch"
+	evidentlyEndpoint "github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	rumEndpoint "github.com/aws/aws-sdk-go/service/cloudwatchrum"
 	codeartifactEndpoint "github.com/aws/aws-sdk-go/service/codeartifact"
 	codebuildEndpoint "github.com/aws/aws-sdk-go/service/codebuild"
 	codecommitEndpoint "github.com/aws/aws-sdk-go/service/codecommit"
+	codeguruprofilerEndpoint "github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	codegurureviewerEndpoint "github.com/aws/aws-sdk-go/service/codegurureviewer"
 	codepipelineEndpoint "github.com/aws/aws-sdk-go/service/codepipeline"
+	computeoptimizerEndpoint "github.com/aws/aws-sdk-go/service/computeoptimizer"
+	dataexchangeEndpoint "github.com/aws/aws-sdk-go/service/dataexchange"
+	datapipelineEndpoint "github.com/aws/aws-sdk-go/service/datapipeline"
+	datasyncEndpoint "github.com/aws/aws-sdk-go/service/datasync"
 	daxEndpoint "github.com/aws/aws-sdk-go/service/dax"
+	devopsguruEndpoint "github.com/aws/aws-sdk-go/service/devopsguru"
 	directoryserviceEndpoint "github.com/aws/aws-sdk-go/service/directoryservice"
 	dlmEndpoint "github.com/aws/aws-sdk-go/service/dlm"
 	dynamodbEndpoint "github.com/aws/aws-sdk-go/service/dynamodb"
+	dynamodbstreamsEndpoint "github.com/aws/aws-sdk-go/service/dynamodbstreams"
 	eksEndpoint "github.com/aws/aws-sdk-go/service/eks"
 	elasticbeanstalkEndpoint "github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	emrEndpoint "github.com/aws/aws-sdk-go/service/emr"
 	eventbridgeEndpoint "github.com/aws/aws-sdk-go/service/eventbridge"
 	fsxEndpoint "github.com/aws/aws-sdk-go/service/fsx"
 	glacierEndpoint "github.com/aws/aws-sdk-go/service/glacier"
+	greengrassv2Endpoint "github.com/aws/aws-sdk-go/service/greengrassv2"
 	inspectorEndpoint "github.com/aws/aws-sdk-go/service/inspector"
+	internetmonitorEndpoint "github.com/aws/aws-sdk-go/service/internetmonitor"
+	iotEndpoint "github.com/aws/aws-sdk-go/service/iot"
+	ivsEndpoint "github.com/aws/aws-sdk-go/service/ivs"
 	kafkaEndpoint "github.com/aws/aws-sdk-go/service/kafka"
+	kafkaconnectEndpoint "github.com/aws/aws-sdk-go/service/kafkaconnect"
 	kinesisanalyticsv2Endpoint "github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
 	kinesisvideoEndpoint "github.com/aws/aws-sdk-go/service/kinesisvideo"
 	kmsEndpoint "github.com/aws/aws-sdk-go/service/kms"
+	lakeformationEndpoint "github.com/aws/aws-sdk-go/service/lakeformation"
 	lambdaEndpoint "github.com/aws/aws-sdk-go/service/lambda"
+	licensemanagerEndpoint "github.com/aws/aws-sdk-go/service/licensemanager"
 	lightsailEndpoint "github.com/aws/aws-sdk-go/service/lightsail"
 	macie2Endpoint "github.com/aws/aws-sdk-go/service/macie2"
+	grafanaEndpoint "github.com/aws/aws-sdk-go/service/managedgrafana"
+	mediaconvertEndpoint "github.com/aws/aws-sdk-go/service/mediaconvert"
+	medialiveEndpoint "github.com/aws/aws-sdk-go/service/medialive"
+	mediapackageEndpoint "github.com/aws/aws-sdk-go/service/mediapackage"
 	mediastoreEndpoint "github.com/aws/aws-sdk-go/service/mediastore"
+	memorydbEndpoint "github.com/aws/aws-sdk-go/service/memorydb"
+	migrationhubEndpoint "github.com/aws/aws-sdk-go/service/migrationhub"
+	mqEndpoint "github.com/aws/aws-sdk-go/service/mq"
 	networkfirewallEndpoint "github.com/aws/aws-sdk-go/service/networkfirewall"
 	pinpointEndpoint "github.com/aws/aws-sdk-go/service/pinpoint"
+	ampEndpoint "github.com/aws/aws-sdk-go/service/prometheusservice"
+	qldbEndpoint "github.com/aws/aws-sdk-go/service/qldb"
+	quicksightEndpoint "github.com/aws/aws-sdk-go/service/quicksight"
 	redshiftserverlessEndpoint "github.com/aws/aws-sdk-go/service/redshiftserverless"
 	route53resolverEndpoint "github.com/aws/aws-sdk-go/service/route53resolver"
 	sagemakerEndpoint "github.com/aws/aws-sdk-go/service/sagemaker"
+	schemasEndpoint "github.com/aws/aws-sdk-go/service/schemas"
 	securityhubEndpoint "github.com/aws/aws-sdk-go/service/securityhub"
 	securitylakeEndpoint "github.com/aws/aws-sdk-go/service/securitylake"
 	serverlessrepoEndpoint "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	servicecatalogEndpoint "github.com/aws/aws-sdk-go/service/servicecatalog"
 	servicequotasEndpoint "github.com/aws/aws-sdk-go/service/servicequotas"
 	sesEndpoint "github.com/aws/aws-sdk-go/service/ses"
 	ssmEndpoint "github.com/aws/aws-sdk-go/service/ssm"
+	storagegatewayEndpoint "github.com/aws/aws-sdk-go/service/storagegateway"
+	swfEndpoint "github.com/aws/aws-sdk-go/service/swf"
+	syntheticsEndpoint "github.com/aws/aws-sdk-go/service/synthetics"
+	timestreamqueryEndpoint "github.com/aws/aws-sdk-go/service/timestreamquery"
+	timestreamwriteEndpoint "github.com/aws/aws-sdk-go/service/timestreamwrite"
 	wafregionalEnpoint "github.com/aws/aws-sdk-go/service/wafregional"
 	wafv2Enpoint "github.com/aws/aws-sdk-go/service/wafv2"
 	wellarchitectedEndpoint "github.com/aws/aws-sdk-go/service/wellarchitected"
 	workspacesEndpoint "github.com/aws/aws-sdk-go/service/workspaces"
+	xrayEndpoint "github.com/aws/aws-sdk-go/service/xray"
 	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 )
@@ -198,6 +292,17 @@ func ACMClient(ctx context.Context, d *plugin.QueryData) (*acm.Client, error) {
 	return acm.NewFromConfig(*cfg), nil
 }
 
+func AMPClient(ctx context.Context, d *plugin.QueryData) (*amp.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, ampEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return amp.NewFromConfig(*cfg), nil
+}
+
 func AmplifyClient(ctx context.Context, d *plugin.QueryData) (*amplify.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, amplifyEndpoint.EndpointsID)
 	if err != nil {
@@ -246,6 +351,17 @@ func AppConfigClient(ctx context.Context, d *plugin.QueryData) (*appconfig.Clien
 	return appconfig.NewFromConfig(*cfg), nil
 }
 
+func AppFlowClient(ctx context.Context, d *plugin.QueryData) (*appflow.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, appflowEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return appflow.NewFromConfig(*cfg), nil
+}
+
 func ApplicationAutoScalingClient(ctx context.Context, d *plugin.QueryData) (*applicationautoscaling.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -254,6 +370,47 @@ func ApplicationAutoScalingClient(ctx context.Context, d *plugin.QueryData) (*ap
 	return applicationautoscaling.NewFromConfig(*cfg), nil
 }
 
+func ApplicationDiscoveryClient(ctx context.Context, d *plugin.QueryData) (*applicationdiscoveryservice.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, applicationdiscoveryserviceEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return applicationdiscoveryservice.NewFromConfig(*cfg), nil
+}
+
+func AppMeshClient(ctx context.Context, d *plugin.QueryData) (*appmesh.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, appmeshEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return appmesh.NewFromConfig(*cfg), nil
+}
+
+func AppSyncClient(ctx context.Context, d *plugin.QueryData) (*appsync.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, appsyncEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return appsync.NewFromConfig(*cfg), nil
+}
+
+func AthenaClient(ctx context.Context, d *plugin.QueryData, region string) (*athena.Client, error) {
+	cfg, err := getClientForRegion(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	return athena.NewFromConfig(*cfg), nil
+}
+
 func AuditManagerClient(ctx context.Context, d *plugin.QueryData) (*auditmanager.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, auditmanagerEndpoint.EndpointsID)
 	if err != nil {
@@ -284,6 +441,35 @@ func BackupClient(ctx context.Context, d *plugin.QueryData) (*backup.Client, err
 	return backup.NewFromConfig(*cfg), nil
 }
 
+func BillingConductorClient(ctx context.Context, d *plugin.QueryData) (*billingconductor.Client, error) {
+	// Billing Conductor is a global service with its endpoint in us-east-1
+	cfg, err := getClient(ctx, d, "us-east-1")
+	if err != nil {
+		return nil, err
+	}
+	return billingconductor.NewFromConfig(*cfg), nil
+}
+
+func BudgetsClient(ctx context.Context, d *plugin.QueryData) (*budgets.Client, error) {
+	// Budgets is a global service with a single endpoint
+	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	return budgets.NewFromConfig(*cfg), nil
+}
+
+func Cloud9Client(ctx context.Context, d *plugin.QueryData) (*cloud9.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, cloud9Endpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return cloud9.NewFromConfig(*cfg), nil
+}
+
 func CloudControlClient(ctx context.Context, d *plugin.QueryData) (*cloudcontrol.Client, error) {
 	// CloudControl returns GeneralServiceException in a lot of situations, which
 	// AWS SDK treats as retryable. This is frustrating because we end up retrying
@@ -342,6 +528,14 @@ func CloudFrontClient(ctx context.Context, d *plugin.QueryData) (*cloudfront.Cli
 	return cloudfront.NewFromConfig(*cfg), nil
 }
 
+func CloudFrontKeyValueStoreClient(ctx context.Context, d *plugin.QueryData) (*cloudfrontkeyvaluestore.Client, error) {
+	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	return cloudfrontkeyvaluestore.NewFromConfig(*cfg), nil
+}
+
 func CloudSearchClient(ctx context.Context, d *plugin.QueryData) (*cloudsearch.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, cloudsearchEndpoint.EndpointsID)
 	if err != nil {
@@ -388,6 +582,14 @@ func CloudWatchLogsClient(ctx context.Context, d *plugin.QueryData) (*cloudwatch
 	return cloudwatchlogs.NewFromConfig(*cfg), nil
 }
 
+func CloudWatchLogsRegionsClient(ctx context.Context, d *plugin.QueryData, region string) (*cloudwatchlogs.Client, error) {
+	cfg, err := getClientForRegion(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	return cloudwatchlogs.NewFromConfig(*cfg), nil
+}
+
 func CodeArtifactClient(ctx context.Context, d *plugin.QueryData) (*codeartifact.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, codeartifactEndpoint.EndpointsID)
 	if err != nil {
@@ -418,6 +620,28 @@ func CodeDeployClient(ctx context.Context, d *plugin.QueryData) (*codedeploy.Cli
 	return codedeploy.NewFromConfig(*cfg), nil
 }
 
+func CodeGuruProfilerClient(ctx context.Context, d *plugin.QueryData) (*codeguruprofiler.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, codeguruprofilerEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return codeguruprofiler.NewFromConfig(*cfg), nil
+}
+
+func CodeGuruReviewerClient(ctx context.Context, d *plugin.QueryData) (*codegurureviewer.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, codegurureviewerEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return codegurureviewer.NewFromConfig(*cfg), nil
+}
+
 // CodePipelineClient returns the service connection for AWS CodePipeline service
 func CodePipelineClient(ctx context.Context, d *plugin.QueryData) (*codepipeline.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, codepipelineEndpoint.EndpointsID)
@@ -430,6 +654,17 @@ func CodePipelineClient(ctx context.Context, d *plugin.QueryData) (*codepipeline
 	return codepipeline.NewFromConfig(*cfg), nil
 }
 
+func ComputeOptimizerClient(ctx context.Context, d *plugin.QueryData) (*computeoptimizer.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, computeoptimizerEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return computeoptimizer.NewFromConfig(*cfg), nil
+}
+
 func ConfigClient(ctx context.Context, d *plugin.QueryData) (*configservice.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -438,6 +673,15 @@ func ConfigClient(ctx context.Context, d *plugin.QueryData) (*configservice.Clie
 	return configservice.NewFromConfig(*cfg), nil
 }
 
+func CostAndUsageReportClient(ctx context.Context, d *plugin.QueryData) (*costandusagereportservice.Client, error) {
+	// Cost and Usage Report API is only available in us-east-1
+	cfg, err := getClient(ctx, d, "us-east-1")
+	if err != nil {
+		return nil, err
+	}
+	return costandusagereportservice.NewFromConfig(*cfg), nil
+}
+
 // CostExplorerClient returns the connection client for AWS Cost Explorer service
 func CostExplorerClient(ctx context.Context, d *plugin.QueryData) (*costexplorer.Client, error) {
 	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
@@ -455,6 +699,39 @@ func DatabaseMigrationClient(ctx context.Context, d *plugin.QueryData) (*databas
 	return databasemigrationservice.NewFromConfig(*cfg), nil
 }
 
+func DataExchangeClient(ctx context.Context, d *plugin.QueryData) (*dataexchange.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, dataexchangeEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return dataexchange.NewFromConfig(*cfg), nil
+}
+
+func DataPipelineClient(ctx context.Context, d *plugin.QueryData) (*datapipeline.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, datapipelineEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return datapipeline.NewFromConfig(*cfg), nil
+}
+
+func DataSyncClient(ctx context.Context, d *plugin.QueryData) (*datasync.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, datasyncEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return datasync.NewFromConfig(*cfg), nil
+}
+
 func DAXClient(ctx context.Context, d *plugin.QueryData) (*dax.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, daxEndpoint.EndpointsID)
 	if err != nil {
@@ -466,6 +743,17 @@ func DAXClient(ctx context.Context, d *plugin.QueryData) (*dax.Client, error) {
 	return dax.NewFromConfig(*cfg), nil
 }
 
+func DevOpsGuruClient(ctx context.Context, d *plugin.QueryData) (*devopsguru.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, devopsguruEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return devopsguru.NewFromConfig(*cfg), nil
+}
+
 func DirectoryServiceClient(ctx context.Context, d *plugin.QueryData) (*directoryservice.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, directoryserviceEndpoint.EndpointsID)
 	if err != nil {
@@ -496,6 +784,17 @@ func DocDBClient(ctx context.Context, d *plugin.QueryData) (*docdb.Client, error
 	return docdb.NewFromConfig(*cfg), nil
 }
 
+func DocDBElasticClient(ctx context.Context, d *plugin.QueryData) (*docdbelastic.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, "docdb-elastic")
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return docdbelastic.NewFromConfig(*cfg), nil
+}
+
 func DynamoDBClient(ctx context.Context, d *plugin.QueryData) (*dynamodb.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, dynamodbEndpoint.EndpointsID)
 	if err != nil {
@@ -507,6 +806,17 @@ func DynamoDBClient(ctx context.Context, d *plugin.QueryData) (*dynamodb.Client,
 	return dynamodb.NewFromConfig(*cfg), nil
 }
 
+func DynamoDBStreamsClient(ctx context.Context, d *plugin.QueryData) (*dynamodbstreams.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, dynamodbstreamsEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return dynamodbstreams.NewFromConfig(*cfg), nil
+}
+
 func EC2Client(ctx context.Context, d *plugin.QueryData) (*ec2.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -639,6 +949,17 @@ func EventBridgeClient(ctx context.Context, d *plugin.QueryData) (*eventbridge.C
 	return eventbridge.NewFromConfig(*cfg), nil
 }
 
+func EvidentlyClient(ctx context.Context, d *plugin.QueryData) (*evidently.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, evidentlyEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return evidently.NewFromConfig(*cfg), nil
+}
+
 func FirehoseClient(ctx context.Context, d *plugin.QueryData) (*firehose.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -690,6 +1011,28 @@ func GlueClient(ctx context.Context, d *plugin.QueryData) (*glue.Client, error)
 	return glue.NewFromConfig(*cfg), nil
 }
 
+func GrafanaClient(ctx context.Context, d *plugin.QueryData) (*grafana.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, grafanaEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return grafana.NewFromConfig(*cfg), nil
+}
+
+func GreengrassV2Client(ctx context.Context, d *plugin.QueryData) (*greengrassv2.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, greengrassv2Endpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return greengrassv2.NewFromConfig(*cfg), nil
+}
+
 func GuardDutyClient(ctx context.Context, d *plugin.QueryData) (*guardduty.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -733,6 +1076,39 @@ func InspectorClient(ctx context.Context, d *plugin.QueryData) (*inspector.Clien
 	return inspector.NewFromConfig(*cfg), nil
 }
 
+func InternetMonitorClient(ctx context.Context, d *plugin.QueryData) (*internetmonitor.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, internetmonitorEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return internetmonitor.NewFromConfig(*cfg), nil
+}
+
+func IoTClient(ctx context.Context, d *plugin.QueryData) (*iot.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, iotEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return iot.NewFromConfig(*cfg), nil
+}
+
+func IVSClient(ctx context.Context, d *plugin.QueryData) (*ivs.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, ivsEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return ivs.NewFromConfig(*cfg), nil
+}
+
 func KafkaClient(ctx context.Context, d *plugin.QueryData) (*kafka.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, kafkaEndpoint.EndpointsID)
 	if err != nil {
@@ -744,6 +1120,17 @@ func KafkaClient(ctx context.Context, d *plugin.QueryData) (*kafka.Client, error
 	return kafka.NewFromConfig(*cfg), nil
 }
 
+func KafkaConnectClient(ctx context.Context, d *plugin.QueryData) (*kafkaconnect.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, kafkaconnectEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return kafkaconnect.NewFromConfig(*cfg), nil
+}
+
 func KinesisClient(ctx context.Context, d *plugin.QueryData) (*kinesis.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -785,6 +1172,17 @@ func KMSClient(ctx context.Context, d *plugin.QueryData) (*kms.Client, error) {
 	return kms.NewFromConfig(*cfg), nil
 }
 
+func LakeFormationClient(ctx context.Context, d *plugin.QueryData) (*lakeformation.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, lakeformationEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return lakeformation.NewFromConfig(*cfg), nil
+}
+
 func LambdaClient(ctx context.Context, d *plugin.QueryData) (*lambda.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, lambdaEndpoint.EndpointsID)
 	if err != nil {
@@ -796,6 +1194,17 @@ func LambdaClient(ctx context.Context, d *plugin.QueryData) (*lambda.Client, err
 	return lambda.NewFromConfig(*cfg), nil
 }
 
+func LicenseManagerClient(ctx context.Context, d *plugin.QueryData) (*licensemanager.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, licensemanagerEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return licensemanager.NewFromConfig(*cfg), nil
+}
+
 func LightsailClient(ctx context.Context, d *plugin.QueryData) (*lightsail.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, lightsailEndpoint.EndpointsID)
 	if err != nil {
@@ -818,6 +1227,79 @@ func Macie2Client(ctx context.Context, d *plugin.QueryData) (*macie2.Client, err
 	return macie2.NewFromConfig(*cfg), nil
 }
 
+func MarketplaceAgreementClient(ctx context.Context, d *plugin.QueryData) (*marketplaceagreement.Client, error) {
+	// AWS Marketplace Agreement Service is only available in us-east-1
+	cfg, err := getClient(ctx, d, "us-east-1")
+	if err != nil {
+		return nil, err
+	}
+	return marketplaceagreement.NewFromConfig(*cfg), nil
+}
+
+func MarketplaceEntitlementClient(ctx context.Context, d *plugin.QueryData) (*marketplaceentitlementservice.Client, error) {
+	// Marketplace Entitlement Service is only available in us-east-1
+	cfg, err := getClient(ctx, d, "us-east-1")
+	if err != nil {
+		return nil, err
+	}
+	return marketplaceentitlementservice.NewFromConfig(*cfg), nil
+}
+
+func MediaConvertClient(ctx context.Context, d *plugin.QueryData) (*mediaconvert.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, mediaconvertEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+
+	// MediaConvert requests must be sent to an account specific endpoint, which
+	// is discovered through DescribeEndpoints and cached per region
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	cacheKey := fmt.Sprintf("mediaconvert-endpoint-%s", region)
+	var endpoint string
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		endpoint = cachedData.(string)
+	} else {
+		op, err := mediaconvert.NewFromConfig(*cfg).DescribeEndpoints(ctx, &mediaconvert.DescribeEndpointsInput{})
+		if err != nil {
+			return nil, err
+		}
+		if len(op.Endpoints) == 0 || op.Endpoints[0].Url == nil {
+			return nil, fmt.Errorf("no MediaConvert endpoint found for region %s", region)
+		}
+		endpoint = *op.Endpoints[0].Url
+		d.ConnectionManager.Cache.Set(cacheKey, endpoint)
+	}
+
+	return mediaconvert.NewFromConfig(*cfg, func(o *mediaconvert.Options) {
+		o.EndpointResolver = mediaconvert.EndpointResolverFromURL(endpoint)
+	}), nil
+}
+
+func MediaLiveClient(ctx context.Context, d *plugin.QueryData) (*medialive.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, medialiveEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return medialive.NewFromConfig(*cfg), nil
+}
+
+func MediaPackageClient(ctx context.Context, d *plugin.QueryData) (*mediapackage.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, mediapackageEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return mediapackage.NewFromConfig(*cfg), nil
+}
+
 func MediaStoreClient(ctx context.Context, d *plugin.QueryData) (*mediastore.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, mediastoreEndpoint.EndpointsID)
 	if err != nil {
@@ -829,6 +1311,39 @@ func MediaStoreClient(ctx context.Context, d *plugin.QueryData) (*mediastore.Cli
 	return mediastore.NewFromConfig(*cfg), nil
 }
 
+func MemoryDBClient(ctx context.Context, d *plugin.QueryData) (*memorydb.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, memorydbEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return memorydb.NewFromConfig(*cfg), nil
+}
+
+func MigrationHubClient(ctx context.Context, d *plugin.QueryData) (*migrationhub.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, migrationhubEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return migrationhub.NewFromConfig(*cfg), nil
+}
+
+func MQClient(ctx context.Context, d *plugin.QueryData) (*mq.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, mqEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return mq.NewFromConfig(*cfg), nil
+}
+
 func NeptuneClient(ctx context.Context, d *plugin.QueryData) (*neptune.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -848,6 +1363,16 @@ func NetworkFirewallClient(ctx context.Context, d *plugin.QueryData) (*networkfi
 	return networkfirewall.NewFromConfig(*cfg), nil
 }
 
+// NetworkManagerClient returns a client for the global network manager service,
+// which is homed in a single region per partition
+func NetworkManagerClient(ctx context.Context, d *plugin.QueryData) (*networkmanager.Client, error) {
+	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	return networkmanager.NewFromConfig(*cfg), nil
+}
+
 func OpenSearchClient(ctx context.Context, d *plugin.QueryData) (*opensearch.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -875,6 +1400,14 @@ func PinpointClient(ctx context.Context, d *plugin.QueryData) (*pinpoint.Client,
 	return pinpoint.NewFromConfig(*cfg), nil
 }
 
+func PipesClient(ctx context.Context, d *plugin.QueryData) (*pipes.Client, error) {
+	cfg, err := getClientForQueryRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return pipes.NewFromConfig(*cfg), nil
+}
+
 func PricingClient(ctx context.Context, d *plugin.QueryData) (*pricing.Client, error) {
 	// Pricing API is a global API that supports only us-east-1 and ap-south-1 regions
 	// getDefaultAwsRegion doesn't return the good region at the moment (it should use specified API endpoints but it doesn't).
@@ -886,6 +1419,28 @@ func PricingClient(ctx context.Context, d *plugin.QueryData) (*pricing.Client, e
 	return pricing.NewFromConfig(*cfg), nil
 }
 
+func QLDBClient(ctx context.Context, d *plugin.QueryData) (*qldb.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, qldbEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return qldb.NewFromConfig(*cfg), nil
+}
+
+func QuickSightClient(ctx context.Context, d *plugin.QueryData) (*quicksight.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, quicksightEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return quicksight.NewFromConfig(*cfg), nil
+}
+
 func RAMClient(ctx context.Context, d *plugin.QueryData) (*ram.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -932,6 +1487,14 @@ func RedshiftClient(ctx context.Context, d *plugin.QueryData) (*redshift.Client,
 	return redshift.NewFromConfig(*cfg), nil
 }
 
+func RedshiftDataClient(ctx context.Context, d *plugin.QueryData, region string) (*redshiftdata.Client, error) {
+	cfg, err := getClientForRegion(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+	return redshiftdata.NewFromConfig(*cfg), nil
+}
+
 func RedshiftServerlessClient(ctx context.Context, d *plugin.QueryData) (*redshiftserverless.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, redshiftserverlessEndpoint.EndpointsID)
 	if err != nil {
@@ -999,6 +1562,17 @@ func Route53ResolverClient(ctx context.Context, d *plugin.QueryData) (*route53re
 	return route53resolver.NewFromConfig(*cfg), nil
 }
 
+func RUMClient(ctx context.Context, d *plugin.QueryData) (*rum.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, rumEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return rum.NewFromConfig(*cfg), nil
+}
+
 func S3Client(ctx context.Context, d *plugin.QueryData, region string) (*s3.Client, error) {
 	cfg, err := getClientForRegion(ctx, d, region)
 	if err != nil {
@@ -1038,6 +1612,34 @@ func SageMakerClient(ctx context.Context, d *plugin.QueryData) (*sagemaker.Clien
 	return sagemaker.NewFromConfig(*cfg), nil
 }
 
+func SavingsPlansClient(ctx context.Context, d *plugin.QueryData) (*savingsplans.Client, error) {
+	// Savings Plans is a global service with a single endpoint
+	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
+	if err != nil {
+		return nil, err
+	}
+	return savingsplans.NewFromConfig(*cfg), nil
+}
+
+func SchedulerClient(ctx context.Context, d *plugin.QueryData) (*scheduler.Client, error) {
+	cfg, err := getClientForQueryRegion(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.NewFromConfig(*cfg), nil
+}
+
+func SchemasClient(ctx context.Context, d *plugin.QueryData) (*schemas.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, schemasEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return schemas.NewFromConfig(*cfg), nil
+}
+
 func SecretsManagerClient(ctx context.Context, d *plugin.QueryData) (*secretsmanager.Client, error) {
 	cfg, err := getClientForQueryRegion(ctx, d)
 	if err != nil {
@@ -1081,6 +1683,17 @@ func SecurityLakeClient(ctx context.Context, d *plugin.QueryData) (*securitylake
 	return securitylake.NewFromConfig(*cfg), nil
 }
 
+func ServiceCatalogClient(ctx context.Context, d *plugin.QueryData) (*servicecatalog.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, servicecatalogEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return servicecatalog.NewFromConfig(*cfg), nil
+}
+
 func SESClient(ctx context.Context, d *plugin.QueryData) (*ses.Client, error) {
 	cfg, err := getClientForQuerySupportedRegion(ctx, d, sesEndpoint.EndpointsID)
 	if err != nil {
@@ -1149,6 +1762,17 @@ func SQSClient(ctx context.Context, d *plugin.QueryData) (*sqs.Client, error) {
 	return sqs.NewFromConfig(*cfg), nil
 }
 
+func StorageGatewayClient(ctx context.Context, d *plugin.QueryData) (*storagegateway.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, storagegatewayEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return storagegateway.NewFromConfig(*cfg), nil
+}
+
 func STSClient(ctx context.Context, d *plugin.QueryData) (*sts.Client, error) {
 	// TODO - Should STS be regional instead?
 	// By default, the AWS Security Token Service (AWS STS) is available as a global service, and all AWS STS requests go to a single endpoint at https://sts.amazonaws.com. AWS recommends using Regional AWS STS endpoints instead of the global endpoint to reduce latency, build in redundancy, and increase session token validity.
@@ -1172,6 +1796,59 @@ func SSOAdminClient(ctx context.Context, d *plugin.QueryData) (*ssoadmin.Client,
 	return ssoadmin.NewFromConfig(*cfg), nil
 }
 
+func SWFClient(ctx context.Context, d *plugin.QueryData) (*swf.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, swfEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return swf.NewFromConfig(*cfg), nil
+}
+
+func SyntheticsClient(ctx context.Context, d *plugin.QueryData) (*synthetics.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, syntheticsEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return synthetics.NewFromConfig(*cfg), nil
+}
+
+func TimestreamQueryClient(ctx context.Context, d *plugin.QueryData) (*timestreamquery.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, timestreamqueryEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return timestreamquery.NewFromConfig(*cfg), nil
+}
+
+func TimestreamWriteClient(ctx context.Context, d *plugin.QueryData) (*timestreamwrite.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, timestreamwriteEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return timestreamwrite.NewFromConfig(*cfg), nil
+}
+
+func TrustedAdvisorClient(ctx context.Context, d *plugin.QueryData) (*trustedadvisor.Client, error) {
+	// Trusted Advisor API is a global API that is only available in us-east-1
+	cfg, err := getClient(ctx, d, "us-east-1")
+	if err != nil {
+		return nil, err
+	}
+	return trustedadvisor.NewFromConfig(*cfg), nil
+}
+
 func WAFClient(ctx context.Context, d *plugin.QueryData) (*waf.Client, error) {
 	cfg, err := getClient(ctx, d, getDefaultAwsRegion(d))
 	if err != nil {
@@ -1231,6 +1908,17 @@ func WorkspacesClient(ctx context.Context, d *plugin.QueryData) (*workspaces.Cli
 	return workspaces.NewFromConfig(*cfg), nil
 }
 
+func XRayClient(ctx context.Context, d *plugin.QueryData) (*xray.Client, error) {
+	cfg, err := getClientForQuerySupportedRegion(ctx, d, xrayEndpoint.EndpointsID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	return xray.NewFromConfig(*cfg), nil
+}
+
 func getClient(ctx context.Context, d *plugin.QueryData, region string) (*aws.Config, error) {
 	sessionCacheKey := fmt.Sprintf("session-v2-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(sessionCacheKey); ok {
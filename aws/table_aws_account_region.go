@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAccountRegion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_account_region",
+		Description: "AWS Account Region",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsAccountRegions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "opt_status", Require: plugin.Optional},
+				{Name: "linked_account_id", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The Region code of a given Region, for example us-east-1.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region.RegionName"),
+			},
+			{
+				Name:        "opt_status",
+				Description: "One of the potential statuses a Region can undergo: ENABLED, ENABLING, DISABLED, DISABLING or ENABLED_BY_DEFAULT.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region.RegionOptStatus"),
+			},
+			{
+				Name:        "linked_account_id",
+				Description: "Account ID to get the Region opt-in status for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedAccountID"),
+			},
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region.RegionName"),
+			},
+		}),
+	}
+}
+
+type accountRegionData = struct {
+	Region          types.Region
+	LinkedAccountID string
+}
+
+//// LIST FUNCTION
+
+func listAwsAccountRegions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Account management APIs are not supported in GovCloud
+	if commonColumnData.Partition == "aws-us-gov" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := AccountClient(ctx, d)
+	if err != nil {
+		logger.Error("aws_account_region.listAwsAccountRegions", "service_creation_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	var linkedAccountID string
+	if d.KeyColumnQuals["linked_account_id"] != nil {
+		linkedAccountID = d.KeyColumnQuals["linked_account_id"].GetStringValue()
+	} else {
+		linkedAccountID = commonColumnData.AccountId
+	}
+
+	// As with GetContactInformation, the account ID must only be passed when
+	// calling from the management account for a member account
+	input := &account.ListRegionsInput{}
+	if linkedAccountID != commonColumnData.AccountId {
+		input.AccountId = aws.String(linkedAccountID)
+	}
+	if d.KeyColumnQuals["opt_status"] != nil {
+		input.RegionOptStatusContains = []types.RegionOptStatus{types.RegionOptStatus(d.KeyColumnQuals["opt_status"].GetStringValue())}
+	}
+
+	paginator := account.NewListRegionsPaginator(svc, input, func(o *account.ListRegionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Error("aws_account_region.listAwsAccountRegions", "api_error", err)
+			return nil, err
+		}
+
+		for _, region := range output.Regions {
+			d.StreamListItem(ctx, &accountRegionData{region, linkedAccountID})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
+	"github.com/aws/aws-sdk-go-v2/service/amp/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type ampRuleGroupsNamespaceInfo = struct {
+	types.RuleGroupsNamespaceSummary
+	WorkspaceId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAMPRuleGroupsNamespace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amp_rule_groups_namespace",
+		Description: "AWS Managed Service for Prometheus Rule Groups Namespace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_id", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAMPRuleGroupsNamespace,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAMPWorkspaces,
+			Hydrate:       listAMPRuleGroupsNamespaces,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "workspace_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule groups namespace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the rule groups namespace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_id",
+				Description: "The ID of the workspace the rule groups namespace belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the rule groups namespace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StatusCode"),
+			},
+			{
+				Name:        "status_reason",
+				Description: "The reason for failure if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StatusReason"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time when the rule groups namespace was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified_at",
+				Description: "The time when the rule groups namespace was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "data",
+				Description: "The rule groups namespace definition, in Prometheus rules file YAML format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAMPRuleGroupsNamespaceData,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAMPRuleGroupsNamespaces(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(types.WorkspaceSummary)
+
+	// Minimize API calls when a specific workspace has been requested
+	if d.KeyColumnQuals["workspace_id"] != nil && d.KeyColumnQuals["workspace_id"].GetStringValue() != *workspace.WorkspaceId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_rule_groups_namespace.listAMPRuleGroupsNamespaces", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &amp.ListRuleGroupsNamespacesInput{
+		WorkspaceId: workspace.WorkspaceId,
+	}
+
+	paginator := amp.NewListRuleGroupsNamespacesPaginator(svc, input, func(o *amp.ListRuleGroupsNamespacesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amp_rule_groups_namespace.listAMPRuleGroupsNamespaces", "api_error", err)
+			return nil, err
+		}
+
+		for _, namespace := range output.RuleGroupsNamespaces {
+			d.StreamLeafListItem(ctx, ampRuleGroupsNamespaceInfo{namespace, workspace.WorkspaceId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAMPRuleGroupsNamespace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	workspaceId := d.KeyColumnQuals["workspace_id"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if workspace_id or name is empty
+	if workspaceId == "" || name == "" {
+		return nil, nil
+	}
+
+	namespace, err := getAMPRuleGroupsNamespaceDetails(ctx, d, workspaceId, name)
+	if err != nil || namespace == nil {
+		return nil, err
+	}
+
+	return ampRuleGroupsNamespaceInfo{
+		types.RuleGroupsNamespaceSummary{
+			Arn:        namespace.Arn,
+			CreatedAt:  namespace.CreatedAt,
+			ModifiedAt: namespace.ModifiedAt,
+			Name:       namespace.Name,
+			Status:     namespace.Status,
+			Tags:       namespace.Tags,
+		},
+		aws.String(workspaceId),
+	}, nil
+}
+
+// getAMPRuleGroupsNamespaceData returns the rules file of the namespace, which
+// is not included in the list response
+func getAMPRuleGroupsNamespaceData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespace := h.Item.(ampRuleGroupsNamespaceInfo)
+
+	op, err := getAMPRuleGroupsNamespaceDetails(ctx, d, *namespace.WorkspaceId, *namespace.Name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return string(op.Data), nil
+}
+
+func getAMPRuleGroupsNamespaceDetails(ctx context.Context, d *plugin.QueryData, workspaceId string, name string) (*types.RuleGroupsNamespaceDescription, error) {
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_rule_groups_namespace.getAMPRuleGroupsNamespaceDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &amp.DescribeRuleGroupsNamespaceInput{
+		WorkspaceId: aws.String(workspaceId),
+		Name:        aws.String(name),
+	}
+
+	op, err := svc.DescribeRuleGroupsNamespace(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_rule_groups_namespace.getAMPRuleGroupsNamespaceDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.RuleGroupsNamespace, nil
+}
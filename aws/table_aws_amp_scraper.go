@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
+	"github.com/aws/aws-sdk-go-v2/service/amp/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAMPScraper(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amp_scraper",
+		Description: "AWS Managed Service for Prometheus Scraper",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("scraper_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAMPScraper,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAMPScrapers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "alias", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "workspace_arn", Require: plugin.Optional},
+				{Name: "cluster_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "scraper_id",
+				Description: "The unique ID of the scraper.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the scraper.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alias",
+				Description: "The alias of the scraper.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the scraper.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StatusCode"),
+			},
+			{
+				Name:        "status_reason",
+				Description: "If there is a failure, the reason for the failure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the scraper was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The date and time that the scraper was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role that provides permissions for the scraper to discover and collect metrics on your behalf.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_arn",
+				Description: "The Amazon Resource Name (ARN) of the workspace the scraper sends metrics to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Destination").Transform(ampScraperWorkspaceArn),
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon EKS cluster the scraper collects metrics from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Source").Transform(ampScraperClusterArn),
+			},
+			{
+				Name:        "source",
+				Description: "The Amazon EKS cluster, subnets and security groups the scraper collects metrics from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Source").Transform(ampScraperSource),
+			},
+			{
+				Name:        "scrape_configuration",
+				Description: "The configuration in use by the scraper, in YAML format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAMPScraper,
+				Transform:   transform.FromField("ScrapeConfiguration").Transform(ampScraperConfiguration),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Alias", "ScraperId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAMPScrapers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_scraper.listAMPScrapers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &amp.ListScrapersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filterQuals := map[string]string{
+		"alias":         "alias",
+		"status":        "status",
+		"workspace_arn": "destinationArn",
+		"cluster_arn":   "sourceArn",
+	}
+	filters := map[string][]string{}
+	for columnName, filterName := range filterQuals {
+		if d.KeyColumnQuals[columnName] != nil {
+			filters[filterName] = []string{d.KeyColumnQuals[columnName].GetStringValue()}
+		}
+	}
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := amp.NewListScrapersPaginator(svc, input, func(o *amp.ListScrapersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amp_scraper.listAMPScrapers", "api_error", err)
+			return nil, err
+		}
+
+		for _, scraper := range output.Scrapers {
+			d.StreamListItem(ctx, scraper)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAMPScraper(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["scraper_id"].GetStringValue()
+
+	// check if scraper_id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	scraper, err := getAMPScraperDetails(ctx, d, id)
+	if err != nil || scraper == nil {
+		return nil, err
+	}
+
+	return types.ScraperSummary{
+		Alias:          scraper.Alias,
+		Arn:            scraper.Arn,
+		CreatedAt:      scraper.CreatedAt,
+		Destination:    scraper.Destination,
+		LastModifiedAt: scraper.LastModifiedAt,
+		RoleArn:        scraper.RoleArn,
+		ScraperId:      scraper.ScraperId,
+		Source:         scraper.Source,
+		Status:         scraper.Status,
+		StatusReason:   scraper.StatusReason,
+		Tags:           scraper.Tags,
+	}, nil
+}
+
+// describeAMPScraper returns the scrape configuration of the scraper, which
+// is not included in the list response
+func describeAMPScraper(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.ScraperSummary).ScraperId
+
+	scraper, err := getAMPScraperDetails(ctx, d, *id)
+	if err != nil || scraper == nil {
+		return nil, err
+	}
+
+	return scraper, nil
+}
+
+func getAMPScraperDetails(ctx context.Context, d *plugin.QueryData, id string) (*types.ScraperDescription, error) {
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_scraper.getAMPScraperDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &amp.DescribeScraperInput{
+		ScraperId: aws.String(id),
+	}
+
+	op, err := svc.DescribeScraper(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_scraper.getAMPScraperDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Scraper, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ampScraperWorkspaceArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if destination, ok := d.Value.(*types.DestinationMemberAmpConfiguration); ok {
+		return destination.Value.WorkspaceArn, nil
+	}
+	return nil, nil
+}
+
+func ampScraperClusterArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if source, ok := d.Value.(*types.SourceMemberEksConfiguration); ok {
+		return source.Value.ClusterArn, nil
+	}
+	return nil, nil
+}
+
+func ampScraperSource(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if source, ok := d.Value.(*types.SourceMemberEksConfiguration); ok {
+		return source.Value, nil
+	}
+	return nil, nil
+}
+
+func ampScraperConfiguration(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if configuration, ok := d.Value.(*types.ScrapeConfigurationMemberConfigurationBlob); ok {
+		return string(configuration.Value), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amp"
+	"github.com/aws/aws-sdk-go-v2/service/amp/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAMPWorkspace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amp_workspace",
+		Description: "AWS Managed Service for Prometheus Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("workspace_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAMPWorkspace,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAMPWorkspaces,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "alias", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "workspace_id",
+				Description: "The unique ID for the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alias",
+				Description: "The alias that is assigned to the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StatusCode"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the workspace was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "prometheus_endpoint",
+				Description: "The Prometheus endpoint available for the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAMPWorkspace,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Alias", "WorkspaceId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAMPWorkspaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_workspace.listAMPWorkspaces", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &amp.ListWorkspacesInput{}
+	if d.KeyColumnQuals["alias"] != nil {
+		input.Alias = aws.String(d.KeyColumnQuals["alias"].GetStringValue())
+	}
+
+	paginator := amp.NewListWorkspacesPaginator(svc, input, func(o *amp.ListWorkspacesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amp_workspace.listAMPWorkspaces", "api_error", err)
+			return nil, err
+		}
+
+		for _, workspace := range output.Workspaces {
+			d.StreamListItem(ctx, workspace)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAMPWorkspace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["workspace_id"].GetStringValue()
+
+	// check if workspace_id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	workspace, err := getAMPWorkspaceDetails(ctx, d, id)
+	if err != nil || workspace == nil {
+		return nil, err
+	}
+
+	return types.WorkspaceSummary{
+		Alias:       workspace.Alias,
+		Arn:         workspace.Arn,
+		CreatedAt:   workspace.CreatedAt,
+		Status:      workspace.Status,
+		Tags:        workspace.Tags,
+		WorkspaceId: workspace.WorkspaceId,
+	}, nil
+}
+
+// describeAMPWorkspace returns the Prometheus endpoint of the workspace, which
+// is not included in the list response
+func describeAMPWorkspace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.WorkspaceSummary).WorkspaceId
+
+	workspace, err := getAMPWorkspaceDetails(ctx, d, *id)
+	if err != nil || workspace == nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+func getAMPWorkspaceDetails(ctx context.Context, d *plugin.QueryData, id string) (*types.WorkspaceDescription, error) {
+	// Create Session
+	svc, err := AMPClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_workspace.getAMPWorkspaceDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &amp.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	op, err := svc.DescribeWorkspace(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amp_workspace.getAMPWorkspaceDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Workspace, nil
+}
@@ -0,0 +1,266 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type amplifyBranchInfo = struct {
+	types.Branch
+	AppId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAmplifyBranch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amplify_branch",
+		Description: "AWS Amplify Branch",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAmplifyApps,
+			Hydrate:       listAmplifyBranches,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "app_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "branch_name",
+				Description: "The name for the branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BranchArn"),
+			},
+			{
+				Name:        "app_id",
+				Description: "The unique ID of the Amplify app that the branch belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name for the branch. This is used as the default domain prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description for the branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stage",
+				Description: "The current stage for the branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "framework",
+				Description: "The framework for a branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The creation date and time for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_time",
+				Description: "The last updated date and time for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "active_job_id",
+				Description: "The ID of the active job for a branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_number_of_jobs",
+				Description: "The total number of jobs that are part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_auto_build",
+				Description: "Enables auto-building on push for a branch of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_basic_auth",
+				Description: "Enables basic authorization for a branch of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_notification",
+				Description: "Enables notifications for a branch that is part of an Amplify app.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_performance_mode",
+				Description: "Enables performance mode for the branch.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "enable_pull_request_preview",
+				Description: "Enables pull request previews for the branch.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "pull_request_environment_name",
+				Description: "The Amplify environment name for the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backend_environment_arn",
+				Description: "The Amazon Resource Name (ARN) for a backend environment that is part of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_branch",
+				Description: "The source branch if the branch is a pull request branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_branch",
+				Description: "The destination branch if the branch is a pull request branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ttl",
+				Description: "The content Time to Live (TTL) for the website in seconds.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "thumbnail_url",
+				Description: "The thumbnail URL for the branch of an Amplify app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "basic_auth_credentials",
+				Description: "The basic authorization credentials for a branch of an Amplify app. You must base64-encode the authorization credentials and provide them in the format user:password.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_spec",
+				Description: "The build specification (build spec) content for the branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BuildSpec").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "custom_domains",
+				Description: "The custom domains for a branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "associated_resources",
+				Description: "A list of custom resources that are linked to this branch.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "environment_variables",
+				Description: "The environment variables specific to a branch of an Amplify app.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BranchName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BranchArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAmplifyBranches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	app := h.Item.(types.App)
+
+	// Minimize API calls when a specific app has been requested
+	if d.KeyColumnQualString("app_id") != "" && d.KeyColumnQualString("app_id") != *app.AppId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AmplifyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amplify_branch.listAmplifyBranches", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &amplify.ListBranchesInput{
+		AppId:      app.AppId,
+		MaxResults: int32(50),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = int32(1)
+			} else {
+				input.MaxResults = int32(limit)
+			}
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListBranches(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amplify_branch.listAmplifyBranches", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Branches {
+			d.StreamLeafListItem(ctx, amplifyBranchInfo{
+				Branch: item,
+				AppId:  app.AppId,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
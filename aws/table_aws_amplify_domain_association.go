@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type amplifyDomainAssociationInfo = struct {
+	types.DomainAssociation
+	AppId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAmplifyDomainAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_amplify_domain_association",
+		Description: "AWS Amplify Domain Association",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAmplifyApps,
+			Hydrate:       listAmplifyDomainAssociations,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "app_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "domain_name",
+				Description: "The name of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the domain association.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainAssociationArn"),
+			},
+			{
+				Name:        "app_id",
+				Description: "The unique ID of the Amplify app that the domain is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_status",
+				Description: "The current status of the domain association, including the status of its certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "The reason for the current status of the domain association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_verification_dns_record",
+				Description: "The DNS record for certificate verification.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enable_auto_sub_domain",
+				Description: "Enables the automated creation of subdomains for branches.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "auto_sub_domain_iam_role",
+				Description: "The required AWS Identity and Access Management (IAM) service role for the Amazon Resource Name (ARN) for automatically creating subdomains.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoSubDomainIAMRole"),
+			},
+			{
+				Name:        "auto_sub_domain_creation_patterns",
+				Description: "Sets branch patterns for automatic subdomain creation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sub_domains",
+				Description: "The subdomains for the domain association, including the branch each one points to and whether its DNS record is verified.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DomainAssociationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAmplifyDomainAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	app := h.Item.(types.App)
+
+	// Minimize API calls when a specific app has been requested
+	if d.KeyColumnQualString("app_id") != "" && d.KeyColumnQualString("app_id") != *app.AppId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AmplifyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_amplify_domain_association.listAmplifyDomainAssociations", "get_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &amplify.ListDomainAssociationsInput{
+		AppId:      app.AppId,
+		MaxResults: int32(50),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < input.MaxResults {
+			if limit < 1 {
+				input.MaxResults = int32(1)
+			} else {
+				input.MaxResults = int32(limit)
+			}
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListDomainAssociations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_amplify_domain_association.listAmplifyDomainAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.DomainAssociations {
+			d.StreamLeafListItem(ctx, amplifyDomainAssociationInfo{
+				DomainAssociation: item,
+				AppId:             app.AppId,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
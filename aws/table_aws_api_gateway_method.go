@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAPIGatewayMethod(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_api_gateway_method",
+		Description: "AWS API Gateway Method",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"rest_api_id", "resource_id", "http_method"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAPIGatewayMethod,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listRestAPI,
+			Hydrate:       listAPIGatewayMethods,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "rest_api_id", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "http_method",
+				Description: "The method's HTTP verb.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.HttpMethod"),
+			},
+			{
+				Name:        "rest_api_id",
+				Description: "The id of the rest api.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestAPIId"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The identifier of the resource that the method belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceId"),
+			},
+			{
+				Name:        "path",
+				Description: "The full path of the resource that the method belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Path"),
+			},
+			{
+				Name:        "authorization_type",
+				Description: "The method's authorization type. Valid values are NONE for open access, AWS_IAM for using AWS IAM permissions, CUSTOM for using a custom authorizer, or COGNITO_USER_POOLS for using a Cognito user pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.AuthorizationType"),
+			},
+			{
+				Name:        "authorizer_id",
+				Description: "The identifier of an Authorizer to use on this method.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.AuthorizerId"),
+			},
+			{
+				Name:        "api_key_required",
+				Description: "A boolean flag specifying whether a valid ApiKey is required to invoke this method.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Method.ApiKeyRequired"),
+			},
+			{
+				Name:        "operation_name",
+				Description: "A human-friendly operation identifier for the method.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.OperationName"),
+			},
+			{
+				Name:        "request_validator_id",
+				Description: "The identifier of a RequestValidator for request validation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.RequestValidatorId"),
+			},
+			{
+				Name:        "validate_request_body",
+				Description: "Indicates whether the request validator of the method validates the request body.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAPIGatewayMethodRequestValidator,
+				Transform:   transform.FromField("ValidateRequestBody"),
+			},
+			{
+				Name:        "validate_request_parameters",
+				Description: "Indicates whether the request validator of the method validates the request parameters.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAPIGatewayMethodRequestValidator,
+				Transform:   transform.FromField("ValidateRequestParameters"),
+			},
+			{
+				Name:        "integration_type",
+				Description: "The type of the method's integration backend, one of HTTP, HTTP_PROXY, AWS, AWS_PROXY or MOCK.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.MethodIntegration.Type"),
+			},
+			{
+				Name:        "integration_uri",
+				Description: "The Uniform Resource Identifier (URI) of the method's integration backend.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Method.MethodIntegration.Uri"),
+			},
+			{
+				Name:        "authorization_scopes",
+				Description: "A list of authorization scopes configured on the method. The scopes are used with a COGNITO_USER_POOLS authorizer to authorize the method invocation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Method.AuthorizationScopes"),
+			},
+			{
+				Name:        "method_integration",
+				Description: "The method's integration, which defines how API Gateway forwards the request to the backend.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Method.MethodIntegration"),
+			},
+			{
+				Name:        "method_responses",
+				Description: "The method's responses, keyed by status code.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Method.MethodResponses"),
+			},
+			{
+				Name:        "request_models",
+				Description: "The data schemas for the method's request payloads, keyed by content type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Method.RequestModels"),
+			},
+			{
+				Name:        "request_parameters",
+				Description: "The request parameters that API Gateway accepts, and whether each of them is required.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Method.RequestParameters"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(apiGatewayMethodTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAPIGatewayMethodAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type apiGatewayMethodRowData = struct {
+	Method     types.Method
+	RestAPIId  *string
+	ResourceId *string
+	Path       *string
+}
+
+//// LIST FUNCTION
+
+func listAPIGatewayMethods(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get Rest API details
+	restAPI := h.Item.(types.RestApi)
+
+	// Minimize API calls when a specific rest api has been requested
+	if d.KeyColumnQuals["rest_api_id"] != nil && d.KeyColumnQuals["rest_api_id"].GetStringValue() != *restAPI.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := APIGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.listAPIGatewayMethods", "service_client_error", err)
+		return nil, err
+	}
+
+	// The methods are embedded in the resources of the rest api, so the
+	// resources are listed and each of their methods returned as a row
+	params := &apigateway.GetResourcesInput{
+		Limit:     aws.Int32(500),
+		RestApiId: restAPI.Id,
+		Embed:     []string{"methods"},
+	}
+
+	paginator := apigateway.NewGetResourcesPaginator(svc, params, func(o *apigateway.GetResourcesPaginatorOptions) {
+		o.Limit = 500
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_api_gateway_method.listAPIGatewayMethods", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range output.Items {
+			if d.KeyColumnQuals["resource_id"] != nil && d.KeyColumnQuals["resource_id"].GetStringValue() != *resource.Id {
+				continue
+			}
+
+			// Return the methods in a stable order
+			var httpMethods []string
+			for httpMethod := range resource.ResourceMethods {
+				httpMethods = append(httpMethods, httpMethod)
+			}
+			sort.Strings(httpMethods)
+
+			for _, httpMethod := range httpMethods {
+				method := resource.ResourceMethods[httpMethod]
+				if method.HttpMethod == nil {
+					method.HttpMethod = aws.String(httpMethod)
+				}
+				d.StreamLeafListItem(ctx, &apiGatewayMethodRowData{method, restAPI.Id, resource.Id, resource.Path})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIGatewayMethod(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := APIGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.getAPIGatewayMethod", "service_client_error", err)
+		return nil, err
+	}
+
+	restAPIID := d.KeyColumnQuals["rest_api_id"].GetStringValue()
+	resourceID := d.KeyColumnQuals["resource_id"].GetStringValue()
+	httpMethod := d.KeyColumnQuals["http_method"].GetStringValue()
+
+	params := &apigateway.GetMethodInput{
+		RestApiId:  aws.String(restAPIID),
+		ResourceId: aws.String(resourceID),
+		HttpMethod: aws.String(httpMethod),
+	}
+
+	op, err := svc.GetMethod(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.getAPIGatewayMethod", "api_error", err)
+		return nil, err
+	}
+
+	// The path of the resource is not returned by the method
+	resource, err := svc.GetResource(ctx, &apigateway.GetResourceInput{
+		RestApiId:  aws.String(restAPIID),
+		ResourceId: aws.String(resourceID),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.getAPIGatewayMethod", "api_error", err)
+		return nil, err
+	}
+
+	method := types.Method{
+		ApiKeyRequired:      op.ApiKeyRequired,
+		AuthorizationScopes: op.AuthorizationScopes,
+		AuthorizationType:   op.AuthorizationType,
+		AuthorizerId:        op.AuthorizerId,
+		HttpMethod:          op.HttpMethod,
+		MethodIntegration:   op.MethodIntegration,
+		MethodResponses:     op.MethodResponses,
+		OperationName:       op.OperationName,
+		RequestModels:       op.RequestModels,
+		RequestParameters:   op.RequestParameters,
+		RequestValidatorId:  op.RequestValidatorId,
+	}
+
+	return &apiGatewayMethodRowData{method, aws.String(restAPIID), aws.String(resourceID), resource.Path}, nil
+}
+
+func getAPIGatewayMethodRequestValidator(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(*apiGatewayMethodRowData)
+
+	// Methods without a request validator do not validate requests
+	if data.Method.RequestValidatorId == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := APIGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.getAPIGatewayMethodRequestValidator", "service_client_error", err)
+		return nil, err
+	}
+
+	params := &apigateway.GetRequestValidatorInput{
+		RestApiId:          data.RestAPIId,
+		RequestValidatorId: data.Method.RequestValidatorId,
+	}
+
+	op, err := svc.GetRequestValidator(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_method.getAPIGatewayMethodRequestValidator", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getAPIGatewayMethodAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	data := h.Item.(*apiGatewayMethodRowData)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	akas := []string{"arn:" + commonColumnData.Partition + ":apigateway:" + region + "::/restapis/" + *data.RestAPIId + "/resources/" + *data.ResourceId + "/methods/" + *data.Method.HttpMethod}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func apiGatewayMethodTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*apiGatewayMethodRowData)
+	return aws.ToString(data.Method.HttpMethod) + " " + aws.ToString(data.Path), nil
+}
@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAPIGatewayResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_api_gateway_resource",
+		Description: "AWS API Gateway Resource",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"rest_api_id", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAPIGatewayResource,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listRestAPI,
+			Hydrate:       listAPIGatewayResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "rest_api_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The resource's identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Id"),
+			},
+			{
+				Name:        "rest_api_id",
+				Description: "The id of the rest api.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestAPIId"),
+			},
+			{
+				Name:        "path",
+				Description: "The full path for this resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Path"),
+			},
+			{
+				Name:        "path_part",
+				Description: "The last path segment for this resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.PathPart"),
+			},
+			{
+				Name:        "parent_id",
+				Description: "The parent resource's identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.ParentId"),
+			},
+			{
+				Name:        "http_methods",
+				Description: "The HTTP methods that are defined on the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Resource.ResourceMethods").Transform(apiGatewayResourceMethodNames),
+			},
+			{
+				Name:        "resource_methods",
+				Description: "The methods defined on the resource, keyed by HTTP method.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Resource.ResourceMethods"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Path"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAPIGatewayResourceAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type apiGatewayResourceRowData = struct {
+	Resource  types.Resource
+	RestAPIId *string
+}
+
+//// LIST FUNCTION
+
+func listAPIGatewayResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get Rest API details
+	restAPI := h.Item.(types.RestApi)
+
+	// Minimize API calls when a specific rest api has been requested
+	if d.KeyColumnQuals["rest_api_id"] != nil && d.KeyColumnQuals["rest_api_id"].GetStringValue() != *restAPI.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := APIGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_resource.listAPIGatewayResources", "service_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &apigateway.GetResourcesInput{
+		Limit:     aws.Int32(maxLimit),
+		RestApiId: restAPI.Id,
+		Embed:     []string{"methods"},
+	}
+
+	paginator := apigateway.NewGetResourcesPaginator(svc, params, func(o *apigateway.GetResourcesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_api_gateway_resource.listAPIGatewayResources", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range output.Items {
+			d.StreamLeafListItem(ctx, &apiGatewayResourceRowData{resource, restAPI.Id})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIGatewayResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := APIGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_resource.getAPIGatewayResource", "service_client_error", err)
+		return nil, err
+	}
+
+	resourceID := d.KeyColumnQuals["id"].GetStringValue()
+	restAPIID := d.KeyColumnQuals["rest_api_id"].GetStringValue()
+
+	params := &apigateway.GetResourceInput{
+		ResourceId: aws.String(resourceID),
+		RestApiId:  aws.String(restAPIID),
+		Embed:      []string{"methods"},
+	}
+
+	op, err := svc.GetResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gateway_resource.getAPIGatewayResource", "api_error", err)
+		return nil, err
+	}
+
+	resource := types.Resource{
+		Id:              op.Id,
+		ParentId:        op.ParentId,
+		Path:            op.Path,
+		PathPart:        op.PathPart,
+		ResourceMethods: op.ResourceMethods,
+	}
+
+	return &apiGatewayResourceRowData{resource, aws.String(restAPIID)}, nil
+}
+
+func getAPIGatewayResourceAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	data := h.Item.(*apiGatewayResourceRowData)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	akas := []string{"arn:" + commonColumnData.Partition + ":apigateway:" + region + "::/restapis/" + *data.RestAPIId + "/resources/" + *data.Resource.Id}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func apiGatewayResourceMethodNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	methods, ok := d.Value.(map[string]types.Method)
+	if !ok || len(methods) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -34,6 +34,9 @@ func tableAwsAPIGatewayV2Integration(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listAPIGatewayV2API,
 			Hydrate:       listAPIGatewayV2Integrations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "api_id", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItemFunc: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -174,6 +177,11 @@ func listAPIGatewayV2Integrations(ctx context.Context, d *plugin.QueryData, h *p
 	// Get API details
 	api := h.Item.(types.Api)
 
+	// Minimize API calls when a specific API has been requested
+	if d.KeyColumnQuals["api_id"] != nil && d.KeyColumnQuals["api_id"].GetStringValue() != *api.ApiId {
+		return nil, nil
+	}
+
 	// Create Session
 	svc, err := APIGatewayV2Client(ctx, d)
 	if err != nil {
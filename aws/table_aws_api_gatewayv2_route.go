@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type routeInfo = struct {
+	types.Route
+	ApiId string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAPIGatewayV2Route(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_api_gatewayv2_route",
+		Description: "AWS API Gateway Version 2 Route",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"route_id", "api_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "TooManyRequestsException"}),
+			},
+			Hydrate: getAPIGatewayV2Route,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIGatewayV2API,
+			Hydrate:       listAPIGatewayV2Routes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "api_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "route_id",
+				Description: "The route ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_id",
+				Description: "Represents the identifier of an API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "route_key",
+				Description: "The route key for the route. For HTTP APIs, the route key can be either $default, or a combination of an HTTP method and resource path, for example, GET /pets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) specifying the route.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAPIGatewayV2RouteARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "target",
+				Description: "The target for the route, in the form integrations/{integration_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_type",
+				Description: "The authorization type for the route. For WebSocket APIs, valid values are NONE for open access, AWS_IAM for using AWS IAM permissions, and CUSTOM for using a Lambda authorizer. For HTTP APIs, valid values are NONE for open access, JWT for using JSON Web Tokens, AWS_IAM for using AWS IAM permissions, and CUSTOM for using a Lambda authorizer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorizer_id",
+				Description: "The identifier of the Authorizer resource to be associated with this route.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_gateway_managed",
+				Description: "Specifies whether a route is managed by API Gateway. If you created an API using quick create, the $default route is managed by API Gateway.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "api_key_required",
+				Description: "Specifies whether an API key is required for this route. Supported only for WebSocket APIs.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "model_selection_expression",
+				Description: "The model selection expression for the route. Supported only for WebSocket APIs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation_name",
+				Description: "The operation name for the route.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "route_response_selection_expression",
+				Description: "The route response selection expression for the route. Supported only for WebSocket APIs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorization_scopes",
+				Description: "A list of authorization scopes configured on a route. The scopes are used with a JWT authorizer to authorize the method invocation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "request_models",
+				Description: "The request models for the route. Supported only for WebSocket APIs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "request_parameters",
+				Description: "The request parameters for the route. Supported only for WebSocket APIs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RouteKey"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAPIGatewayV2RouteARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAPIGatewayV2Routes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get API details
+	api := h.Item.(types.Api)
+
+	// Minimize API calls when a specific API has been requested
+	if d.KeyColumnQuals["api_id"] != nil && d.KeyColumnQuals["api_id"].GetStringValue() != *api.ApiId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := APIGatewayV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_route.listAPIGatewayV2Routes", "service_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &apigatewayv2.GetRoutesInput{
+		ApiId:      api.ApiId,
+		MaxResults: aws.String(fmt.Sprint(maxLimit)),
+	}
+
+	for pagesLeft {
+		result, err := svc.GetRoutes(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_api_gatewayv2_route.listAPIGatewayV2Routes", "api_error", err)
+			return nil, err
+		}
+
+		for _, route := range result.Items {
+			d.StreamLeafListItem(ctx, routeInfo{route, *api.ApiId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIGatewayV2Route(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := APIGatewayV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_route.getAPIGatewayV2Route", "service_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	api := d.KeyColumnQuals["api_id"].GetStringValue()
+	key := d.KeyColumnQuals["route_id"].GetStringValue()
+	params := &apigatewayv2.GetRouteInput{
+		ApiId:   aws.String(api),
+		RouteId: aws.String(key),
+	}
+
+	item, err := svc.GetRoute(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_route.getAPIGatewayV2Route", "api_error", err)
+		return nil, err
+	}
+
+	if item != nil {
+		route := &types.Route{
+			ApiGatewayManaged:                item.ApiGatewayManaged,
+			ApiKeyRequired:                   item.ApiKeyRequired,
+			AuthorizationScopes:              item.AuthorizationScopes,
+			AuthorizationType:                item.AuthorizationType,
+			AuthorizerId:                     item.AuthorizerId,
+			ModelSelectionExpression:         item.ModelSelectionExpression,
+			OperationName:                    item.OperationName,
+			RequestModels:                    item.RequestModels,
+			RequestParameters:                item.RequestParameters,
+			RouteId:                          item.RouteId,
+			RouteKey:                         item.RouteKey,
+			RouteResponseSelectionExpression: item.RouteResponseSelectionExpression,
+			Target:                           item.Target,
+		}
+		return routeInfo{*route, api}, nil
+	}
+
+	return nil, nil
+}
+
+func getAPIGatewayV2RouteARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(routeInfo)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	arn := "arn:" + commonColumnData.Partition + ":apigateway:" + region + "::/apis/" + data.ApiId + "/routes/" + *data.RouteId
+
+	return arn, nil
+}
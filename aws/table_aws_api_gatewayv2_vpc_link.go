@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAPIGatewayV2VpcLink(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_api_gatewayv2_vpc_link",
+		Description: "AWS API Gateway Version 2 VPC Link",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("vpc_link_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "TooManyRequestsException"}),
+			},
+			Hydrate: getAPIGatewayV2VpcLink,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAPIGatewayV2VpcLinks,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the VPC link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_link_id",
+				Description: "The ID of the VPC link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) specifying the VPC link.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAPIGatewayV2VpcLinkARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "vpc_link_status",
+				Description: "The status of the VPC link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_link_status_message",
+				Description: "A message summarizing the cause of the status of the VPC link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_link_version",
+				Description: "The version of the VPC link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The timestamp when the VPC link was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "A list of security group IDs for the VPC link.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "A list of subnet IDs to include in the VPC link.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAPIGatewayV2VpcLinkARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAPIGatewayV2VpcLinks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := APIGatewayV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_vpc_link.listAPIGatewayV2VpcLinks", "service_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &apigatewayv2.GetVpcLinksInput{
+		MaxResults: aws.String(fmt.Sprint(maxLimit)),
+	}
+
+	for pagesLeft {
+		result, err := svc.GetVpcLinks(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_api_gatewayv2_vpc_link.listAPIGatewayV2VpcLinks", "api_error", err)
+			return nil, err
+		}
+
+		for _, vpcLink := range result.Items {
+			d.StreamListItem(ctx, vpcLink)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIGatewayV2VpcLink(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := APIGatewayV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_vpc_link.getAPIGatewayV2VpcLink", "service_client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	id := d.KeyColumnQuals["vpc_link_id"].GetStringValue()
+	params := &apigatewayv2.GetVpcLinkInput{
+		VpcLinkId: aws.String(id),
+	}
+
+	item, err := svc.GetVpcLink(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_api_gatewayv2_vpc_link.getAPIGatewayV2VpcLink", "api_error", err)
+		return nil, err
+	}
+
+	if item != nil {
+		vpcLink := types.VpcLink{
+			CreatedDate:          item.CreatedDate,
+			Name:                 item.Name,
+			SecurityGroupIds:     item.SecurityGroupIds,
+			SubnetIds:            item.SubnetIds,
+			Tags:                 item.Tags,
+			VpcLinkId:            item.VpcLinkId,
+			VpcLinkStatus:        item.VpcLinkStatus,
+			VpcLinkStatusMessage: item.VpcLinkStatusMessage,
+			VpcLinkVersion:       item.VpcLinkVersion,
+		}
+		return vpcLink, nil
+	}
+
+	return nil, nil
+}
+
+func getAPIGatewayV2VpcLinkARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vpcLink := h.Item.(types.VpcLink)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	arn := "arn:" + commonColumnData.Partition + ":apigateway:" + region + "::/vpclinks/" + *vpcLink.VpcLinkId
+
+	return arn, nil
+}
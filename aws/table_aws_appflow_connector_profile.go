@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appflow"
+	"github.com/aws/aws-sdk-go-v2/service/appflow/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppFlowConnectorProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appflow_connector_profile",
+		Description: "AWS AppFlow Connector Profile",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getAppFlowConnectorProfile,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppFlowConnectorProfiles,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "connector_type", Require: plugin.Optional},
+				{Name: "connector_label", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connector profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectorProfileName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connector profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectorProfileArn"),
+			},
+			{
+				Name:        "connector_type",
+				Description: "The type of connector, such as Salesforce, Amplitude and so on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connector_label",
+				Description: "The label for the connector profile, for custom connectors.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_mode",
+				Description: "Indicates the connection mode and if it is public or private.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "credentials_arn",
+				Description: "The ARN of the connector profile credentials.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "Specifies when the connector profile was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "Specifies when the connector profile was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "connector_profile_properties",
+				Description: "The connector-specific properties of the profile configuration. Credentials are not included.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "private_connection_provisioning_state",
+				Description: "Specifies the private connection provisioning state.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectorProfileName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectorProfileArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppFlowConnectorProfiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AppFlowClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_connector_profile.listAppFlowConnectorProfiles", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &appflow.DescribeConnectorProfilesInput{}
+	if d.KeyColumnQuals["connector_type"] != nil {
+		input.ConnectorType = types.ConnectorType(d.KeyColumnQuals["connector_type"].GetStringValue())
+	}
+	if d.KeyColumnQuals["connector_label"] != nil {
+		input.ConnectorLabel = aws.String(d.KeyColumnQuals["connector_label"].GetStringValue())
+	}
+
+	paginator := appflow.NewDescribeConnectorProfilesPaginator(svc, input, func(o *appflow.DescribeConnectorProfilesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appflow_connector_profile.listAppFlowConnectorProfiles", "api_error", err)
+			return nil, err
+		}
+
+		for _, profile := range output.ConnectorProfileDetails {
+			d.StreamListItem(ctx, profile)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppFlowConnectorProfile(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppFlowClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_connector_profile.getAppFlowConnectorProfile", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appflow.DescribeConnectorProfilesInput{
+		ConnectorProfileNames: []string{name},
+	}
+
+	op, err := svc.DescribeConnectorProfiles(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_connector_profile.getAppFlowConnectorProfile", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.ConnectorProfileDetails) > 0 {
+		return op.ConnectorProfileDetails[0], nil
+	}
+
+	return nil, nil
+}
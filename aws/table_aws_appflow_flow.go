@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appflow"
+	"github.com/aws/aws-sdk-go-v2/service/appflow/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppFlowFlow(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appflow_flow",
+		Description: "AWS AppFlow Flow",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getAppFlowFlow,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppFlowFlows,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the flow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FlowName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the flow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FlowArn"),
+			},
+			{
+				Name:        "description",
+				Description: "A user-entered description of the flow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "flow_status",
+				Description: "Indicates the current status of the flow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "flow_status_message",
+				Description: "Contains an error message if the flow status is in a suspended or error state.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppFlowFlow,
+			},
+			{
+				Name:        "source_connector_type",
+				Description: "The type of the source connector, such as Salesforce, S3 or Slack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_connector_label",
+				Description: "The label of the source connector, for custom connectors.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_connector_type",
+				Description: "The type of the destination connector, such as Redshift, S3 or Snowflake.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_connector_label",
+				Description: "The label of the destination connector, for custom connectors.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "trigger_type",
+				Description: "Specifies the type of flow trigger, either Scheduled, Event or OnDemand.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_arn",
+				Description: "The ARN of the KMS key used to encrypt the data transferred by the flow.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppFlowFlow,
+			},
+			{
+				Name:        "created_at",
+				Description: "Specifies when the flow was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_by",
+				Description: "The ARN of the user who created the flow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "Specifies when the flow was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_by",
+				Description: "Specifies the account user name that most recently updated the flow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_run_execution_status",
+				Description: "The status of the most recent flow run, either InProgress, Successful or Error.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastRunExecutionDetails.MostRecentExecutionStatus"),
+			},
+			{
+				Name:        "last_run_execution_time",
+				Description: "The time of the most recent flow run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastRunExecutionDetails.MostRecentExecutionTime"),
+			},
+			{
+				Name:        "last_run_execution_message",
+				Description: "The message of the most recent flow run.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastRunExecutionDetails.MostRecentExecutionMessage"),
+			},
+			{
+				Name:        "source_flow_config",
+				Description: "The configuration of the source connector of the flow.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppFlowFlow,
+			},
+			{
+				Name:        "destination_flow_config_list",
+				Description: "The configuration of the destination connectors of the flow.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppFlowFlow,
+			},
+			{
+				Name:        "trigger_config",
+				Description: "The trigger settings that determine how and when the flow runs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppFlowFlow,
+			},
+			{
+				Name:        "tasks",
+				Description: "A list of tasks that Amazon AppFlow performs while transferring the data in the flow run.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppFlowFlow,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FlowName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FlowArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppFlowFlows(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AppFlowClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_flow.listAppFlowFlows", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := appflow.NewListFlowsPaginator(svc, &appflow.ListFlowsInput{}, func(o *appflow.ListFlowsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appflow_flow.listAppFlowFlows", "api_error", err)
+			return nil, err
+		}
+
+		for _, flow := range output.Flows {
+			d.StreamListItem(ctx, flow)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppFlowFlow(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := describeFlow(ctx, d, name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	flow := types.FlowDefinition{
+		CreatedAt:               op.CreatedAt,
+		CreatedBy:               op.CreatedBy,
+		Description:             op.Description,
+		FlowArn:                 op.FlowArn,
+		FlowName:                op.FlowName,
+		FlowStatus:              op.FlowStatus,
+		LastRunExecutionDetails: op.LastRunExecutionDetails,
+		LastUpdatedAt:           op.LastUpdatedAt,
+		LastUpdatedBy:           op.LastUpdatedBy,
+		Tags:                    op.Tags,
+	}
+	if op.SourceFlowConfig != nil {
+		flow.SourceConnectorType = op.SourceFlowConfig.ConnectorType
+	}
+	if len(op.DestinationFlowConfigList) > 0 {
+		flow.DestinationConnectorType = op.DestinationFlowConfigList[0].ConnectorType
+	}
+	if op.TriggerConfig != nil {
+		flow.TriggerType = op.TriggerConfig.TriggerType
+	}
+
+	return flow, nil
+}
+
+// describeAppFlowFlow returns the full flow configuration, which is not
+// included in the list response
+func describeAppFlowFlow(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	flow := h.Item.(types.FlowDefinition)
+
+	op, err := describeFlow(ctx, d, *flow.FlowName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func describeFlow(ctx context.Context, d *plugin.QueryData, name string) (*appflow.DescribeFlowOutput, error) {
+	// Create Session
+	svc, err := AppFlowClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_flow.describeFlow", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appflow.DescribeFlowInput{
+		FlowName: aws.String(name),
+	}
+
+	op, err := svc.DescribeFlow(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appflow_flow.describeFlow", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppMeshMesh(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appmesh_mesh",
+		Description: "AWS App Mesh Mesh",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppMeshMesh,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppMeshMeshes,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the service mesh.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the service mesh.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn", "Metadata.Arn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the service mesh.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshMesh,
+				Transform:   transform.FromField("Status.Status"),
+			},
+			{
+				Name:        "mesh_owner",
+				Description: "The AWS IAM account ID of the service mesh owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshOwner", "Metadata.MeshOwner"),
+			},
+			{
+				Name:        "resource_owner",
+				Description: "The AWS IAM account ID of the resource owner. If the account ID is not the mesh owner, the mesh has been shared with the account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceOwner", "Metadata.ResourceOwner"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The Unix epoch timestamp in seconds for when the service mesh was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedAt", "Metadata.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The Unix epoch timestamp in seconds for when the service mesh was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUpdatedAt", "Metadata.LastUpdatedAt"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the service mesh. The version is incremented each time the mesh is updated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Version", "Metadata.Version"),
+			},
+			{
+				Name:        "uid",
+				Description: "The unique identifier for the service mesh.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshMesh,
+				Transform:   transform.FromField("Metadata.Uid"),
+			},
+			{
+				Name:        "egress_filter_type",
+				Description: "The egress filter type of the service mesh. ALLOW_ALL allows egress to any endpoint inside or outside of the mesh, DROP_ALL only allows egress to other resources in the mesh.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshMesh,
+				Transform:   transform.FromField("Spec.EgressFilter.Type"),
+			},
+			{
+				Name:        "service_discovery",
+				Description: "The service discovery information for the service mesh.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshMesh,
+				Transform:   transform.FromField("Spec.ServiceDiscovery"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the service mesh.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue().Transform(appMeshTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn", "Metadata.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppMeshMeshes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_mesh.listAppMeshMeshes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &appmesh.ListMeshesInput{
+		Limit: aws.Int32(maxItems),
+	}
+
+	paginator := appmesh.NewListMeshesPaginator(svc, input, func(o *appmesh.ListMeshesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appmesh_mesh.listAppMeshMeshes", "api_error", err)
+			return nil, err
+		}
+
+		for _, mesh := range output.Meshes {
+			d.StreamListItem(ctx, mesh)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppMeshMesh(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_mesh.getAppMeshMesh", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appmesh.DescribeMeshInput{
+		MeshName: aws.String(name),
+	}
+
+	op, err := svc.DescribeMesh(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_mesh.getAppMeshMesh", "api_error", err)
+		return nil, err
+	}
+
+	return *op.Mesh, nil
+}
+
+// describeAppMeshMesh returns the spec and status of the mesh, which are not
+// included in the list response
+func describeAppMeshMesh(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var params *appmesh.DescribeMeshInput
+	switch item := h.Item.(type) {
+	case types.MeshData:
+		return item, nil
+	case types.MeshRef:
+		params = &appmesh.DescribeMeshInput{
+			MeshName:  item.MeshName,
+			MeshOwner: item.MeshOwner,
+		}
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_mesh.describeAppMeshMesh", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	op, err := svc.DescribeMesh(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_mesh.describeAppMeshMesh", "api_error", err)
+		return nil, err
+	}
+
+	return *op.Mesh, nil
+}
+
+// getAppMeshResourceTags is shared by all App Mesh tables
+func getAppMeshResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := appMeshResourceArn(h.Item)
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh.getAppMeshResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appmesh.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	paginator := appmesh.NewListTagsForResourcePaginator(svc, params, func(o *appmesh.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var tags []types.TagRef
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appmesh.getAppMeshResourceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
+
+func appMeshResourceArn(item interface{}) *string {
+	switch item := item.(type) {
+	case types.MeshRef:
+		return item.Arn
+	case types.MeshData:
+		return item.Metadata.Arn
+	case types.VirtualNodeRef:
+		return item.Arn
+	case types.VirtualNodeData:
+		return item.Metadata.Arn
+	case types.VirtualServiceRef:
+		return item.Arn
+	case types.VirtualServiceData:
+		return item.Metadata.Arn
+	case types.RouteRef:
+		return item.Arn
+	case types.RouteData:
+		return item.Metadata.Arn
+	}
+	return nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func appMeshTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.TagRef)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppMeshRoute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appmesh_route",
+		Description: "AWS App Mesh Route",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"mesh_name", "virtual_router_name", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppMeshRoute,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppMeshMeshes,
+			Hydrate:       listAppMeshRoutes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "mesh_name", Require: plugin.Optional},
+				{Name: "virtual_router_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the route.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RouteName"),
+			},
+			{
+				Name:        "mesh_name",
+				Description: "The name of the service mesh that the route resides in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "virtual_router_name",
+				Description: "The name of the virtual router that the route is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the route.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn", "Metadata.Arn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the route.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Status.Status"),
+			},
+			{
+				Name:        "mesh_owner",
+				Description: "The AWS IAM account ID of the service mesh owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshOwner", "Metadata.MeshOwner"),
+			},
+			{
+				Name:        "resource_owner",
+				Description: "The AWS IAM account ID of the resource owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceOwner", "Metadata.ResourceOwner"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The Unix epoch timestamp in seconds for when the route was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedAt", "Metadata.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The Unix epoch timestamp in seconds for when the route was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUpdatedAt", "Metadata.LastUpdatedAt"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the route. The version is incremented each time the route is updated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Version", "Metadata.Version"),
+			},
+			{
+				Name:        "uid",
+				Description: "The unique identifier for the route.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Metadata.Uid"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority for the route. Routes are matched based on the specified value, where 0 is the highest priority.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Spec.Priority"),
+			},
+			{
+				Name:        "http_route",
+				Description: "The HTTP routing information for the route, including the match criteria, weighted targets, retry policy and timeouts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Spec.HttpRoute"),
+			},
+			{
+				Name:        "http2_route",
+				Description: "The HTTP/2 routing information for the route, including the match criteria, weighted targets, retry policy and timeouts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Spec.Http2Route"),
+			},
+			{
+				Name:        "grpc_route",
+				Description: "The gRPC routing information for the route, including the match criteria, weighted targets, retry policy and timeouts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Spec.GrpcRoute"),
+			},
+			{
+				Name:        "tcp_route",
+				Description: "The TCP routing information for the route, including the weighted targets and timeouts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshRoute,
+				Transform:   transform.FromField("Spec.TcpRoute"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the route.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RouteName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue().Transform(appMeshTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn", "Metadata.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppMeshRoutes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	mesh := h.Item.(types.MeshRef)
+
+	// Minimize API calls when a specific mesh has been requested
+	if d.KeyColumnQuals["mesh_name"] != nil && d.KeyColumnQuals["mesh_name"].GetStringValue() != aws.ToString(mesh.MeshName) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_route.listAppMeshRoutes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Routes belong to virtual routers, so get the virtual routers of the mesh first
+	var routerNames []*string
+	if d.KeyColumnQuals["virtual_router_name"] != nil {
+		routerNames = append(routerNames, aws.String(d.KeyColumnQuals["virtual_router_name"].GetStringValue()))
+	} else {
+		routersInput := &appmesh.ListVirtualRoutersInput{
+			MeshName:  mesh.MeshName,
+			MeshOwner: mesh.MeshOwner,
+			Limit:     aws.Int32(100),
+		}
+
+		routersPaginator := appmesh.NewListVirtualRoutersPaginator(svc, routersInput, func(o *appmesh.ListVirtualRoutersPaginatorOptions) {
+			o.Limit = 100
+			o.StopOnDuplicateToken = true
+		})
+
+		for routersPaginator.HasMorePages() {
+			output, err := routersPaginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_appmesh_route.listAppMeshRoutes", "api_error", err)
+				return nil, err
+			}
+
+			for _, router := range output.VirtualRouters {
+				routerNames = append(routerNames, router.VirtualRouterName)
+			}
+		}
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	for _, routerName := range routerNames {
+		input := &appmesh.ListRoutesInput{
+			MeshName:          mesh.MeshName,
+			MeshOwner:         mesh.MeshOwner,
+			VirtualRouterName: routerName,
+			Limit:             aws.Int32(maxItems),
+		}
+
+		paginator := appmesh.NewListRoutesPaginator(svc, input, func(o *appmesh.ListRoutesPaginatorOptions) {
+			o.Limit = maxItems
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_appmesh_route.listAppMeshRoutes", "api_error", err)
+				return nil, err
+			}
+
+			for _, route := range output.Routes {
+				d.StreamListItem(ctx, route)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppMeshRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	meshName := d.KeyColumnQuals["mesh_name"].GetStringValue()
+	routerName := d.KeyColumnQuals["virtual_router_name"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if mesh name, virtual router name or name is empty
+	if meshName == "" || routerName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_route.getAppMeshRoute", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appmesh.DescribeRouteInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(routerName),
+		RouteName:         aws.String(name),
+	}
+
+	op, err := svc.DescribeRoute(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_route.getAppMeshRoute", "api_error", err)
+		return nil, err
+	}
+
+	return *op.Route, nil
+}
+
+// describeAppMeshRoute returns the spec and status of the route, which are
+// not included in the list response
+func describeAppMeshRoute(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var params *appmesh.DescribeRouteInput
+	switch item := h.Item.(type) {
+	case types.RouteData:
+		return item, nil
+	case types.RouteRef:
+		params = &appmesh.DescribeRouteInput{
+			MeshName:          item.MeshName,
+			MeshOwner:         item.MeshOwner,
+			VirtualRouterName: item.VirtualRouterName,
+			RouteName:         item.RouteName,
+		}
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_route.describeAppMeshRoute", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	op, err := svc.DescribeRoute(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_route.describeAppMeshRoute", "api_error", err)
+		return nil, err
+	}
+
+	return *op.Route, nil
+}
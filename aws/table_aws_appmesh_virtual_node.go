@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppMeshVirtualNode(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appmesh_virtual_node",
+		Description: "AWS App Mesh Virtual Node",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"mesh_name", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppMeshVirtualNode,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppMeshMeshes,
+			Hydrate:       listAppMeshVirtualNodes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "mesh_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the virtual node.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNodeName"),
+			},
+			{
+				Name:        "mesh_name",
+				Description: "The name of the service mesh that the virtual node resides in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the virtual node.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn", "Metadata.Arn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the virtual node.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Status.Status"),
+			},
+			{
+				Name:        "mesh_owner",
+				Description: "The AWS IAM account ID of the service mesh owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshOwner", "Metadata.MeshOwner"),
+			},
+			{
+				Name:        "resource_owner",
+				Description: "The AWS IAM account ID of the resource owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceOwner", "Metadata.ResourceOwner"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The Unix epoch timestamp in seconds for when the virtual node was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedAt", "Metadata.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The Unix epoch timestamp in seconds for when the virtual node was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUpdatedAt", "Metadata.LastUpdatedAt"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the virtual node. The version is incremented each time the virtual node is updated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Version", "Metadata.Version"),
+			},
+			{
+				Name:        "uid",
+				Description: "The unique identifier for the virtual node.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Metadata.Uid"),
+			},
+			{
+				Name:        "listeners",
+				Description: "The listeners that the virtual node is expected to receive inbound traffic from, including their port mappings, health checks, timeouts, connection pools, outlier detection and TLS configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Spec.Listeners"),
+			},
+			{
+				Name:        "backends",
+				Description: "The backends that the virtual node is expected to send outbound traffic to, including the client policy used for each backend.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Spec.Backends"),
+			},
+			{
+				Name:        "backend_defaults",
+				Description: "The default client policy, such as the TLS validation context, applied to all backends of the virtual node.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Spec.BackendDefaults"),
+			},
+			{
+				Name:        "service_discovery",
+				Description: "The service discovery information for the virtual node.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Spec.ServiceDiscovery"),
+			},
+			{
+				Name:        "logging",
+				Description: "The inbound and outbound access logging information for the virtual node.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualNode,
+				Transform:   transform.FromField("Spec.Logging"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the virtual node.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNodeName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue().Transform(appMeshTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn", "Metadata.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppMeshVirtualNodes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	mesh := h.Item.(types.MeshRef)
+
+	// Minimize API calls when a specific mesh has been requested
+	if d.KeyColumnQuals["mesh_name"] != nil && d.KeyColumnQuals["mesh_name"].GetStringValue() != aws.ToString(mesh.MeshName) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_node.listAppMeshVirtualNodes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &appmesh.ListVirtualNodesInput{
+		MeshName:  mesh.MeshName,
+		MeshOwner: mesh.MeshOwner,
+		Limit:     aws.Int32(maxItems),
+	}
+
+	paginator := appmesh.NewListVirtualNodesPaginator(svc, input, func(o *appmesh.ListVirtualNodesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appmesh_virtual_node.listAppMeshVirtualNodes", "api_error", err)
+			return nil, err
+		}
+
+		for _, node := range output.VirtualNodes {
+			d.StreamListItem(ctx, node)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppMeshVirtualNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	meshName := d.KeyColumnQuals["mesh_name"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if mesh name or name is empty
+	if meshName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_node.getAppMeshVirtualNode", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appmesh.DescribeVirtualNodeInput{
+		MeshName:        aws.String(meshName),
+		VirtualNodeName: aws.String(name),
+	}
+
+	op, err := svc.DescribeVirtualNode(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_node.getAppMeshVirtualNode", "api_error", err)
+		return nil, err
+	}
+
+	return *op.VirtualNode, nil
+}
+
+// describeAppMeshVirtualNode returns the spec and status of the virtual node,
+// which are not included in the list response
+func describeAppMeshVirtualNode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var params *appmesh.DescribeVirtualNodeInput
+	switch item := h.Item.(type) {
+	case types.VirtualNodeData:
+		return item, nil
+	case types.VirtualNodeRef:
+		params = &appmesh.DescribeVirtualNodeInput{
+			MeshName:        item.MeshName,
+			MeshOwner:       item.MeshOwner,
+			VirtualNodeName: item.VirtualNodeName,
+		}
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_node.describeAppMeshVirtualNode", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	op, err := svc.DescribeVirtualNode(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_node.describeAppMeshVirtualNode", "api_error", err)
+		return nil, err
+	}
+
+	return *op.VirtualNode, nil
+}
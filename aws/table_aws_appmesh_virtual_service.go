@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppMeshVirtualService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appmesh_virtual_service",
+		Description: "AWS App Mesh Virtual Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"mesh_name", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppMeshVirtualService,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppMeshMeshes,
+			Hydrate:       listAppMeshVirtualServices,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "mesh_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the virtual service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualServiceName"),
+			},
+			{
+				Name:        "mesh_name",
+				Description: "The name of the service mesh that the virtual service resides in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the virtual service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn", "Metadata.Arn"),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the virtual service.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshVirtualService,
+				Transform:   transform.FromField("Status.Status"),
+			},
+			{
+				Name:        "mesh_owner",
+				Description: "The AWS IAM account ID of the service mesh owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeshOwner", "Metadata.MeshOwner"),
+			},
+			{
+				Name:        "resource_owner",
+				Description: "The AWS IAM account ID of the resource owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceOwner", "Metadata.ResourceOwner"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The Unix epoch timestamp in seconds for when the virtual service was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedAt", "Metadata.CreatedAt"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The Unix epoch timestamp in seconds for when the virtual service was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUpdatedAt", "Metadata.LastUpdatedAt"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the virtual service. The version is incremented each time the virtual service is updated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Version", "Metadata.Version"),
+			},
+			{
+				Name:        "uid",
+				Description: "The unique identifier for the virtual service.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeAppMeshVirtualService,
+				Transform:   transform.FromField("Metadata.Uid"),
+			},
+			{
+				Name:        "provider",
+				Description: "The virtual node or virtual router that is the provider for the virtual service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeAppMeshVirtualService,
+				Transform:   transform.FromField("Spec.Provider"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the virtual service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualServiceName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppMeshResourceTags,
+				Transform:   transform.FromValue().Transform(appMeshTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn", "Metadata.Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppMeshVirtualServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	mesh := h.Item.(types.MeshRef)
+
+	// Minimize API calls when a specific mesh has been requested
+	if d.KeyColumnQuals["mesh_name"] != nil && d.KeyColumnQuals["mesh_name"].GetStringValue() != aws.ToString(mesh.MeshName) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_service.listAppMeshVirtualServices", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &appmesh.ListVirtualServicesInput{
+		MeshName:  mesh.MeshName,
+		MeshOwner: mesh.MeshOwner,
+		Limit:     aws.Int32(maxItems),
+	}
+
+	paginator := appmesh.NewListVirtualServicesPaginator(svc, input, func(o *appmesh.ListVirtualServicesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appmesh_virtual_service.listAppMeshVirtualServices", "api_error", err)
+			return nil, err
+		}
+
+		for _, service := range output.VirtualServices {
+			d.StreamListItem(ctx, service)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppMeshVirtualService(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	meshName := d.KeyColumnQuals["mesh_name"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if mesh name or name is empty
+	if meshName == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_service.getAppMeshVirtualService", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appmesh.DescribeVirtualServiceInput{
+		MeshName:           aws.String(meshName),
+		VirtualServiceName: aws.String(name),
+	}
+
+	op, err := svc.DescribeVirtualService(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_service.getAppMeshVirtualService", "api_error", err)
+		return nil, err
+	}
+
+	return *op.VirtualService, nil
+}
+
+// describeAppMeshVirtualService returns the spec and status of the virtual service,
+// which are not included in the list response
+func describeAppMeshVirtualService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var params *appmesh.DescribeVirtualServiceInput
+	switch item := h.Item.(type) {
+	case types.VirtualServiceData:
+		return item, nil
+	case types.VirtualServiceRef:
+		params = &appmesh.DescribeVirtualServiceInput{
+			MeshName:           item.MeshName,
+			MeshOwner:          item.MeshOwner,
+			VirtualServiceName: item.VirtualServiceName,
+		}
+	}
+
+	// Create session
+	svc, err := AppMeshClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_service.describeAppMeshVirtualService", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	op, err := svc.DescribeVirtualService(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appmesh_virtual_service.describeAppMeshVirtualService", "api_error", err)
+		return nil, err
+	}
+
+	return *op.VirtualService, nil
+}
@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type appSyncDataSourceInfo = struct {
+	types.DataSource
+	ApiId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAppSyncDataSource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appsync_data_source",
+		Description: "AWS AppSync Data Source",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"api_id", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppSyncDataSource,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppSyncGraphQLApis,
+			Hydrate:       listAppSyncDataSources,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "api_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_id",
+				Description: "The ID of the API that the data source belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the data source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DataSourceArn"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the data source, such as AWS_LAMBDA, AMAZON_DYNAMODB, AMAZON_OPENSEARCH_SERVICE, NONE, HTTP or RELATIONAL_DATABASE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_role_arn",
+				Description: "The IAM role ARN that AppSync assumes to access the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dynamodb_config",
+				Description: "The DynamoDB configuration of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "elasticsearch_config",
+				Description: "The OpenSearch configuration of a legacy AMAZON_ELASTICSEARCH data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "http_config",
+				Description: "The HTTP endpoint configuration of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "lambda_config",
+				Description: "The Lambda configuration of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "open_search_service_config",
+				Description: "The OpenSearch Service configuration of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "relational_database_config",
+				Description: "The relational database configuration of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DataSourceArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppSyncDataSources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	api := h.Item.(types.GraphqlApi)
+
+	// Minimize API calls when a specific API has been requested
+	if d.KeyColumnQuals["api_id"] != nil && d.KeyColumnQuals["api_id"].GetStringValue() != *api.ApiId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_data_source.listAppSyncDataSources", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &appsync.ListDataSourcesInput{
+		ApiId:      api.ApiId,
+		MaxResults: maxLimit,
+	}
+
+	for pagesLeft {
+		result, err := svc.ListDataSources(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appsync_data_source.listAppSyncDataSources", "api_error", err)
+			return nil, err
+		}
+
+		for _, dataSource := range result.DataSources {
+			d.StreamLeafListItem(ctx, appSyncDataSourceInfo{dataSource, api.ApiId})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppSyncDataSource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	apiID := d.KeyColumnQuals["api_id"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if api id or name is empty
+	if apiID == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_data_source.getAppSyncDataSource", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appsync.GetDataSourceInput{
+		ApiId: aws.String(apiID),
+		Name:  aws.String(name),
+	}
+
+	op, err := svc.GetDataSource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_data_source.getAppSyncDataSource", "api_error", err)
+		return nil, err
+	}
+
+	return appSyncDataSourceInfo{*op.DataSource, aws.String(apiID)}, nil
+}
@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsAppSyncGraphQLApi(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appsync_graphql_api",
+		Description: "AWS AppSync GraphQL API",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("api_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppSyncGraphQLApi,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppSyncGraphQLApis,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The API name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_id",
+				Description: "The API ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication_type",
+				Description: "The primary authentication type of the API, one of API_KEY, AWS_IAM, AMAZON_COGNITO_USER_POOLS, OPENID_CONNECT or AWS_LAMBDA.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_log_level",
+				Description: "The field logging level of the API, one of NONE, ERROR or ALL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogConfig.FieldLogLevel"),
+			},
+			{
+				Name:        "waf_web_acl_arn",
+				Description: "The ARN of the WAF access control list (ACL) associated with this API, if one exists.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "xray_enabled",
+				Description: "A flag indicating whether to use X-Ray tracing for this API.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "additional_authentication_providers",
+				Description: "A list of additional authentication providers for the API.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "api_cache",
+				Description: "The caching configuration of the API, including the caching behavior, TTL and encryption settings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAppSyncGraphQLApiCache,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "lambda_authorizer_config",
+				Description: "The configuration for Lambda function authorization.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "log_config",
+				Description: "The CloudWatch Logs configuration of the API.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "open_id_connect_config",
+				Description: "The OpenID Connect configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenIDConnectConfig"),
+			},
+			{
+				Name:        "uris",
+				Description: "The URIs of the API, keyed by endpoint type.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_pool_config",
+				Description: "The Amazon Cognito user pool configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppSyncGraphQLApis(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_graphql_api.listAppSyncGraphQLApis", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &appsync.ListGraphqlApisInput{
+		MaxResults: maxLimit,
+	}
+
+	for pagesLeft {
+		result, err := svc.ListGraphqlApis(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_appsync_graphql_api.listAppSyncGraphQLApis", "api_error", err)
+			return nil, err
+		}
+
+		for _, api := range result.GraphqlApis {
+			d.StreamListItem(ctx, api)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppSyncGraphQLApi(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	apiID := d.KeyColumnQuals["api_id"].GetStringValue()
+
+	// check if api id is empty
+	if apiID == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_graphql_api.getAppSyncGraphQLApi", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appsync.GetGraphqlApiInput{
+		ApiId: aws.String(apiID),
+	}
+
+	op, err := svc.GetGraphqlApi(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_graphql_api.getAppSyncGraphQLApi", "api_error", err)
+		return nil, err
+	}
+
+	return *op.GraphqlApi, nil
+}
+
+func getAppSyncGraphQLApiCache(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	api := h.Item.(types.GraphqlApi)
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_graphql_api.getAppSyncGraphQLApiCache", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appsync.GetApiCacheInput{
+		ApiId: api.ApiId,
+	}
+
+	op, err := svc.GetApiCache(ctx, params)
+	if err != nil {
+		// APIs without caching enabled return a NotFoundException
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_appsync_graphql_api.getAppSyncGraphQLApiCache", "api_error", err)
+		return nil, err
+	}
+
+	return op.ApiCache, nil
+}
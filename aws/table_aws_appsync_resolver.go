@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type appSyncResolverInfo = struct {
+	types.Resolver
+	ApiId *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAppSyncResolver(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_appsync_resolver",
+		Description: "AWS AppSync Resolver",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"api_id", "type_name", "field_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getAppSyncResolver,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppSyncGraphQLApis,
+			Hydrate:       listAppSyncResolvers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "api_id", Require: plugin.Optional},
+				{Name: "type_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "field_name",
+				Description: "The resolver field name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_name",
+				Description: "The resolver type name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "api_id",
+				Description: "The ID of the API that the resolver belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the resolver.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResolverArn"),
+			},
+			{
+				Name:        "kind",
+				Description: "The resolver type, either UNIT or PIPELINE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_source_name",
+				Description: "The name of the data source used by a UNIT resolver.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_batch_size",
+				Description: "The maximum batching size for the resolver.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "caching_config",
+				Description: "The caching configuration of the resolver, including the TTL and caching keys.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pipeline_config",
+				Description: "The functions of a PIPELINE resolver.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sync_config",
+				Description: "The conflict detection and resolution configuration of the resolver.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "request_mapping_template",
+				Description: "The request mapping template of the resolver.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "response_mapping_template",
+				Description: "The response mapping template of the resolver.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FieldName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResolverArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppSyncResolvers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	api := h.Item.(types.GraphqlApi)
+
+	// Minimize API calls when a specific API has been requested
+	if d.KeyColumnQuals["api_id"] != nil && d.KeyColumnQuals["api_id"].GetStringValue() != *api.ApiId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_resolver.listAppSyncResolvers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Resolvers are attached to the fields of a type, so get the types of the
+	// schema first
+	var typeNames []*string
+	if d.KeyColumnQuals["type_name"] != nil {
+		typeNames = append(typeNames, aws.String(d.KeyColumnQuals["type_name"].GetStringValue()))
+	} else {
+		typesParams := &appsync.ListTypesInput{
+			ApiId:      api.ApiId,
+			Format:     types.TypeDefinitionFormatSdl,
+			MaxResults: 25,
+		}
+
+		for {
+			result, err := svc.ListTypes(ctx, typesParams)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_appsync_resolver.listAppSyncResolvers", "api_error", err)
+				return nil, err
+			}
+
+			for _, t := range result.Types {
+				typeNames = append(typeNames, t.Name)
+			}
+
+			if result.NextToken == nil {
+				break
+			}
+			typesParams.NextToken = result.NextToken
+		}
+	}
+
+	for _, typeName := range typeNames {
+		params := &appsync.ListResolversInput{
+			ApiId:      api.ApiId,
+			TypeName:   typeName,
+			MaxResults: 25,
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			result, err := svc.ListResolvers(ctx, params)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_appsync_resolver.listAppSyncResolvers", "api_error", err)
+				return nil, err
+			}
+
+			for _, resolver := range result.Resolvers {
+				d.StreamLeafListItem(ctx, appSyncResolverInfo{resolver, api.ApiId})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if result.NextToken != nil {
+				params.NextToken = result.NextToken
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppSyncResolver(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	apiID := d.KeyColumnQuals["api_id"].GetStringValue()
+	typeName := d.KeyColumnQuals["type_name"].GetStringValue()
+	fieldName := d.KeyColumnQuals["field_name"].GetStringValue()
+
+	// check if api id, type name or field name is empty
+	if apiID == "" || typeName == "" || fieldName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := AppSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_resolver.getAppSyncResolver", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &appsync.GetResolverInput{
+		ApiId:     aws.String(apiID),
+		TypeName:  aws.String(typeName),
+		FieldName: aws.String(fieldName),
+	}
+
+	op, err := svc.GetResolver(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_appsync_resolver.getAppSyncResolver", "api_error", err)
+		return nil, err
+	}
+
+	return appSyncResolverInfo{*op.Resolver, aws.String(apiID)}, nil
+}
@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type athenaQueryRow struct {
+	WorkGroup        *string
+	Timeout          int64
+	Region           string
+	QueryExecutionId *string
+	RowNumber        int
+	Result           map[string]interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsAthenaQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_athena_query",
+		Description: "AWS Athena Query",
+		List: &plugin.ListConfig{
+			Hydrate: listAthenaQueryResults,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "workgroup", Require: plugin.Optional},
+				{Name: "database", Require: plugin.Optional},
+				{Name: "catalog", Require: plugin.Optional},
+				{Name: "output_location", Require: plugin.Optional},
+				{Name: "timeout", Require: plugin.Optional},
+				{Name: "region", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "query_execution_id",
+				Description: "The unique identifier of the query execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "row_number",
+				Description: "The position of the row in the result set, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "result",
+				Description: "The row returned by the query, as a JSON object keyed by column name. Values are returned as strings, as reported by Athena.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Inputs to the table
+			{
+				Name:        "query",
+				Description: "The SQL query statement to run.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "workgroup",
+				Description: "The name of the workgroup in which the query runs. Defaults to primary.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkGroup"),
+			},
+			{
+				Name:        "database",
+				Description: "The name of the database used in the query execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("database"),
+			},
+			{
+				Name:        "catalog",
+				Description: "The name of the data catalog used in the query execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("catalog"),
+			},
+			{
+				Name:        "output_location",
+				Description: "The Amazon S3 location where query results are stored. Required if the workgroup does not define a result location.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("output_location"),
+			},
+			{
+				Name:        "timeout",
+				Description: "The maximum number of seconds to wait for the query to complete before it is cancelled. Defaults to 300.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region in which the query runs. Defaults to the default region of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listAthenaQueryResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	equalQuals := d.KeyColumnQuals
+
+	region := getDefaultAwsRegion(d)
+	if equalQuals["region"] != nil {
+		region = equalQuals["region"].GetStringValue()
+	}
+
+	timeout := int64(300)
+	if equalQuals["timeout"] != nil {
+		timeout = equalQuals["timeout"].GetInt64Value()
+	}
+
+	// Create Session
+	svc, err := AthenaClient(ctx, d, region)
+	if err != nil {
+		logger.Error("aws_athena_query.listAthenaQueryResults", "connection_error", err)
+		return nil, err
+	}
+
+	input := &athena.StartQueryExecutionInput{
+		QueryString: aws.String(equalQuals["query"].GetStringValue()),
+		WorkGroup:   aws.String("primary"),
+	}
+	if equalQuals["workgroup"] != nil {
+		input.WorkGroup = aws.String(equalQuals["workgroup"].GetStringValue())
+	}
+	if equalQuals["database"] != nil || equalQuals["catalog"] != nil {
+		input.QueryExecutionContext = &types.QueryExecutionContext{}
+		if equalQuals["database"] != nil {
+			input.QueryExecutionContext.Database = aws.String(equalQuals["database"].GetStringValue())
+		}
+		if equalQuals["catalog"] != nil {
+			input.QueryExecutionContext.Catalog = aws.String(equalQuals["catalog"].GetStringValue())
+		}
+	}
+	if equalQuals["output_location"] != nil {
+		input.ResultConfiguration = &types.ResultConfiguration{
+			OutputLocation: aws.String(equalQuals["output_location"].GetStringValue()),
+		}
+	}
+
+	startOutput, err := svc.StartQueryExecution(ctx, input)
+	if err != nil {
+		logger.Error("aws_athena_query.listAthenaQueryResults", "api_error", err)
+		return nil, err
+	}
+
+	execution, err := waitForAthenaQueryExecution(ctx, svc, startOutput.QueryExecutionId, time.Duration(timeout)*time.Second)
+	if err != nil {
+		logger.Error("aws_athena_query.listAthenaQueryResults", "wait_error", err)
+		return nil, err
+	}
+
+	row := athenaQueryRow{
+		WorkGroup:        input.WorkGroup,
+		Timeout:          timeout,
+		Region:           region,
+		QueryExecutionId: startOutput.QueryExecutionId,
+	}
+
+	paginator := athena.NewGetQueryResultsPaginator(svc, &athena.GetQueryResultsInput{QueryExecutionId: startOutput.QueryExecutionId}, func(o *athena.GetQueryResultsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	// The first row returned for a SELECT (DML) query holds the column headers
+	skipHeader := execution.StatementType == types.StatementTypeDml
+	rowNumber := 0
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Error("aws_athena_query.listAthenaQueryResults", "api_error", err)
+			return nil, err
+		}
+
+		if output.ResultSet == nil {
+			continue
+		}
+
+		var columns []types.ColumnInfo
+		if output.ResultSet.ResultSetMetadata != nil {
+			columns = output.ResultSet.ResultSetMetadata.ColumnInfo
+		}
+
+		for _, r := range output.ResultSet.Rows {
+			if skipHeader {
+				skipHeader = false
+				continue
+			}
+
+			rowNumber++
+			result := map[string]interface{}{}
+			for i, datum := range r.Data {
+				name := fmt.Sprintf("column_%d", i+1)
+				if i < len(columns) && columns[i].Name != nil {
+					name = *columns[i].Name
+				}
+				if datum.VarCharValue != nil {
+					result[name] = *datum.VarCharValue
+				} else {
+					result[name] = nil
+				}
+			}
+
+			item := row
+			item.RowNumber = rowNumber
+			item.Result = result
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// waitForAthenaQueryExecution polls GetQueryExecution until the query reaches
+// a terminal state. The query is stopped if it does not complete within the
+// given timeout.
+func waitForAthenaQueryExecution(ctx context.Context, svc *athena.Client, id *string, timeout time.Duration) (*types.QueryExecution, error) {
+	var execution *types.QueryExecution
+	describe := func() (bool, error) {
+		output, err := svc.GetQueryExecution(ctx, &athena.GetQueryExecutionInput{QueryExecutionId: id})
+		if err != nil {
+			return false, err
+		}
+
+		execution = output.QueryExecution
+		if execution == nil || execution.Status == nil {
+			return false, nil
+		}
+		switch execution.Status.State {
+		case types.QueryExecutionStateSucceeded:
+			return true, nil
+		case types.QueryExecutionStateFailed, types.QueryExecutionStateCancelled:
+			return false, fmt.Errorf("%s: %s", execution.Status.State, aws.ToString(execution.Status.StateChangeReason))
+		}
+		return false, nil
+	}
+	// Stop the query so it does not keep scanning data
+	cancel := func() {
+		_, _ = svc.StopQueryExecution(context.Background(), &athena.StopQueryExecutionInput{QueryExecutionId: id})
+	}
+
+	if err := pollUntilTerminal(ctx, timeout, describe, cancel); err != nil {
+		return nil, fmt.Errorf("query %s %v", aws.ToString(id), err)
+	}
+	return execution, nil
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type backupFrameworkControlInfo struct {
+	FrameworkName          *string
+	FrameworkArn           *string
+	DeploymentStatus       *string
+	FrameworkStatus        *string
+	ControlName            *string
+	ControlInputParameters []types.ControlInputParameter
+	ControlScope           *types.ControlScope
+}
+
+//// TABLE DEFINITION
+
+func tableAwsBackupFrameworkControl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_framework_control",
+		Description: "AWS Backup Framework Control",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsBackupFrameworks,
+			Hydrate:       listAwsBackupFrameworkControls,
+			KeyColumns:    plugin.OptionalColumns([]string{"framework_name"}),
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "control_name",
+				Description: "The name of the control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "framework_name",
+				Description: "The unique name of the backup framework the control belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "framework_arn",
+				Description: "The Amazon Resource Name (ARN) of the backup framework the control belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_status",
+				Description: "The deployment status of the backup framework (CREATE_IN_PROGRESS | UPDATE_IN_PROGRESS | DELETE_IN_PROGRESS | COMPLETED | FAILED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "framework_status",
+				Description: "The framework status based on recording statuses for resources governed by the framework (ACTIVE | PARTIALLY_ACTIVE | INACTIVE | UNAVAILABLE).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "control_input_parameters",
+				Description: "A list of name/value pairs used to evaluate the control.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "control_scope",
+				Description: "The scope of the control, such as the resource IDs, resource types and tags it evaluates.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ControlName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupFrameworkControls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	framework := h.Item.(types.Framework)
+
+	// Minimize the API call with the given framework name
+	if d.KeyColumnQuals["framework_name"] != nil {
+		if d.KeyColumnQuals["framework_name"].GetStringValue() != *framework.FrameworkName {
+			return nil, nil
+		}
+	}
+
+	// Create session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_framework_control.listAwsBackupFrameworkControls", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &backup.DescribeFrameworkInput{
+		FrameworkName: framework.FrameworkName,
+	}
+
+	op, err := svc.DescribeFramework(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_framework_control.listAwsBackupFrameworkControls", "api_error", err)
+		return nil, err
+	}
+
+	for _, control := range op.FrameworkControls {
+		d.StreamListItem(ctx, backupFrameworkControlInfo{
+			FrameworkName:          op.FrameworkName,
+			FrameworkArn:           op.FrameworkArn,
+			DeploymentStatus:       op.DeploymentStatus,
+			FrameworkStatus:        op.FrameworkStatus,
+			ControlName:            control.ControlName,
+			ControlInputParameters: control.ControlInputParameters,
+			ControlScope:           control.ControlScope,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
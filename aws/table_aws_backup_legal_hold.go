@@ -164,7 +164,7 @@ func getAwsBackupLegalHold(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	var id string
 	if h.Item != nil {
 		backupHold := h.Item.(types.LegalHold)
-		id         = *backupHold.LegalHoldId
+		id = *backupHold.LegalHoldId
 	} else {
 		id = d.KeyColumnQuals["legal_hold_id"].GetStringValue()
 	}
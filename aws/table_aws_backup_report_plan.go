@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupReportPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_report_plan",
+		Description: "AWS Backup Report Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("report_plan_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterValueException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getAwsBackupReportPlan,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsBackupReportPlans,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "report_plan_name",
+				Description: "The unique name of the report plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "An Amazon Resource Name (ARN) that uniquely identifies the report plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReportPlanArn"),
+			},
+			{
+				Name:        "report_plan_description",
+				Description: "An optional description of the report plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_status",
+				Description: "The deployment status of the report plan (CREATE_IN_PROGRESS | UPDATE_IN_PROGRESS | DELETE_IN_PROGRESS | COMPLETED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the report plan was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_attempted_execution_time",
+				Description: "The date and time that a report job associated with this report plan last attempted to run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_successful_execution_time",
+				Description: "The date and time that a report job associated with this report plan last successfully ran.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "report_template",
+				Description: "Identifies the report template for the report. Reports are built using a report template.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReportSetting.ReportTemplate"),
+			},
+			{
+				Name:        "report_delivery_channel",
+				Description: "Contains information about where and how to deliver the reports, specifically the Amazon S3 bucket name, S3 key prefix, and the formats of the reports.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "report_setting",
+				Description: "Identifies the report template for the report, and the frameworks, accounts, organizational units and regions it covers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsBackupReportPlanTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReportPlanName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ReportPlanArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupReportPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_report_plan.listAwsBackupReportPlans", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &backup.ListReportPlansInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := backup.NewListReportPlansPaginator(svc, input, func(o *backup.ListReportPlansPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_report_plan.listAwsBackupReportPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ReportPlans {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsBackupReportPlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["report_plan_name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_report_plan.getAwsBackupReportPlan", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &backup.DescribeReportPlanInput{
+		ReportPlanName: aws.String(name),
+	}
+
+	op, err := svc.DescribeReportPlan(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_report_plan.getAwsBackupReportPlan", "api_error", err)
+		return nil, err
+	}
+
+	if op.ReportPlan == nil {
+		return nil, nil
+	}
+
+	return *op.ReportPlan, nil
+}
+
+func listAwsBackupReportPlanTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_report_plan.listAwsBackupReportPlanTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	plan := h.Item.(types.ReportPlan)
+
+	// Build the params
+	params := &backup.ListTagsInput{
+		ResourceArn: plan.ReportPlanArn,
+		MaxResults:  aws.Int32(1000),
+	}
+
+	paginator := backup.NewListTagsPaginator(svc, params, func(o *backup.ListTagsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	tags := make(map[string]string)
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_report_plan.listAwsBackupReportPlanTags", "api_error", err)
+			return nil, err
+		}
+
+		for k, v := range output.Tags {
+			tags[k] = v
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupRestoreTestingPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_restore_testing_plan",
+		Description: "AWS Backup Restore Testing Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("restore_testing_plan_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterValueException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getAwsBackupRestoreTestingPlan,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsBackupRestoreTestingPlans,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "restore_testing_plan_name",
+				Description: "The name of the restore testing plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "An Amazon Resource Name (ARN) that uniquely identifies the restore testing plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestoreTestingPlanArn"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the restore testing plan was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_execution_time",
+				Description: "The last time a restore test was run with the restore testing plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The date and time that the restore testing plan was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "A CRON expression in specified timezone when a restore testing plan is executed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "schedule_expression_timezone",
+				Description: "The timezone in which the schedule expression is set. By default, ScheduleExpressions are in UTC.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_window_hours",
+				Description: "Defaults to 24 hours. A value in hours after a restore test is scheduled before a job will be canceled if it doesn't start successfully.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request and allows failed requests to be retried without the risk of running the operation twice.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRestoreTestingPlan,
+			},
+			{
+				Name:        "recovery_point_selection",
+				Description: "The specified criteria to assign a set of resources, such as recovery point types or backup vaults.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingPlan,
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsBackupRestoreTestingPlanTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestoreTestingPlanName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RestoreTestingPlanArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupRestoreTestingPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.listAwsBackupRestoreTestingPlans", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &backup.ListRestoreTestingPlansInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := backup.NewListRestoreTestingPlansPaginator(svc, input, func(o *backup.ListRestoreTestingPlansPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.listAwsBackupRestoreTestingPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.RestoreTestingPlans {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsBackupRestoreTestingPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.getAwsBackupRestoreTestingPlan", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.RestoreTestingPlanForList).RestoreTestingPlanName
+	} else {
+		name = d.KeyColumnQuals["restore_testing_plan_name"].GetStringValue()
+	}
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	params := &backup.GetRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(name),
+	}
+
+	op, err := svc.GetRestoreTestingPlan(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.getAwsBackupRestoreTestingPlan", "api_error", err)
+		return nil, err
+	}
+
+	return *op.RestoreTestingPlan, nil
+}
+
+func listAwsBackupRestoreTestingPlanTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.listAwsBackupRestoreTestingPlanTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.RestoreTestingPlanForList:
+		arn = item.RestoreTestingPlanArn
+	case types.RestoreTestingPlanForGet:
+		arn = item.RestoreTestingPlanArn
+	}
+
+	// Build the params
+	params := &backup.ListTagsInput{
+		ResourceArn: arn,
+		MaxResults:  aws.Int32(1000),
+	}
+
+	paginator := backup.NewListTagsPaginator(svc, params, func(o *backup.ListTagsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	tags := make(map[string]string)
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_restore_testing_plan.listAwsBackupRestoreTestingPlanTags", "api_error", err)
+			return nil, err
+		}
+
+		for k, v := range output.Tags {
+			tags[k] = v
+		}
+	}
+
+	return tags, nil
+}
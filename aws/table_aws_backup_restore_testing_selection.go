@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBackupRestoreTestingSelection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_backup_restore_testing_selection",
+		Description: "AWS Backup Restore Testing Selection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"restore_testing_plan_name", "restore_testing_selection_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameterValueException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getAwsBackupRestoreTestingSelection,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsBackupRestoreTestingPlans,
+			Hydrate:       listAwsBackupRestoreTestingSelections,
+			KeyColumns:    plugin.OptionalColumns([]string{"restore_testing_plan_name"}),
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "restore_testing_selection_name",
+				Description: "The unique name of the restore testing selection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "restore_testing_plan_name",
+				Description: "The name of the restore testing plan the selection belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the restore testing selection was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "iam_role_arn",
+				Description: "The ARN of the IAM role that AWS Backup uses to create the target resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "protected_resource_type",
+				Description: "The type of Amazon Web Services resource included in the restore testing selection; for example, an Amazon EBS volume or an Amazon RDS database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "validation_window_hours",
+				Description: "The amount of hours available to run a validation script on the data before the restored resource is deleted.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request and allows failed requests to be retried without the risk of running the operation twice.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+			{
+				Name:        "protected_resource_arns",
+				Description: "The ARNs of the protected resources included in the restore testing selection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+			{
+				Name:        "protected_resource_conditions",
+				Description: "The conditions, in the form of tag key-value pairs, used to filter the protected resources included in the restore testing selection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+			{
+				Name:        "restore_metadata_overrides",
+				Description: "The restore metadata keys and values that override the values inferred from the recovery point when the restore job runs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsBackupRestoreTestingSelection,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestoreTestingSelectionName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsBackupRestoreTestingSelections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plan := h.Item.(types.RestoreTestingPlanForList)
+
+	// Minimize the API call with the given plan name
+	if d.KeyColumnQuals["restore_testing_plan_name"] != nil {
+		if d.KeyColumnQuals["restore_testing_plan_name"].GetStringValue() != *plan.RestoreTestingPlanName {
+			return nil, nil
+		}
+	}
+
+	// Create session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_selection.listAwsBackupRestoreTestingSelections", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &backup.ListRestoreTestingSelectionsInput{
+		RestoreTestingPlanName: plan.RestoreTestingPlanName,
+		MaxResults:             aws.Int32(maxLimit),
+	}
+
+	paginator := backup.NewListRestoreTestingSelectionsPaginator(svc, input, func(o *backup.ListRestoreTestingSelectionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_backup_restore_testing_selection.listAwsBackupRestoreTestingSelections", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.RestoreTestingSelections {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsBackupRestoreTestingSelection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := BackupClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_selection.getAwsBackupRestoreTestingSelection", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	var planName, selectionName string
+	if h.Item != nil {
+		selection := h.Item.(types.RestoreTestingSelectionForList)
+		planName = *selection.RestoreTestingPlanName
+		selectionName = *selection.RestoreTestingSelectionName
+	} else {
+		planName = d.KeyColumnQuals["restore_testing_plan_name"].GetStringValue()
+		selectionName = d.KeyColumnQuals["restore_testing_selection_name"].GetStringValue()
+	}
+
+	// Return nil, if no input provided
+	if planName == "" || selectionName == "" {
+		return nil, nil
+	}
+
+	params := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(selectionName),
+	}
+
+	op, err := svc.GetRestoreTestingSelection(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_backup_restore_testing_selection.getAwsBackupRestoreTestingSelection", "api_error", err)
+		return nil, err
+	}
+
+	return *op.RestoreTestingSelection, nil
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBillingConductorBillingGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_billingconductor_billing_group",
+		Description: "AWS Billing Conductor Billing Group",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingConductorBillingGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "pricing_plan_arn", Require: plugin.Optional},
+				{Name: "billing_period", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the billing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Number (ARN) that can be used to uniquely identify the billing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the billing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "primary_account_id",
+				Description: "The account ID that serves as the main account in a billing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pricing_plan_arn",
+				Description: "The Amazon Resource Name (ARN) of the pricing plan used to compute the Amazon Web Services charges for the billing group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComputationPreference.PricingPlanArn"),
+			},
+			{
+				Name:        "size",
+				Description: "The number of accounts in the particular billing group.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The billing group status. Possible values are ACTIVE and PRIMARY_ACCOUNT_MISSING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "The reason why the billing group is in its current status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the billing group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The most recent time when the billing group was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "billing_period",
+				Description: "The preferred billing period to get billing groups, in YYYY-MM format. Defaults to the current billing period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("billing_period"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBillingConductorResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingConductorBillingGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BillingConductorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor_billing_group.listBillingConductorBillingGroups", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &billingconductor.ListBillingGroupsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if billingPeriod := d.KeyColumnQualString("billing_period"); billingPeriod != "" {
+		input.BillingPeriod = aws.String(billingPeriod)
+	}
+
+	filter := &types.ListBillingGroupsFilter{}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		filter.Arns = []string{arn}
+		input.Filters = filter
+	}
+	if pricingPlan := d.KeyColumnQualString("pricing_plan_arn"); pricingPlan != "" {
+		filter.PricingPlan = aws.String(pricingPlan)
+		input.Filters = filter
+	}
+
+	paginator := billingconductor.NewListBillingGroupsPaginator(svc, input, func(o *billingconductor.ListBillingGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_billingconductor_billing_group.listBillingConductorBillingGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.BillingGroups {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getBillingConductorResourceTags is shared by all Billing Conductor tables
+func getBillingConductorResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.BillingGroupListElement:
+		arn = item.Arn
+	case types.PricingPlanListElement:
+		arn = item.Arn
+	case types.PricingRuleListElement:
+		arn = item.Arn
+	case types.CustomLineItemListElement:
+		arn = item.Arn
+	}
+
+	// Create session
+	svc, err := BillingConductorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor.getBillingConductorResourceTags", "connection_error", err)
+		return nil, err
+	}
+
+	params := &billingconductor.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor.getBillingConductorResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBillingConductorCustomLineItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_billingconductor_custom_line_item",
+		Description: "AWS Billing Conductor Custom Line Item",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingConductorCustomLineItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "billing_group_arn", Require: plugin.Optional},
+				{Name: "billing_period", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The custom line item's name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Names (ARNs) for custom line items.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The custom line item's description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_group_arn",
+				Description: "The Amazon Resource Name (ARN) that references the billing group where the custom line item applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "charge_type",
+				Description: "The type of the custom line item that indicates whether the charge is a fee or credit.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ChargeDetails.Type"),
+			},
+			{
+				Name:        "currency_code",
+				Description: "The custom line item's charge value currency.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_code",
+				Description: "The product code that's associated with the custom line item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_size",
+				Description: "The number of resources that are associated to the custom line item.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The most recent time when the custom line item was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "charge_details",
+				Description: "A ListCustomLineItemChargeDetails that describes the charge details of a custom line item.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "billing_period",
+				Description: "The preferred billing period to get custom line items, in YYYY-MM format. Defaults to the current billing period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("billing_period"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBillingConductorResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingConductorCustomLineItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BillingConductorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor_custom_line_item.listBillingConductorCustomLineItems", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &billingconductor.ListCustomLineItemsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if billingPeriod := d.KeyColumnQualString("billing_period"); billingPeriod != "" {
+		input.BillingPeriod = aws.String(billingPeriod)
+	}
+
+	filter := &types.ListCustomLineItemsFilter{}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		filter.Arns = []string{arn}
+		input.Filters = filter
+	}
+	if name := d.KeyColumnQualString("name"); name != "" {
+		filter.Names = []string{name}
+		input.Filters = filter
+	}
+	if billingGroup := d.KeyColumnQualString("billing_group_arn"); billingGroup != "" {
+		filter.BillingGroups = []string{billingGroup}
+		input.Filters = filter
+	}
+
+	paginator := billingconductor.NewListCustomLineItemsPaginator(svc, input, func(o *billingconductor.ListCustomLineItemsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_billingconductor_custom_line_item.listBillingConductorCustomLineItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.CustomLineItems {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBillingConductorPricingPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_billingconductor_pricing_plan",
+		Description: "AWS Billing Conductor Pricing Plan",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingConductorPricingPlans,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "billing_period", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of a pricing plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The pricing plan Amazon Resource Names (ARN).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The pricing plan description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "size",
+				Description: "The pricing rules count that's currently associated with this pricing plan list element.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the pricing plan was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The most recent time when the pricing plan was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "billing_period",
+				Description: "The preferred billing period to get pricing plans, in YYYY-MM format. Defaults to the current billing period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("billing_period"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBillingConductorResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingConductorPricingPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BillingConductorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor_pricing_plan.listBillingConductorPricingPlans", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &billingconductor.ListPricingPlansInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if billingPeriod := d.KeyColumnQualString("billing_period"); billingPeriod != "" {
+		input.BillingPeriod = aws.String(billingPeriod)
+	}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		input.Filters = &types.ListPricingPlansFilter{
+			Arns: []string{arn},
+		}
+	}
+
+	paginator := billingconductor.NewListPricingPlansPaginator(svc, input, func(o *billingconductor.ListPricingPlansPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_billingconductor_pricing_plan.listBillingConductorPricingPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.PricingPlans {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
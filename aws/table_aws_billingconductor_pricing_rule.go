@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor"
+	"github.com/aws/aws-sdk-go-v2/service/billingconductor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBillingConductorPricingRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_billingconductor_pricing_rule",
+		Description: "AWS Billing Conductor Pricing Rule",
+		List: &plugin.ListConfig{
+			Hydrate: listBillingConductorPricingRules,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "billing_period", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of a pricing rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) used to uniquely identify a pricing rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The pricing rule description.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of pricing rule that indicates if it is globally applicable, or if it is service-specific. Possible values are GLOBAL, SERVICE, BILLING_ENTITY and SKU.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of pricing rule. Possible values are MARKUP, DISCOUNT and TIERING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "modifier_percentage",
+				Description: "A percentage modifier applied on the public pricing rates.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "service",
+				Description: "If the scope attribute is SERVICE, this attribute indicates which service the pricing rule is applicable for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associated_pricing_plan_count",
+				Description: "The pricing plans count that this pricing rule is associated with.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the pricing rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreationTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The most recent time when the pricing rule was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedTime").Transform(transform.UnixToTimestamp),
+			},
+			{
+				Name:        "billing_period",
+				Description: "The preferred billing period to get pricing rules, in YYYY-MM format. Defaults to the current billing period.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("billing_period"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBillingConductorResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingConductorPricingRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BillingConductorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_billingconductor_pricing_rule.listBillingConductorPricingRules", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &billingconductor.ListPricingRulesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if billingPeriod := d.KeyColumnQualString("billing_period"); billingPeriod != "" {
+		input.BillingPeriod = aws.String(billingPeriod)
+	}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		input.Filters = &types.ListPricingRulesFilter{
+			Arns: []string{arn},
+		}
+	}
+
+	paginator := billingconductor.NewListPricingRulesPaginator(svc, input, func(o *billingconductor.ListPricingRulesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_billingconductor_pricing_rule.listBillingConductorPricingRules", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.PricingRules {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
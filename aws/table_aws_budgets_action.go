@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsBudgetsAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_budgets_action",
+		Description: "AWS Budgets Action",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"budget_name", "action_id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "InvalidParameterException"}),
+			},
+			Hydrate: getBudgetsAction,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBudgetsActions,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "action_id",
+				Description: "A system-generated universally unique identifier (UUID) for the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "budget_name",
+				Description: "A string that represents the budget name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the budget action.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBudgetsActionArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "action_type",
+				Description: "The type of action. This defines the type of tasks that can be carried out by this action. Possible values are APPLY_IAM_POLICY, APPLY_SCP_POLICY and RUN_SSM_DOCUMENTS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "approval_model",
+				Description: "This specifies if the action needs manual or automatic approval.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notification_type",
+				Description: "The type of a notification. Possible values are ACTUAL and FORECASTED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action_threshold_value",
+				Description: "The threshold of a notification.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ActionThreshold.ActionThresholdValue"),
+			},
+			{
+				Name:        "action_threshold_type",
+				Description: "The type of threshold for a notification. Possible values are PERCENTAGE and ABSOLUTE_VALUE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionThreshold.ActionThresholdType"),
+			},
+			{
+				Name:        "execution_role_arn",
+				Description: "The role passed for action execution and reversion.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "definition",
+				Description: "Where you specify all of the type-specific parameters.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subscribers",
+				Description: "A list of subscribers.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "action_histories",
+				Description: "The historical records for the budget action, such as status changes and executions.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listBudgetsActionHistories,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBudgetsActionArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBudgetsActions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActions", "connection_error", err)
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActions", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &budgets.DescribeBudgetActionsForAccountInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := budgets.NewDescribeBudgetActionsForAccountPaginator(svc, input, func(o *budgets.DescribeBudgetActionsForAccountPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActions", "api_error", err)
+			return nil, err
+		}
+
+		for _, action := range output.Actions {
+			d.StreamListItem(ctx, action)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBudgetsAction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	budgetName := d.KeyColumnQuals["budget_name"].GetStringValue()
+	actionId := d.KeyColumnQuals["action_id"].GetStringValue()
+
+	// check if budget name or action id is empty
+	if budgetName == "" || actionId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.getBudgetsAction", "connection_error", err)
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.getBudgetsAction", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	params := &budgets.DescribeBudgetActionInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		BudgetName: aws.String(budgetName),
+		ActionId:   aws.String(actionId),
+	}
+
+	op, err := svc.DescribeBudgetAction(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.getBudgetsAction", "api_error", err)
+		return nil, err
+	}
+
+	if op == nil || op.Action == nil {
+		return nil, nil
+	}
+
+	return *op.Action, nil
+}
+
+func listBudgetsActionHistories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	action := h.Item.(types.Action)
+
+	// Create session
+	svc, err := BudgetsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActionHistories", "connection_error", err)
+		return nil, err
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActionHistories", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	input := &budgets.DescribeBudgetActionHistoriesInput{
+		AccountId:  aws.String(commonColumnData.AccountId),
+		BudgetName: action.BudgetName,
+		ActionId:   action.ActionId,
+	}
+
+	paginator := budgets.NewDescribeBudgetActionHistoriesPaginator(svc, input, func(o *budgets.DescribeBudgetActionHistoriesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var histories []types.ActionHistory
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_budgets_action.listBudgetsActionHistories", "api_error", err)
+			return nil, err
+		}
+		histories = append(histories, output.ActionHistories...)
+	}
+
+	return histories, nil
+}
+
+func getBudgetsActionArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	action := h.Item.(types.Action)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_budgets_action.getBudgetsActionArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Budget actions don't have an ARN in the API response, so build it
+	arn := "arn:" + commonColumnData.Partition + ":budgets::" + commonColumnData.AccountId + ":budget/" + *action.BudgetName + "/action/" + *action.ActionId
+
+	return arn, nil
+}
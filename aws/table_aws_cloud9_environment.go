@@ -0,0 +1,348 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloud9"
+	"github.com/aws/aws-sdk-go-v2/service/cloud9/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloud9Environment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloud9_environment",
+		Description: "AWS Cloud9 Environment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getCloud9Environment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloud9Environments,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description for the environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of environment. Valid values are ec2 and ssh.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_type",
+				Description: "The connection type used for connecting to an Amazon EC2 environment. Valid values are CONNECT_SSH and CONNECT_SSM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_arn",
+				Description: "The Amazon Resource Name (ARN) of the environment owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_status",
+				Description: "The current creation or deletion lifecycle state of the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Lifecycle.Status"),
+			},
+			{
+				Name:        "lifecycle_reason",
+				Description: "Any informational message about the lifecycle state of the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Lifecycle.Reason"),
+			},
+			{
+				Name:        "managed_credentials_status",
+				Description: "Describes the status of Amazon Web Services managed temporary credentials for the Cloud9 environment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the environment. Valid values are connecting, creating, deleting, error, ready, stopped and stopping.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloud9EnvironmentStatus,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "status_message",
+				Description: "Any informational message about the status of the environment.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloud9EnvironmentStatus,
+				Transform:   transform.FromField("Message"),
+			},
+			{
+				Name:        "instance_id",
+				Description: "The ID of the Amazon EC2 instance that Cloud9 created for the environment.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloud9EnvironmentInstance,
+				Transform:   transform.FromField("InstanceId"),
+			},
+			{
+				Name:        "instance_state",
+				Description: "The current state of the Amazon EC2 instance that Cloud9 created for the environment.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloud9EnvironmentInstance,
+				Transform:   transform.FromField("State.Name"),
+			},
+			{
+				Name:        "lifecycle",
+				Description: "Information about the current creation or deletion lifecycle state of the environment.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloud9EnvironmentTags,
+				Transform:   transform.FromValue().Transform(cloud9EnvironmentTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloud9Environments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := Cloud9Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.listCloud9Environments", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// DescribeEnvironments api can take maximum 25 number of environment IDs at a time
+	maxLimit := int32(25)
+
+	input := &cloud9.ListEnvironmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := cloud9.NewListEnvironmentsPaginator(svc, input, func(o *cloud9.ListEnvironmentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloud9_environment.listCloud9Environments", "api_error", err)
+			return nil, err
+		}
+
+		if len(output.EnvironmentIds) == 0 {
+			continue
+		}
+
+		// ListEnvironments only returns IDs, so get the details of the environments in the page
+		result, err := svc.DescribeEnvironments(ctx, &cloud9.DescribeEnvironmentsInput{
+			EnvironmentIds: output.EnvironmentIds,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloud9_environment.listCloud9Environments", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Environments {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloud9Environment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := Cloud9Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9Environment", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &cloud9.DescribeEnvironmentsInput{
+		EnvironmentIds: []string{id},
+	}
+
+	op, err := svc.DescribeEnvironments(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9Environment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Environments) > 0 {
+		return op.Environments[0], nil
+	}
+	return nil, nil
+}
+
+func getCloud9EnvironmentStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	environment := h.Item.(types.Environment)
+
+	// Create Session
+	svc, err := Cloud9Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentStatus", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &cloud9.DescribeEnvironmentStatusInput{
+		EnvironmentId: environment.Id,
+	}
+
+	op, err := svc.DescribeEnvironmentStatus(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentStatus", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloud9EnvironmentInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	environment := h.Item.(types.Environment)
+
+	// Only EC2 environments have an instance managed by Cloud9
+	if environment.Type != types.EnvironmentTypeEc2 {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentInstance", "connection_error", err)
+		return nil, err
+	}
+
+	// Cloud9 tags the instances it creates with the ID of the environment
+	params := &ec2.DescribeInstancesInput{
+		Filters: []ec2Types.Filter{
+			{
+				Name:   aws.String("tag:aws:cloud9:environment"),
+				Values: []string{*environment.Id},
+			},
+		},
+	}
+
+	op, err := svc.DescribeInstances(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentInstance", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Reservations) > 0 && len(op.Reservations[0].Instances) > 0 {
+		return op.Reservations[0].Instances[0], nil
+	}
+	return nil, nil
+}
+
+func getCloud9EnvironmentTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	environment := h.Item.(types.Environment)
+
+	// Create Session
+	svc, err := Cloud9Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &cloud9.ListTagsForResourceInput{
+		ResourceARN: environment.Arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloud9_environment.getCloud9EnvironmentTags", "api_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloud9EnvironmentTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.HydrateItem.([]types.Tag)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tagList != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -2,14 +2,17 @@ package aws
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 
+	go_kit_packs "github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+	"gopkg.in/yaml.v3"
 )
 
 //// TABLE DEFINITION
@@ -141,6 +144,34 @@ func tableAwsCloudFormationStack(_ context.Context) *plugin.Table {
 				Hydrate:     getStackTemplate,
 				Transform:   transform.FromField("TemplateBody").Transform(transform.UnmarshalYAML),
 			},
+			{
+				Name:        "template_processed_json",
+				Description: "The processed template, with any transforms such as AWS::Serverless applied, parsed into a json object. Short form intrinsic functions in YAML templates are converted to their long form.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStackProcessedTemplate,
+				Transform:   transform.FromField("TemplateBody").TransformP(cfnTemplateSectionToJSON, ""),
+			},
+			{
+				Name:        "template_resources",
+				Description: "The Resources section of the processed template.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStackProcessedTemplate,
+				Transform:   transform.FromField("TemplateBody").TransformP(cfnTemplateSectionToJSON, "Resources"),
+			},
+			{
+				Name:        "template_parameters",
+				Description: "The Parameters section of the processed template.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStackProcessedTemplate,
+				Transform:   transform.FromField("TemplateBody").TransformP(cfnTemplateSectionToJSON, "Parameters"),
+			},
+			{
+				Name:        "template_outputs",
+				Description: "The Outputs section of the processed template.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStackProcessedTemplate,
+				Transform:   transform.FromField("TemplateBody").TransformP(cfnTemplateSectionToJSON, "Outputs"),
+			},
 			{
 				Name:        "resources",
 				Description: "A list of Stack resource structures.",
@@ -284,6 +315,35 @@ func getStackTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return stackTemplate, nil
 }
 
+func getStackProcessedTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	stack := h.Item.(types.Stack)
+
+	// Create Session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack.getStackProcessedTemplate", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// The processed template has any transforms, such as AWS::Serverless, applied
+	params := &cloudformation.GetTemplateInput{
+		StackName:     stack.StackName,
+		TemplateStage: types.TemplateStageProcessed,
+	}
+	stackTemplate, err := svc.GetTemplate(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack.getStackProcessedTemplate", "api_error", err)
+		return nil, err
+	}
+
+	return stackTemplate, nil
+}
+
 func describeStackResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	stack := h.Item.(types.Stack)
 
@@ -326,3 +386,104 @@ func cfnStackTagsToTurbotTags(_ context.Context, d *transform.TransformData) (in
 	}
 	return turbotTagsMap, nil
 }
+
+// cfnTemplateSectionToJSON parses a JSON or YAML template and returns the
+// section named by the transform param, or the whole template if it is empty
+func cfnTemplateSectionToJSON(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	body := go_kit_packs.SafeString(d.Value)
+	if body == "" {
+		return nil, nil
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(body), &node); err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack.cfnTemplateSectionToJSON", "parse_error", err)
+		return nil, err
+	}
+
+	template, err := cfnYAMLNodeToValue(&node)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack.cfnTemplateSectionToJSON", "parse_error", err)
+		return nil, err
+	}
+
+	section := d.Param.(string)
+	if section == "" {
+		return template, nil
+	}
+	if templateMap, ok := template.(map[string]interface{}); ok {
+		return templateMap[section], nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// cfnYAMLNodeToValue converts a YAML node into plain Go values, rewriting
+// short form intrinsic functions such as !Ref or !GetAtt into the long form
+// used by JSON templates
+func cfnYAMLNodeToValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return cfnYAMLNodeToValue(node.Content[0])
+	case yaml.AliasNode:
+		return cfnYAMLNodeToValue(node.Alias)
+	}
+
+	// Local tags (a single !) are CloudFormation intrinsic functions
+	if strings.HasPrefix(node.Tag, "!") && !strings.HasPrefix(node.Tag, "!!") {
+		function := strings.TrimPrefix(node.Tag, "!")
+		key := "Fn::" + function
+		if function == "Ref" || function == "Condition" {
+			key = function
+		}
+
+		untagged := *node
+		untagged.Tag = ""
+		value, err := cfnYAMLNodeToValue(&untagged)
+		if err != nil {
+			return nil, err
+		}
+
+		// !GetAtt accepts a dotted string, while Fn::GetAtt takes a list
+		if attribute, ok := value.(string); ok && function == "GetAtt" {
+			value = strings.SplitN(attribute, ".", 2)
+		}
+
+		return map[string]interface{}{key: value}, nil
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		result := map[string]interface{}{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := cfnYAMLNodeToValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			result[node.Content[i].Value] = value
+		}
+		return result, nil
+	case yaml.SequenceNode:
+		result := []interface{}{}
+		for _, item := range node.Content {
+			value, err := cfnYAMLNodeToValue(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	}
+
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
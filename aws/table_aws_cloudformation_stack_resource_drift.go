@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudFormationStackResourceDriftInfo = struct {
+	types.StackResourceDrift
+	StackName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFormationStackResourceDrift(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudformation_stack_resource_drift",
+		Description: "AWS CloudFormation Stack Resource Drift",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudFormationStacks,
+			Hydrate:       listCloudFormationStackResourceDrifts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stack_name", Require: plugin.Optional},
+				{Name: "stack_resource_drift_status", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationError"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "logical_resource_id",
+				Description: "The logical name of the resource specified in the template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "physical_resource_id",
+				Description: "The name or unique identifier that corresponds to a physical instance ID of a resource supported by CloudFormation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_name",
+				Description: "The name of the stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_id",
+				Description: "The ID of the stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_resource_drift_status",
+				Description: "Status of the resource's actual configuration compared to its expected configuration. Possible values are DELETED, MODIFIED, IN_SYNC and NOT_CHECKED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "Time at which CloudFormation performed drift detection on the stack resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expected_properties",
+				Description: "A JSON structure containing the expected property values of the stack resource, as defined in the stack template and any values specified as template parameters.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExpectedProperties").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "actual_properties",
+				Description: "A JSON structure containing the actual property values of the stack resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ActualProperties").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "property_differences",
+				Description: "A collection of the resource properties whose actual values differ from their expected values.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "physical_resource_id_context",
+				Description: "Context information that enables CloudFormation to uniquely identify a resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "module_info",
+				Description: "Contains information about the module from which the resource was created, if the resource was created from a module included in the stack template.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogicalResourceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFormationStackResourceDrifts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	stack := h.Item.(types.Stack)
+
+	// Minimize API calls when a specific stack has been requested
+	if name := d.KeyColumnQualString("stack_name"); name != "" && name != *stack.StackName {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_resource_drift.listCloudFormationStackResourceDrifts", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName:  stack.StackName,
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if status := d.KeyColumnQualString("stack_resource_drift_status"); status != "" {
+		input.StackResourceDriftStatusFilters = []types.StackResourceDriftStatus{types.StackResourceDriftStatus(status)}
+	}
+
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(svc, input, func(o *cloudformation.DescribeStackResourceDriftsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudformation_stack_resource_drift.listCloudFormationStackResourceDrifts", "api_error", err)
+			return nil, err
+		}
+
+		for _, drift := range output.StackResourceDrifts {
+			d.StreamLeafListItem(ctx, cloudFormationStackResourceDriftInfo{drift, stack.StackName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudFormationStackSetInstanceInfo = struct {
+	types.StackInstanceSummary
+	StackSetName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFormationStackSetInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudformation_stack_set_instance",
+		Description: "AWS CloudFormation StackSet Instance",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudFormationStackSets,
+			Hydrate:       listCloudFormationStackSetInstances,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stack_set_name", Require: plugin.Optional},
+				{Name: "stack_instance_account", Require: plugin.Optional},
+				{Name: "stack_instance_region", Require: plugin.Optional},
+				{Name: "detailed_status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "stack_set_name",
+				Description: "The name of the StackSet that the stack instance is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_set_id",
+				Description: "The name or unique ID of the StackSet that the stack instance is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_id",
+				Description: "The ID of the stack instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_instance_account",
+				Description: "The name of the Amazon Web Services account that the stack instance is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account"),
+			},
+			{
+				Name:        "stack_instance_region",
+				Description: "The name of the Amazon Web Services Region that the stack instance is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "organizational_unit_id",
+				Description: "The organization root ID or organizational unit (OU) IDs that you specified for DeploymentTargets.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the stack instance, in terms of its synchronization with its associated StackSet. Possible values are CURRENT, OUTDATED and INOPERABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detailed_status",
+				Description: "The detailed status of the stack instance. Possible values are CANCELLED, FAILED, INOPERABLE, PENDING, RUNNING, SKIPPED_SUSPENDED_ACCOUNT and SUCCEEDED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackInstanceStatus.DetailedStatus"),
+			},
+			{
+				Name:        "status_reason",
+				Description: "The explanation for the specific status code assigned to this stack instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drift_status",
+				Description: "Status of the stack instance's actual configuration compared to the expected template and parameter configuration of the StackSet it belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_drift_check_timestamp",
+				Description: "Most recent time when CloudFormation performed a drift detection operation on the stack instance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_operation_id",
+				Description: "The last unique ID of a StackSet operation performed on a stack instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameter_overrides",
+				Description: "A list of parameters from the StackSet template whose values have been overridden in this stack instance.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSetInstance,
+				Transform:   transform.FromField("ParameterOverrides"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StackId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StackId").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listCloudFormationStackSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Minimize API calls when a specific StackSet has been requested
+	if name := d.KeyColumnQualString("stack_set_name"); name != "" {
+		d.StreamListItem(ctx, types.StackSetSummary{StackSetName: aws.String(name)})
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.listCloudFormationStackSets", "connection_error", err)
+		return nil, err
+	}
+
+	input := &cloudformation.ListStackSetsInput{
+		MaxResults: aws.Int32(100),
+		Status:     types.StackSetStatusActive,
+	}
+
+	paginator := cloudformation.NewListStackSetsPaginator(svc, input, func(o *cloudformation.ListStackSetsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.listCloudFormationStackSets", "api_error", err)
+			return nil, err
+		}
+
+		for _, stackSet := range output.Summaries {
+			d.StreamListItem(ctx, stackSet)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listCloudFormationStackSetInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	stackSet := h.Item.(types.StackSetSummary)
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.listCloudFormationStackSetInstances", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &cloudformation.ListStackInstancesInput{
+		StackSetName: stackSet.StackSetName,
+		MaxResults:   aws.Int32(maxLimit),
+	}
+	if account := d.KeyColumnQualString("stack_instance_account"); account != "" {
+		input.StackInstanceAccount = aws.String(account)
+	}
+	if region := d.KeyColumnQualString("stack_instance_region"); region != "" {
+		input.StackInstanceRegion = aws.String(region)
+	}
+	if status := d.KeyColumnQualString("detailed_status"); status != "" {
+		input.Filters = []types.StackInstanceFilter{
+			{
+				Name:   types.StackInstanceFilterNameDetailedStatus,
+				Values: aws.String(status),
+			},
+		}
+	}
+
+	paginator := cloudformation.NewListStackInstancesPaginator(svc, input, func(o *cloudformation.ListStackInstancesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.listCloudFormationStackSetInstances", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range output.Summaries {
+			d.StreamLeafListItem(ctx, cloudFormationStackSetInstanceInfo{instance, stackSet.StackSetName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFormationStackSetInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(cloudFormationStackSetInstanceInfo)
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.getCloudFormationStackSetInstance", "connection_error", err)
+		return nil, err
+	}
+
+	params := &cloudformation.DescribeStackInstanceInput{
+		StackSetName:         instance.StackSetName,
+		StackInstanceAccount: instance.Account,
+		StackInstanceRegion:  instance.Region,
+	}
+
+	op, err := svc.DescribeStackInstance(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_instance.getCloudFormationStackSetInstance", "api_error", err)
+		return nil, err
+	}
+
+	return op.StackInstance, nil
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudFormationStackSetOperationInfo = struct {
+	types.StackSetOperationSummary
+	StackSetName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFormationStackSetOperation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudformation_stack_set_operation",
+		Description: "AWS CloudFormation StackSet Operation",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudFormationStackSets,
+			Hydrate:       listCloudFormationStackSetOperations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stack_set_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "operation_id",
+				Description: "The unique ID of the StackSet operation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stack_set_name",
+				Description: "The name of the StackSet that the operation was performed on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "The type of operation: CREATE, UPDATE, DELETE or DETECT_DRIFT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The overall status of the operation. Possible values are FAILED, QUEUED, RUNNING, STOPPING, STOPPED and SUCCEEDED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "The status of the operation in details.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The time at which the operation was initiated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_timestamp",
+				Description: "The time at which the StackSet operation ended, across all accounts and Regions specified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "failed_stack_instances_count",
+				Description: "The number of stack instances for which the StackSet operation failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StatusDetails.FailedStackInstancesCount"),
+			},
+			{
+				Name:        "operation_preferences",
+				Description: "The user-specified preferences for how CloudFormation performs a StackSet operation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "administration_role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role used to perform this StackSet operation.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSetOperation,
+			},
+			{
+				Name:        "execution_role_name",
+				Description: "The name of the IAM execution role used to create or update the StackSet.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFormationStackSetOperation,
+			},
+			{
+				Name:        "retain_stacks",
+				Description: "For stack set operations of action type DELETE, specifies whether to remove the stack instances from the specified stack set, but doesn't delete the stacks.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCloudFormationStackSetOperation,
+			},
+			{
+				Name:        "deployment_targets",
+				Description: "The Organizations accounts affected by the stack operation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSetOperation,
+			},
+			{
+				Name:        "stack_set_drift_detection_details",
+				Description: "Detailed information about the drift status of the StackSet, for drift detection operations.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFormationStackSetOperation,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OperationId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFormationStackSetOperations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	stackSet := h.Item.(types.StackSetSummary)
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_operation.listCloudFormationStackSetOperations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &cloudformation.ListStackSetOperationsInput{
+		StackSetName: stackSet.StackSetName,
+		MaxResults:   aws.Int32(maxLimit),
+	}
+
+	paginator := cloudformation.NewListStackSetOperationsPaginator(svc, input, func(o *cloudformation.ListStackSetOperationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudformation_stack_set_operation.listCloudFormationStackSetOperations", "api_error", err)
+			return nil, err
+		}
+
+		for _, operation := range output.Summaries {
+			d.StreamLeafListItem(ctx, cloudFormationStackSetOperationInfo{operation, stackSet.StackSetName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFormationStackSetOperation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	operation := h.Item.(cloudFormationStackSetOperationInfo)
+
+	// Create session
+	svc, err := CloudFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_operation.getCloudFormationStackSetOperation", "connection_error", err)
+		return nil, err
+	}
+
+	params := &cloudformation.DescribeStackSetOperationInput{
+		StackSetName: operation.StackSetName,
+		OperationId:  operation.OperationId,
+	}
+
+	op, err := svc.DescribeStackSetOperation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudformation_stack_set_operation.getCloudFormationStackSetOperation", "api_error", err)
+		return nil, err
+	}
+
+	return op.StackSetOperation, nil
+}
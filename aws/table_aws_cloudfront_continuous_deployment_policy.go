@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontContinuousDeploymentPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_continuous_deployment_policy",
+		Description: "AWS CloudFront Continuous Deployment Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchContinuousDeploymentPolicy"}),
+			},
+			Hydrate: getCloudFrontContinuousDeploymentPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontContinuousDeploymentPolicies,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The identifier of the continuous deployment policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the continuous deployment policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontContinuousDeploymentPolicyARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the continuous deployment policy is enabled. When enabled, CloudFront sends traffic to the staging distribution according to the traffic configuration.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ContinuousDeploymentPolicyConfig.Enabled"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time the continuous deployment policy was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "traffic_config_type",
+				Description: "The type of traffic configuration, either SingleWeight or SingleHeader.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContinuousDeploymentPolicyConfig.TrafficConfig.Type"),
+			},
+			{
+				Name:        "staging_distribution_dns_names",
+				Description: "The CloudFront domain names of the staging distributions that the policy sends traffic to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContinuousDeploymentPolicyConfig.StagingDistributionDnsNames.Items"),
+			},
+			{
+				Name:        "traffic_config",
+				Description: "The traffic configuration of the policy, including the header or the weight and session stickiness used to route requests to the staging distribution.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContinuousDeploymentPolicyConfig.TrafficConfig"),
+			},
+			{
+				Name:        "etag",
+				Description: "The current version of the continuous deployment policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontContinuousDeploymentPolicyETag,
+				Transform:   transform.FromField("ETag"),
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFrontContinuousDeploymentPolicyARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontContinuousDeploymentPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_continuous_deployment_policy.listCloudFrontContinuousDeploymentPolicies", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfront.ListContinuousDeploymentPoliciesInput{
+		MaxItems: &maxItems,
+	}
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		data, err := svc.ListContinuousDeploymentPolicies(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_continuous_deployment_policy.listCloudFrontContinuousDeploymentPolicies", "api_error", err)
+			return nil, err
+		}
+
+		if data.ContinuousDeploymentPolicyList == nil {
+			break
+		}
+
+		for _, summary := range data.ContinuousDeploymentPolicyList.Items {
+			if summary.ContinuousDeploymentPolicy == nil {
+				continue
+			}
+			d.StreamListItem(ctx, *summary.ContinuousDeploymentPolicy)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if data.ContinuousDeploymentPolicyList.NextMarker != nil {
+			input.Marker = data.ContinuousDeploymentPolicyList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontContinuousDeploymentPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	op, err := getCloudFrontContinuousDeploymentPolicyOutput(ctx, d, d.KeyColumnQuals["id"].GetStringValue())
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_continuous_deployment_policy.getCloudFrontContinuousDeploymentPolicy", "api_error", err)
+		return nil, err
+	}
+
+	if op == nil || op.ContinuousDeploymentPolicy == nil {
+		return nil, nil
+	}
+	return *op.ContinuousDeploymentPolicy, nil
+}
+
+// The ETag is only returned by the get call, so it is fetched separately for
+// listed policies
+func getCloudFrontContinuousDeploymentPolicyETag(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(types.ContinuousDeploymentPolicy)
+
+	op, err := getCloudFrontContinuousDeploymentPolicyOutput(ctx, d, aws.ToString(policy.Id))
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_continuous_deployment_policy.getCloudFrontContinuousDeploymentPolicyETag", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudFrontContinuousDeploymentPolicyOutput(ctx context.Context, d *plugin.QueryData, id string) (*cloudfront.GetContinuousDeploymentPolicyOutput, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &cloudfront.GetContinuousDeploymentPolicyInput{
+		Id: aws.String(id),
+	}
+
+	return svc.GetContinuousDeploymentPolicy(ctx, params)
+}
+
+func getCloudFrontContinuousDeploymentPolicyARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(types.ContinuousDeploymentPolicy)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_continuous_deployment_policy.getCloudFrontContinuousDeploymentPolicyARN", "common_data_error", err)
+		return nil, err
+	}
+
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":cloudfront::" + commonColumnData.AccountId + ":continuous-deployment-policy/" + aws.ToString(policy.Id)
+
+	return arn, nil
+}
@@ -2,8 +2,11 @@ package aws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
@@ -11,6 +14,19 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
 )
 
+type cloudFrontFunctionCode struct {
+	Code       string
+	CodeSize   int
+	CodeSha256 string
+}
+
+type cloudFrontFunctionDistribution struct {
+	DistributionId  *string
+	DistributionArn *string
+	PathPattern     *string
+	EventType       types.EventType
+}
+
 //// TABLE DEFINITION
 
 func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
@@ -26,8 +42,10 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudWatchFunctions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "stage", Require: plugin.Optional},
+			},
 		},
-		GetMatrixItemFunc: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -41,6 +59,24 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("FunctionMetadata.FunctionARN", "FunctionSummary.FunctionMetadata.FunctionARN"),
 			},
+			{
+				Name:        "stage",
+				Description: "The stage that the function is in, either DEVELOPMENT or LIVE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionMetadata.Stage", "FunctionSummary.FunctionMetadata.Stage"),
+			},
+			{
+				Name:        "runtime",
+				Description: "The function's runtime environment, such as cloudfront-js-1.0.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Runtime", "FunctionSummary.FunctionConfig.Runtime"),
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the function.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionConfig.Comment", "FunctionSummary.FunctionConfig.Comment"),
+			},
 			{
 				Name:        "status",
 				Description: "The status of the CloudFront function.",
@@ -48,6 +84,18 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("Status", "FunctionSummary.Status"),
 				Hydrate:     getCloudFrontFunction,
 			},
+			{
+				Name:        "created_time",
+				Description: "The date and time when the function was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.CreatedTime", "FunctionSummary.FunctionMetadata.CreatedTime"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the function was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FunctionMetadata.LastModifiedTime", "FunctionSummary.FunctionMetadata.LastModifiedTime"),
+			},
 			{
 				Name:        "e_tag",
 				Description: "The version identifier for the current version of the CloudFront function.",
@@ -55,6 +103,31 @@ func tableAwsCloudFrontFunction(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("ETag", "FunctionSummary.ETag"),
 				Hydrate:     getCloudFrontFunction,
 			},
+			{
+				Name:        "code",
+				Description: "The code of the function in this stage.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontFunctionCode,
+			},
+			{
+				Name:        "code_size",
+				Description: "The size of the function code in this stage, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCloudFrontFunctionCode,
+			},
+			{
+				Name:        "code_sha256",
+				Description: "The SHA-256 hash of the function code in this stage. Compare the DEVELOPMENT and LIVE values to find functions with unpublished changes.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontFunctionCode,
+			},
+			{
+				Name:        "distributions",
+				Description: "The distribution cache behaviors the function is associated with, including the path pattern and event type of each association. Only set for the LIVE stage.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCloudFrontFunctionDistributions,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "function_config",
 				Description: "Contains configuration information about a CloudFront function.",
@@ -113,6 +186,11 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 		MaxItems: &maxItems,
 	}
 
+	// Functions are listed once per stage unless a stage is specified
+	if d.KeyColumnQuals["stage"] != nil {
+		input.Stage = types.FunctionStage(d.KeyColumnQuals["stage"].GetStringValue())
+	}
+
 	// Paginator not available for the API
 	pagesLeft := true
 	for pagesLeft {
@@ -125,6 +203,11 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 
 		for _, function := range data.FunctionList.Items {
 			d.StreamListItem(ctx, function)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
 		}
 
 		if data.FunctionList.NextMarker != nil {
@@ -143,10 +226,14 @@ func listCloudWatchFunctions(ctx context.Context, d *plugin.QueryData, h *plugin
 func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	var name string
+	var stage types.FunctionStage
 
 	if h.Item != nil {
 		function_summary := h.Item.(types.FunctionSummary)
 		name = *function_summary.Name
+		if function_summary.FunctionMetadata != nil {
+			stage = function_summary.FunctionMetadata.Stage
+		}
 	} else {
 		name = d.KeyColumnQuals["name"].GetStringValue()
 	}
@@ -162,9 +249,11 @@ func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		return nil, err
 	}
 
-	// Build the params
+	// Build the params, describing the stage of the listed row rather than the
+	// default DEVELOPMENT stage
 	params := &cloudfront.DescribeFunctionInput{
-		Name: &name,
+		Name:  &name,
+		Stage: stage,
 	}
 
 	// Get call
@@ -178,4 +267,140 @@ func getCloudFrontFunction(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return *data, nil
 }
 
-//// TRANSFORM FUNCTION
+func getCloudFrontFunctionCode(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	summary := cloudFrontFunctionSummary(h.Item)
+	if summary == nil {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_function.getCloudFrontFunctionCode", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.GetFunctionInput{
+		Name: summary.Name,
+	}
+	if summary.FunctionMetadata != nil {
+		params.Stage = summary.FunctionMetadata.Stage
+	}
+
+	data, err := svc.GetFunction(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_function.getCloudFrontFunctionCode", "api_error", err)
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data.FunctionCode)
+	return cloudFrontFunctionCode{
+		Code:       string(data.FunctionCode),
+		CodeSize:   len(data.FunctionCode),
+		CodeSha256: hex.EncodeToString(hash[:]),
+	}, nil
+}
+
+func listCloudFrontFunctionDistributions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	summary := cloudFrontFunctionSummary(h.Item)
+
+	// Only published functions can be associated with a distribution
+	if summary == nil || summary.FunctionMetadata == nil || summary.FunctionMetadata.Stage != types.FunctionStageLive {
+		return nil, nil
+	}
+	functionArn := aws.ToString(summary.FunctionMetadata.FunctionARN)
+
+	distributions, err := listCloudFrontDistributionSummariesCached(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_function.listCloudFrontFunctionDistributions", "api_error", err)
+		return nil, err
+	}
+
+	var associations []cloudFrontFunctionDistribution
+	for _, distribution := range distributions {
+		if distribution.DefaultCacheBehavior != nil && distribution.DefaultCacheBehavior.FunctionAssociations != nil {
+			for _, association := range distribution.DefaultCacheBehavior.FunctionAssociations.Items {
+				if aws.ToString(association.FunctionARN) == functionArn {
+					associations = append(associations, cloudFrontFunctionDistribution{
+						DistributionId:  distribution.Id,
+						DistributionArn: distribution.ARN,
+						PathPattern:     aws.String("Default (*)"),
+						EventType:       association.EventType,
+					})
+				}
+			}
+		}
+		if distribution.CacheBehaviors != nil {
+			for _, behavior := range distribution.CacheBehaviors.Items {
+				if behavior.FunctionAssociations == nil {
+					continue
+				}
+				for _, association := range behavior.FunctionAssociations.Items {
+					if aws.ToString(association.FunctionARN) == functionArn {
+						associations = append(associations, cloudFrontFunctionDistribution{
+							DistributionId:  distribution.Id,
+							DistributionArn: distribution.ARN,
+							PathPattern:     behavior.PathPattern,
+							EventType:       association.EventType,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return associations, nil
+}
+
+// listCloudFrontDistributionSummariesCached lists the distributions once per
+// connection so that each function row does not list them again
+func listCloudFrontDistributionSummariesCached(ctx context.Context, d *plugin.QueryData) ([]types.DistributionSummary, error) {
+	cacheKey := "listCloudFrontDistributionSummaries"
+
+	// if found in cache, return the result
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]types.DistributionSummary), nil
+	}
+
+	// Create service
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudfront.ListDistributionsInput{
+		MaxItems: aws.Int32(1000),
+	}
+
+	paginator := cloudfront.NewListDistributionsPaginator(svc, input, func(o *cloudfront.ListDistributionsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	var distributions []types.DistributionSummary
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if output.DistributionList != nil {
+			distributions = append(distributions, output.DistributionList.Items...)
+		}
+	}
+
+	// save to extension cache
+	d.ConnectionManager.Cache.Set(cacheKey, distributions)
+	return distributions, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func cloudFrontFunctionSummary(item interface{}) *types.FunctionSummary {
+	switch item := item.(type) {
+	case types.FunctionSummary:
+		return &item
+	case cloudfront.DescribeFunctionOutput:
+		return item.FunctionSummary
+	}
+	return nil
+}
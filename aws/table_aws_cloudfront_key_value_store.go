@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontKeyValueStore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_key_value_store",
+		Description: "AWS CloudFront Key Value Store",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFound"}),
+			},
+			Hydrate: getCloudFrontKeyValueStore,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontKeyValueStores,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the key value store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the key value store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the key value store.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the key value store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "A comment for the key value store.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last-modified time of the key value store.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created",
+				Description: "The date and time that the key value store was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeCloudFrontKeyValueStoreData,
+			},
+			{
+				Name:        "item_count",
+				Description: "The number of key value pairs in the key value store.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeCloudFrontKeyValueStoreData,
+			},
+			{
+				Name:        "total_size_in_bytes",
+				Description: "The total size of the key value pairs in the key value store, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeCloudFrontKeyValueStoreData,
+			},
+			{
+				Name:        "etag",
+				Description: "The current version of the key value store's data.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeCloudFrontKeyValueStoreData,
+				Transform:   transform.FromField("ETag"),
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontKeyValueStores(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.listCloudFrontKeyValueStores", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfront.ListKeyValueStoresInput{
+		MaxItems: &maxItems,
+	}
+
+	if d.KeyColumnQuals["status"] != nil {
+		status := d.KeyColumnQuals["status"].GetStringValue()
+		input.Status = &status
+	}
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		data, err := svc.ListKeyValueStores(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.listCloudFrontKeyValueStores", "api_error", err)
+			return nil, err
+		}
+
+		if data.KeyValueStoreList == nil {
+			break
+		}
+
+		for _, store := range data.KeyValueStoreList.Items {
+			d.StreamListItem(ctx, store)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if data.KeyValueStoreList.NextMarker != nil {
+			input.Marker = data.KeyValueStoreList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontKeyValueStore(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+	if strings.TrimSpace(name) == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.getCloudFrontKeyValueStore", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.DescribeKeyValueStoreInput{
+		Name: &name,
+	}
+
+	op, err := svc.DescribeKeyValueStore(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.getCloudFrontKeyValueStore", "api_error", err)
+		return nil, err
+	}
+
+	if op.KeyValueStore == nil {
+		return nil, nil
+	}
+	return *op.KeyValueStore, nil
+}
+
+// describeCloudFrontKeyValueStoreData gets the item count and size of the
+// store from the CloudFront KeyValueStore data plane API
+func describeCloudFrontKeyValueStoreData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.KeyValueStore)
+
+	// Get client
+	svc, err := CloudFrontKeyValueStoreClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.describeCloudFrontKeyValueStoreData", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: store.ARN,
+	}
+
+	op, err := svc.DescribeKeyValueStore(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store.describeCloudFrontKeyValueStoreData", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudFrontKeyValueStoreKey struct {
+	KeyValueStoreName *string
+	KeyValueStoreArn  *string
+	Key               *string
+	Value             *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontKeyValueStoreKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_key_value_store_key",
+		Description: "AWS CloudFront Key Value Store Key",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudFrontKeyValueStores,
+			Hydrate:       listCloudFrontKeyValueStoreKeys,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "key_value_store_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "key_value_store_name",
+				Description: "The name of the key value store that contains the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_value_store_arn",
+				Description: "The Amazon Resource Name (ARN) of the key value store that contains the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the key value pair.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the key value pair.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontKeyValueStoreKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	store := h.Item.(types.KeyValueStore)
+
+	// Minimize API calls when a specific key value store has been requested
+	if d.KeyColumnQuals["key_value_store_name"] != nil && d.KeyColumnQuals["key_value_store_name"].GetStringValue() != aws.ToString(store.Name) {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontKeyValueStoreClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_key_value_store_key.listCloudFrontKeyValueStoreKeys", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(50)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN:     store.ARN,
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := cloudfrontkeyvaluestore.NewListKeysPaginator(svc, input, func(o *cloudfrontkeyvaluestore.ListKeysPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_key_value_store_key.listCloudFrontKeyValueStoreKeys", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, cloudFrontKeyValueStoreKey{
+				KeyValueStoreName: store.Name,
+				KeyValueStoreArn:  store.ARN,
+				Key:               item.Key,
+				Value:             item.Value,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudFrontOriginAccessControlOrigin struct {
+	DistributionId  *string
+	DistributionArn *string
+	OriginId        *string
+	DomainName      *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontOriginAccessControl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_origin_access_control",
+		Description: "AWS CloudFront Origin Access Control",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchOriginAccessControl"}),
+			},
+			Hydrate: getCloudFrontOriginAccessControl,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontOriginAccessControls,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier of the origin access control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id", "OriginAccessControl.Id"),
+			},
+			{
+				Name:        "name",
+				Description: "A unique name that identifies the origin access control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "OriginAccessControl.OriginAccessControlConfig.Name"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) specifying the origin access control.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontOriginAccessControlARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the origin access control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description", "OriginAccessControl.OriginAccessControlConfig.Description"),
+			},
+			{
+				Name:        "origin_access_control_origin_type",
+				Description: "The type of origin that this origin access control is for, such as s3.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginAccessControlOriginType", "OriginAccessControl.OriginAccessControlConfig.OriginAccessControlOriginType"),
+			},
+			{
+				Name:        "signing_behavior",
+				Description: "Specifies which requests CloudFront signs, one of always, never or no-override.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SigningBehavior", "OriginAccessControl.OriginAccessControlConfig.SigningBehavior"),
+			},
+			{
+				Name:        "signing_protocol",
+				Description: "The signing protocol of the origin access control, which determines how CloudFront signs (authenticates) requests.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SigningProtocol", "OriginAccessControl.OriginAccessControlConfig.SigningProtocol"),
+			},
+			{
+				Name:        "etag",
+				Description: "The current version of the origin access control's information.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCloudFrontOriginAccessControl,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "origins",
+				Description: "The distribution origins that use the origin access control.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCloudFrontOriginAccessControlOrigins,
+				Transform:   transform.FromValue(),
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "OriginAccessControl.OriginAccessControlConfig.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudFrontOriginAccessControlARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontOriginAccessControls(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.listCloudFrontOriginAccessControls", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfront.ListOriginAccessControlsInput{
+		MaxItems: &maxItems,
+	}
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		data, err := svc.ListOriginAccessControls(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.listCloudFrontOriginAccessControls", "api_error", err)
+			return nil, err
+		}
+
+		if data.OriginAccessControlList == nil {
+			break
+		}
+
+		for _, control := range data.OriginAccessControlList.Items {
+			d.StreamListItem(ctx, control)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if data.OriginAccessControlList.NextMarker != nil {
+			input.Marker = data.OriginAccessControlList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontOriginAccessControl(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var controlID string
+	if h.Item != nil {
+		controlID = aws.ToString(originAccessControlID(h.Item))
+	} else {
+		controlID = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	if strings.TrimSpace(controlID) == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.getCloudFrontOriginAccessControl", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.GetOriginAccessControlInput{
+		Id: &controlID,
+	}
+
+	op, err := svc.GetOriginAccessControl(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.getCloudFrontOriginAccessControl", "api_error", err)
+		return nil, err
+	}
+
+	return *op, nil
+}
+
+func listCloudFrontOriginAccessControlOrigins(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	controlID := aws.ToString(originAccessControlID(h.Item))
+
+	distributions, err := listCloudFrontDistributionSummariesCached(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.listCloudFrontOriginAccessControlOrigins", "api_error", err)
+		return nil, err
+	}
+
+	var origins []cloudFrontOriginAccessControlOrigin
+	for _, distribution := range distributions {
+		if distribution.Origins == nil {
+			continue
+		}
+		for _, origin := range distribution.Origins.Items {
+			if aws.ToString(origin.OriginAccessControlId) == controlID {
+				origins = append(origins, cloudFrontOriginAccessControlOrigin{
+					DistributionId:  distribution.Id,
+					DistributionArn: distribution.ARN,
+					OriginId:        origin.Id,
+					DomainName:      origin.DomainName,
+				})
+			}
+		}
+	}
+
+	return origins, nil
+}
+
+func getCloudFrontOriginAccessControlARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	controlID := aws.ToString(originAccessControlID(h.Item))
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_origin_access_control.getCloudFrontOriginAccessControlARN", "common_data_error", err)
+		return nil, err
+	}
+
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":cloudfront::" + commonColumnData.AccountId + ":origin-access-control/" + controlID
+
+	return arn, nil
+}
+
+func originAccessControlID(item interface{}) *string {
+	switch item := item.(type) {
+	case cloudfront.GetOriginAccessControlOutput:
+		if item.OriginAccessControl != nil {
+			return item.OriginAccessControl.Id
+		}
+	case types.OriginAccessControlSummary:
+		return item.Id
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudFrontRealtimeLogConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudfront_realtime_log_config",
+		Description: "AWS CloudFront Realtime Log Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NoSuchRealtimeLogConfig"}),
+			},
+			Hydrate: getCloudFrontRealtimeLogConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFrontRealtimeLogConfigs,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique name of the real-time log configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the real-time log configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "sampling_rate",
+				Description: "The sampling rate, the percentage of viewer requests that are represented in the real-time log data. A number from 1 to 100.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "fields",
+				Description: "The fields that are included in each real-time log record.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "end_points",
+				Description: "The Kinesis data streams where real-time log data is sent, including the IAM role that CloudFront uses to write to each stream.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "distributions",
+				Description: "The distributions that use the real-time log configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCloudFrontRealtimeLogConfigDistributions,
+				Transform:   transform.FromValue(),
+			},
+
+			//  Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudFrontRealtimeLogConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.listCloudFrontRealtimeLogConfigs", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &cloudfront.ListRealtimeLogConfigsInput{
+		MaxItems: &maxItems,
+	}
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		data, err := svc.ListRealtimeLogConfigs(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.listCloudFrontRealtimeLogConfigs", "api_error", err)
+			return nil, err
+		}
+
+		if data.RealtimeLogConfigs == nil {
+			break
+		}
+
+		for _, config := range data.RealtimeLogConfigs.Items {
+			d.StreamListItem(ctx, config)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if data.RealtimeLogConfigs.NextMarker != nil {
+			input.Marker = data.RealtimeLogConfigs.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudFrontRealtimeLogConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+	if strings.TrimSpace(name) == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.getCloudFrontRealtimeLogConfig", "client_error", err)
+		return nil, err
+	}
+
+	params := &cloudfront.GetRealtimeLogConfigInput{
+		Name: &name,
+	}
+
+	op, err := svc.GetRealtimeLogConfig(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.getCloudFrontRealtimeLogConfig", "api_error", err)
+		return nil, err
+	}
+
+	if op.RealtimeLogConfig == nil {
+		return nil, nil
+	}
+	return *op.RealtimeLogConfig, nil
+}
+
+func listCloudFrontRealtimeLogConfigDistributions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	config := h.Item.(types.RealtimeLogConfig)
+
+	// Get client
+	svc, err := CloudFrontClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.listCloudFrontRealtimeLogConfigDistributions", "client_error", err)
+		return nil, err
+	}
+
+	input := &cloudfront.ListDistributionsByRealtimeLogConfigInput{
+		RealtimeLogConfigName: config.Name,
+		MaxItems:              aws.Int32(100),
+	}
+
+	var distributions []types.DistributionSummary
+
+	// Paginator not available for the API
+	pagesLeft := true
+	for pagesLeft {
+		data, err := svc.ListDistributionsByRealtimeLogConfig(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudfront_realtime_log_config.listCloudFrontRealtimeLogConfigDistributions", "api_error", err)
+			return nil, err
+		}
+
+		if data.DistributionList == nil {
+			break
+		}
+		distributions = append(distributions, data.DistributionList.Items...)
+
+		if data.DistributionList.NextMarker != nil {
+			input.Marker = data.DistributionList.NextMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return distributions, nil
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchAnomalyDetector(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_anomaly_detector",
+		Description: "AWS CloudWatch Anomaly Detector",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchAnomalyDetectors,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace", Require: plugin.Optional},
+				{Name: "metric_name", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "type",
+				Description: "The type of the anomaly detector, SINGLE_METRIC or METRIC_MATH.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(cloudWatchAnomalyDetectorType),
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the metric associated with a single metric anomaly detector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Namespace", "Namespace"),
+			},
+			{
+				Name:        "metric_name",
+				Description: "The name of the metric associated with a single metric anomaly detector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.MetricName", "MetricName"),
+			},
+			{
+				Name:        "stat",
+				Description: "The statistic associated with a single metric anomaly detector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Stat", "Stat"),
+			},
+			{
+				Name:        "state_value",
+				Description: "The current status of the anomaly detector's training, e.g. PENDING_TRAINING, TRAINED_INSUFFICIENT_DATA or TRAINED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric_timezone",
+				Description: "The time zone to use for the metric, which is used to account for daylight saving time changes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Configuration.MetricTimezone"),
+			},
+			{
+				Name:        "dimensions",
+				Description: "The metric dimensions associated with a single metric anomaly detector.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SingleMetricAnomalyDetector.Dimensions", "Dimensions"),
+			},
+			{
+				Name:        "excluded_time_ranges",
+				Description: "An array of time ranges to exclude from use when the anomaly detection model is trained.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Configuration.ExcludedTimeRanges"),
+			},
+			{
+				Name:        "metric_data_queries",
+				Description: "The metric math queries associated with a metric math anomaly detector.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MetricMathAnomalyDetector.MetricDataQueries"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchAnomalyDetectors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_anomaly_detector.listCloudWatchAnomalyDetectors", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	equalQuals := d.KeyColumnQuals
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{}
+	if equalQuals["namespace"] != nil {
+		input.Namespace = aws.String(equalQuals["namespace"].GetStringValue())
+	}
+	if equalQuals["metric_name"] != nil {
+		input.MetricName = aws.String(equalQuals["metric_name"].GetStringValue())
+	}
+	if equalQuals["type"] != nil {
+		input.AnomalyDetectorTypes = []types.AnomalyDetectorType{types.AnomalyDetectorType(equalQuals["type"].GetStringValue())}
+	}
+
+	paginator := cloudwatch.NewDescribeAnomalyDetectorsPaginator(svc, input, func(o *cloudwatch.DescribeAnomalyDetectorsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_anomaly_detector.listCloudWatchAnomalyDetectors", "api_error", err)
+			return nil, err
+		}
+
+		for _, detector := range output.AnomalyDetectors {
+			d.StreamListItem(ctx, detector)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func cloudWatchAnomalyDetectorType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	detector := d.HydrateItem.(types.AnomalyDetector)
+	if detector.MetricMathAnomalyDetector != nil {
+		return types.AnomalyDetectorTypeMetricMath, nil
+	}
+	return types.AnomalyDetectorTypeSingleMetric, nil
+}
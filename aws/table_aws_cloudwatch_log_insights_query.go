@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type cloudwatchLogInsightsQueryRow struct {
+	QueryId       *string
+	LogGroupNames []string
+	StartTime     time.Time
+	EndTime       time.Time
+	Timeout       int64
+	Statistics    *types.QueryStatistics
+	Region        string
+	RowNumber     int
+	Result        map[string]interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchLogInsightsQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_log_insights_query",
+		Description: "AWS CloudWatch Log Insights Query",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchLogInsightsQueryResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "query", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "log_group_names", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "start_time", Require: plugin.Optional},
+				{Name: "end_time", Require: plugin.Optional},
+				{Name: "timeout", Require: plugin.Optional},
+				// The query is run once, in the region of the log groups
+				{Name: "region", Require: plugin.Required},
+			},
+			// StartQuery returns a ResourceNotFoundException when a log group does
+			// not exist in the region being queried
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "query_id",
+				Description: "The unique ID of the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "row_number",
+				Description: "The position of the row in the result set, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "result",
+				Description: "The row returned by the query, as a JSON object keyed by field name. Values are returned as strings, as reported by CloudWatch Logs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "records_matched",
+				Description: "The number of log events that matched the query string.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Statistics.RecordsMatched"),
+			},
+			{
+				Name:        "records_scanned",
+				Description: "The total number of log events scanned during the query.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Statistics.RecordsScanned"),
+			},
+			{
+				Name:        "bytes_scanned",
+				Description: "The total number of bytes in the log events scanned during the query.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Statistics.BytesScanned"),
+			},
+
+			// Inputs to the table
+			{
+				Name:        "query",
+				Description: "The CloudWatch Logs Insights query string to run.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "log_group_names",
+				Description: "The list of log groups to query, as a JSON array. Up to 50 log groups can be queried at once.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "start_time",
+				Description: "The beginning of the time range to query. Defaults to one hour before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time range to query. Defaults to the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "timeout",
+				Description: "The maximum number of seconds to wait for the query to complete before it is stopped. Defaults to 300.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the log groups the query runs on.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QueryId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchLogInsightsQueryResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	equalQuals := d.KeyColumnQuals
+
+	var logGroupNames []string
+	if err := json.Unmarshal([]byte(equalQuals["log_group_names"].GetJsonbValue()), &logGroupNames); err != nil {
+		return nil, fmt.Errorf("log_group_names must be a JSON array of log group names: %v", err)
+	}
+
+	endTime := time.Now()
+	if equalQuals["end_time"] != nil {
+		endTime = equalQuals["end_time"].GetTimestampValue().AsTime()
+	}
+	startTime := endTime.Add(-1 * time.Hour)
+	if equalQuals["start_time"] != nil {
+		startTime = equalQuals["start_time"].GetTimestampValue().AsTime()
+	}
+
+	region := equalQuals["region"].GetStringValue()
+
+	timeout := int64(300)
+	if equalQuals["timeout"] != nil {
+		timeout = equalQuals["timeout"].GetInt64Value()
+	}
+
+	// Create Session
+	svc, err := CloudWatchLogsRegionsClient(ctx, d, region)
+	if err != nil {
+		logger.Error("aws_cloudwatch_log_insights_query.listCloudWatchLogInsightsQueryResults", "connection_error", err)
+		return nil, err
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		QueryString:   aws.String(equalQuals["query"].GetStringValue()),
+		LogGroupNames: logGroupNames,
+		StartTime:     aws.Int64(startTime.Unix()),
+		EndTime:       aws.Int64(endTime.Unix()),
+	}
+
+	// Limiting the results
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < 1 {
+			limit = 1
+		}
+		if limit < 10000 {
+			input.Limit = aws.Int32(limit)
+		}
+	}
+
+	startOutput, err := svc.StartQuery(ctx, input)
+	if err != nil {
+		logger.Error("aws_cloudwatch_log_insights_query.listCloudWatchLogInsightsQueryResults", "api_error", err)
+		return nil, err
+	}
+
+	output, err := waitForCloudWatchLogInsightsQuery(ctx, svc, startOutput.QueryId, time.Duration(timeout)*time.Second)
+	if err != nil {
+		logger.Error("aws_cloudwatch_log_insights_query.listCloudWatchLogInsightsQueryResults", "wait_error", err)
+		return nil, err
+	}
+
+	row := cloudwatchLogInsightsQueryRow{
+		QueryId:       startOutput.QueryId,
+		LogGroupNames: logGroupNames,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Timeout:       timeout,
+		Region:        region,
+		Statistics:    output.Statistics,
+	}
+
+	for i, fields := range output.Results {
+		result := map[string]interface{}{}
+		for _, field := range fields {
+			if field.Field != nil {
+				result[*field.Field] = aws.ToString(field.Value)
+			}
+		}
+
+		item := row
+		item.RowNumber = i + 1
+		item.Result = result
+		d.StreamListItem(ctx, item)
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// waitForCloudWatchLogInsightsQuery polls GetQueryResults until the query
+// reaches a terminal state. The query is stopped if it does not complete
+// within the given timeout.
+func waitForCloudWatchLogInsightsQuery(ctx context.Context, svc *cloudwatchlogs.Client, id *string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	var output *cloudwatchlogs.GetQueryResultsOutput
+	describe := func() (bool, error) {
+		var err error
+		output, err = svc.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{QueryId: id})
+		if err != nil {
+			return false, err
+		}
+
+		switch output.Status {
+		case types.QueryStatusComplete:
+			return true, nil
+		case types.QueryStatusFailed, types.QueryStatusCancelled, types.QueryStatusTimeout:
+			return false, fmt.Errorf("%s", output.Status)
+		}
+		return false, nil
+	}
+	// Stop the query so it does not keep scanning log data
+	cancel := func() {
+		_, _ = svc.StopQuery(context.Background(), &cloudwatchlogs.StopQueryInput{QueryId: id})
+	}
+
+	if err := pollUntilTerminal(ctx, timeout, describe, cancel); err != nil {
+		return nil, fmt.Errorf("query %s %v", aws.ToString(id), err)
+	}
+	return output, nil
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+type cloudwatchMetricDataRow struct {
+	Id            *string
+	Label         *string
+	StatusCode    types.StatusCode
+	Timestamp     time.Time
+	Value         float64
+	MetricQueries interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchMetricData(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_metric_data",
+		Description: "AWS CloudWatch Metric Data",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchMetricData,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "metric_queries", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "timestamp", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The short name specified in the metric query that produced the data point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "label",
+				Description: "The human-readable label associated with the data.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time stamp of the data point. The time range to query defaults to the last 24 hours.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the data point.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "status_code",
+				Description: "The status of the returned data. Complete indicates that all data points in the requested time range were returned, PartialData means that an incomplete set of data points were returned.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Inputs to the table
+			{
+				Name:        "metric_queries",
+				Description: "The metric queries to run, as a JSON array of MetricDataQuery objects. Each query either retrieves a metric (MetricStat) or performs a math expression or search (Expression).",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchMetricData(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	metricQueries := []byte(d.KeyColumnQuals["metric_queries"].GetJsonbValue())
+
+	var queries []types.MetricDataQuery
+	if err := json.Unmarshal(metricQueries, &queries); err != nil {
+		return nil, fmt.Errorf("metric_queries must be a JSON array of metric data queries: %v", err)
+	}
+
+	// Return the queries as provided so the qual continues to match the rows
+	var rawQueries interface{}
+	if err := json.Unmarshal(metricQueries, &rawQueries); err != nil {
+		return nil, err
+	}
+
+	endTime := time.Now()
+	startTime := endTime.Add(-24 * time.Hour)
+
+	quals := d.Quals
+	if quals["timestamp"] != nil {
+		for _, q := range quals["timestamp"].Quals {
+			ts := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				// GetMetricData requires the end time to be after the start time
+				startTime = ts
+				endTime = ts.Add(time.Second)
+			case ">=", ">":
+				startTime = ts
+			case "<":
+				endTime = ts
+			case "<=":
+				// EndTime is exclusive, so move it past the requested timestamp
+				endTime = ts.Add(time.Second)
+			}
+		}
+	}
+
+	// Create Session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_data.listCloudWatchMetricData", "connection_error", err)
+		return nil, err
+	}
+
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: queries,
+		StartTime:         aws.Time(startTime),
+		EndTime:           aws.Time(endTime),
+	}
+
+	paginator := cloudwatch.NewGetMetricDataPaginator(svc, input, func(o *cloudwatch.GetMetricDataPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_metric_data.listCloudWatchMetricData", "api_error", err)
+			return nil, err
+		}
+
+		for _, result := range output.MetricDataResults {
+			for i, timestamp := range result.Timestamps {
+				if i >= len(result.Values) {
+					break
+				}
+
+				d.StreamListItem(ctx, cloudwatchMetricDataRow{
+					Id:            result.Id,
+					Label:         result.Label,
+					StatusCode:    result.StatusCode,
+					Timestamp:     timestamp,
+					Value:         result.Values[i],
+					MetricQueries: rawQueries,
+				})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchMetricStream(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_metric_stream",
+		Description: "AWS CloudWatch Metric Stream",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationError"}),
+			},
+			Hydrate: getCloudWatchMetricStream,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudWatchMetricStreams,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the metric stream, running or stopped.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_format",
+				Description: "The output format of the metric stream, json or opentelemetry0.7.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firehose_arn",
+				Description: "The ARN of the Kinesis Data Firehose delivery stream that is used for the metric stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that is used by the metric stream.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeCloudWatchMetricStream,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date that the metric stream was originally created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_date",
+				Description: "The date that the metric stream was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "include_filters",
+				Description: "The metric namespaces that are streamed. If empty, all namespaces except those in exclude_filters are streamed.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeCloudWatchMetricStream,
+			},
+			{
+				Name:        "exclude_filters",
+				Description: "The metric namespaces that are not streamed.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeCloudWatchMetricStream,
+			},
+			{
+				Name:        "statistics_configurations",
+				Description: "The additional statistics that are streamed for the metrics, beyond the default statistics.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeCloudWatchMetricStream,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The list of tag keys and values associated with the metric stream.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStreamTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudWatchMetricStreamTags,
+				Transform:   transform.From(getAwsCloudWatchAlarmTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudWatchMetricStreams(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.listCloudWatchMetricStreams", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &cloudwatch.ListMetricStreamsInput{}
+
+	paginator := cloudwatch.NewListMetricStreamsPaginator(svc, input, func(o *cloudwatch.ListMetricStreamsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.listCloudWatchMetricStreams", "api_error", err)
+			return nil, err
+		}
+
+		for _, stream := range output.Entries {
+			d.StreamListItem(ctx, stream)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCloudWatchMetricStream(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := getCloudWatchMetricStreamDetails(ctx, d, name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.MetricStreamEntry{
+		Arn:            op.Arn,
+		CreationDate:   op.CreationDate,
+		FirehoseArn:    op.FirehoseArn,
+		LastUpdateDate: op.LastUpdateDate,
+		Name:           op.Name,
+		OutputFormat:   op.OutputFormat,
+		State:          op.State,
+	}, nil
+}
+
+// describeCloudWatchMetricStream returns the filters and role of the metric
+// stream, which are not included in the list response
+func describeCloudWatchMetricStream(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.MetricStreamEntry).Name
+
+	op, err := getCloudWatchMetricStreamDetails(ctx, d, *name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudWatchMetricStreamDetails(ctx context.Context, d *plugin.QueryData, name string) (*cloudwatch.GetMetricStreamOutput, error) {
+	// Create Session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamDetails", "connection_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.GetMetricStreamInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetMetricStream(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCloudWatchMetricStreamTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	stream := h.Item.(types.MetricStreamEntry)
+
+	// Create Session
+	svc, err := CloudWatchClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamTags", "connection_error", err)
+		return nil, err
+	}
+
+	params := &cloudwatch.ListTagsForResourceInput{
+		ResourceARN: stream.Arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_metric_stream.getCloudWatchMetricStreamTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
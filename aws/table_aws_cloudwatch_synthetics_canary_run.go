@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCloudWatchSyntheticsCanaryRun(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cloudwatch_synthetics_canary_run",
+		Description: "AWS CloudWatch Synthetics Canary Run",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSyntheticsCanaries,
+			Hydrate:       listSyntheticsCanaryRuns,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "canary_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "canary_name",
+				Description: "The name of the canary.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "id",
+				Description: "A unique ID that identifies this canary run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the run, e.g. RUNNING, PASSED or FAILED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.State"),
+			},
+			{
+				Name:        "state_reason",
+				Description: "If the run failed, this field contains the reason for the failure.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StateReason"),
+			},
+			{
+				Name:        "state_reason_code",
+				Description: "If this value is CANARY_FAILURE, an exception occurred in the canary code. If this value is EXECUTION_FAILURE, an exception occurred in CloudWatch Synthetics.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.StateReasonCode"),
+			},
+			{
+				Name:        "started",
+				Description: "The start time of the run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timeline.Started"),
+			},
+			{
+				Name:        "completed",
+				Description: "The end time of the run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timeline.Completed"),
+			},
+			{
+				Name:        "artifact_s3_location",
+				Description: "The location where the canary stored artifacts from the run, such as screenshots, HAR files and logs.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listSyntheticsCanaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SyntheticsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_synthetics_canary_run.listSyntheticsCanaries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &synthetics.DescribeCanariesInput{}
+
+	// Minimize API calls when a specific canary has been requested
+	if d.KeyColumnQuals["canary_name"] != nil {
+		input.Names = []string{d.KeyColumnQuals["canary_name"].GetStringValue()}
+	}
+
+	paginator := synthetics.NewDescribeCanariesPaginator(svc, input, func(o *synthetics.DescribeCanariesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_synthetics_canary_run.listSyntheticsCanaries", "api_error", err)
+			return nil, err
+		}
+
+		for _, canary := range output.Canaries {
+			d.StreamListItem(ctx, canary)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listSyntheticsCanaryRuns(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	canary := h.Item.(types.Canary)
+
+	// Create Session
+	svc, err := SyntheticsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cloudwatch_synthetics_canary_run.listSyntheticsCanaryRuns", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &synthetics.GetCanaryRunsInput{
+		Name: canary.Name,
+	}
+
+	paginator := synthetics.NewGetCanaryRunsPaginator(svc, input, func(o *synthetics.GetCanaryRunsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cloudwatch_synthetics_canary_run.listSyntheticsCanaryRuns", "api_error", err)
+			return nil, err
+		}
+
+		for _, run := range output.CanaryRuns {
+			d.StreamLeafListItem(ctx, run)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
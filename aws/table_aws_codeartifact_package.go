@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type codeArtifactPackageInfo = struct {
+	types.PackageSummary
+	DomainName     *string
+	DomainOwner    *string
+	RepositoryName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCodeArtifactPackage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codeartifact_package",
+		Description: "AWS CodeArtifact Package",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodeArtifactRepositories,
+			Hydrate:       listCodeArtifactPackages,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "domain_name", Require: plugin.Optional},
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "format", Require: plugin.Optional},
+				{Name: "namespace", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "package_name",
+				Description: "The name of the package.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Package"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the package.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactPackageArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "format",
+				Description: "The format of the package. Valid values are npm, pypi, maven and nuget.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the package, such as the Maven group ID or the npm scope.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_name",
+				Description: "The name of the domain that contains the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_owner",
+				Description: "The 12-digit account number of the Amazon Web Services account that owns the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository that contains the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publish_restriction",
+				Description: "The package origin configuration that determines if new versions of the package can be published directly to the repository. Valid values are ALLOW and BLOCK.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginConfiguration.Restrictions.Publish"),
+			},
+			{
+				Name:        "upstream_restriction",
+				Description: "The package origin configuration that determines if new versions of the package can be added to the repository from an external connection or upstream source. Valid values are ALLOW and BLOCK.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginConfiguration.Restrictions.Upstream"),
+			},
+			{
+				Name:        "origin_configuration",
+				Description: "The package origin configuration of the package.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Package"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactPackageArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeArtifactPackages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*types.RepositoryDescription)
+
+	// Minimize API calls when a specific domain or repository has been requested
+	if d.KeyColumnQualString("domain_name") != "" && d.KeyColumnQualString("domain_name") != *repository.DomainName {
+		return nil, nil
+	}
+	if d.KeyColumnQualString("repository_name") != "" && d.KeyColumnQualString("repository_name") != *repository.Name {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeArtifactClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeartifact_package.listCodeArtifactPackages", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codeartifact.ListPackagesInput{
+		Domain:      repository.DomainName,
+		DomainOwner: repository.DomainOwner,
+		Repository:  repository.Name,
+		MaxResults:  aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQualString("format") != "" {
+		input.Format = types.PackageFormat(d.KeyColumnQualString("format"))
+	}
+	if d.KeyColumnQualString("namespace") != "" {
+		input.Namespace = aws.String(d.KeyColumnQualString("namespace"))
+	}
+
+	paginator := codeartifact.NewListPackagesPaginator(svc, input, func(o *codeartifact.ListPackagesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codeartifact_package.listCodeArtifactPackages", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Packages {
+			d.StreamLeafListItem(ctx, codeArtifactPackageInfo{
+				PackageSummary: item,
+				DomainName:     repository.DomainName,
+				DomainOwner:    repository.DomainOwner,
+				RepositoryName: repository.Name,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeArtifactPackageArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	data := h.Item.(codeArtifactPackageInfo)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeartifact_package.getCodeArtifactPackageArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Packages without a namespace have an empty path segment in their ARN
+	arn := "arn:" + commonColumnData.Partition + ":codeartifact:" + region + ":" + *data.DomainOwner + ":package/" + *data.DomainName + "/" + *data.RepositoryName + "/" + string(data.Format) + "/" + aws.ToString(data.Namespace) + "/" + *data.Package
+
+	return arn, nil
+}
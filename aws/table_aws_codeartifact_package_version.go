@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type codeArtifactPackageVersionInfo = struct {
+	types.PackageVersionSummary
+	PackageName    *string
+	Format         types.PackageFormat
+	Namespace      *string
+	DomainName     *string
+	DomainOwner    *string
+	RepositoryName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCodeArtifactPackageVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codeartifact_package_version",
+		Description: "AWS CodeArtifact Package Version",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodeArtifactRepositories,
+			Hydrate:       listCodeArtifactPackageVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "domain_name", Require: plugin.Optional},
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "package_name", Require: plugin.Optional},
+				{Name: "format", Require: plugin.Optional},
+				{Name: "namespace", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "package_name",
+				Description: "The name of the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the package.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "format",
+				Description: "The format of the package. Valid values are npm, pypi, maven and nuget.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the package, such as the Maven group ID or the npm scope.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the package version. Valid values are Published, Unfinished, Unlisted, Archived, Disposed and Deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision",
+				Description: "The revision of the package version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_name",
+				Description: "The name of the domain that contains the package version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_owner",
+				Description: "The 12-digit account number of the Amazon Web Services account that owns the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository that contains the package version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "origin_type",
+				Description: "Describes how the package version was originally added to the domain. Valid values are INTERNAL, EXTERNAL and UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Origin.OriginType"),
+			},
+			{
+				Name:        "origin",
+				Description: "Information about how the package version was originally added to the domain, including the repository or external connection it entered the domain through.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "published_time",
+				Description: "The time the package version was published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+			{
+				Name:        "display_name",
+				Description: "The name of the package that is displayed.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+			{
+				Name:        "summary",
+				Description: "A summary of the package version.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+			{
+				Name:        "home_page",
+				Description: "The homepage associated with the package.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+			{
+				Name:        "source_code_repository",
+				Description: "The repository for the source code in the package version, or the source code used to build it.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+			{
+				Name:        "licenses",
+				Description: "Information about licenses associated with the package version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeArtifactPackageVersion,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Version"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeArtifactPackageVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*types.RepositoryDescription)
+
+	// Minimize API calls when a specific domain or repository has been requested
+	if d.KeyColumnQualString("domain_name") != "" && d.KeyColumnQualString("domain_name") != *repository.DomainName {
+		return nil, nil
+	}
+	if d.KeyColumnQualString("repository_name") != "" && d.KeyColumnQualString("repository_name") != *repository.Name {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeArtifactClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeartifact_package_version.listCodeArtifactPackageVersions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// List the packages in the repository first, since package versions can only be listed per package
+	packagesInput := &codeartifact.ListPackagesInput{
+		Domain:      repository.DomainName,
+		DomainOwner: repository.DomainOwner,
+		Repository:  repository.Name,
+		MaxResults:  aws.Int32(1000),
+	}
+	if d.KeyColumnQualString("format") != "" {
+		packagesInput.Format = types.PackageFormat(d.KeyColumnQualString("format"))
+	}
+	if d.KeyColumnQualString("namespace") != "" {
+		packagesInput.Namespace = aws.String(d.KeyColumnQualString("namespace"))
+	}
+	if d.KeyColumnQualString("package_name") != "" {
+		packagesInput.PackagePrefix = aws.String(d.KeyColumnQualString("package_name"))
+	}
+
+	packagesPaginator := codeartifact.NewListPackagesPaginator(svc, packagesInput, func(o *codeartifact.ListPackagesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for packagesPaginator.HasMorePages() {
+		packagesOutput, err := packagesPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codeartifact_package_version.listCodeArtifactPackageVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, pkg := range packagesOutput.Packages {
+			// PackagePrefix matches on prefix, so skip packages that only share a prefix with the requested name
+			if d.KeyColumnQualString("package_name") != "" && d.KeyColumnQualString("package_name") != *pkg.Package {
+				continue
+			}
+
+			input := &codeartifact.ListPackageVersionsInput{
+				Domain:      repository.DomainName,
+				DomainOwner: repository.DomainOwner,
+				Repository:  repository.Name,
+				Format:      pkg.Format,
+				Namespace:   pkg.Namespace,
+				Package:     pkg.Package,
+				MaxResults:  aws.Int32(maxLimit),
+			}
+			if d.KeyColumnQualString("status") != "" {
+				input.Status = types.PackageVersionStatus(d.KeyColumnQualString("status"))
+			}
+
+			paginator := codeartifact.NewListPackageVersionsPaginator(svc, input, func(o *codeartifact.ListPackageVersionsPaginatorOptions) {
+				o.Limit = maxLimit
+				o.StopOnDuplicateToken = true
+			})
+
+			for paginator.HasMorePages() {
+				output, err := paginator.NextPage(ctx)
+				if err != nil {
+					plugin.Logger(ctx).Error("aws_codeartifact_package_version.listCodeArtifactPackageVersions", "api_error", err)
+					return nil, err
+				}
+
+				for _, item := range output.Versions {
+					d.StreamLeafListItem(ctx, codeArtifactPackageVersionInfo{
+						PackageVersionSummary: item,
+						PackageName:           pkg.Package,
+						Format:                pkg.Format,
+						Namespace:             pkg.Namespace,
+						DomainName:            repository.DomainName,
+						DomainOwner:           repository.DomainOwner,
+						RepositoryName:        repository.Name,
+					})
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeArtifactPackageVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(codeArtifactPackageVersionInfo)
+
+	// Create session
+	svc, err := CodeArtifactClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeartifact_package_version.getCodeArtifactPackageVersion", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codeartifact.DescribePackageVersionInput{
+		Domain:         data.DomainName,
+		DomainOwner:    data.DomainOwner,
+		Repository:     data.RepositoryName,
+		Format:         data.Format,
+		Namespace:      data.Namespace,
+		Package:        data.PackageName,
+		PackageVersion: data.Version,
+	}
+
+	op, err := svc.DescribePackageVersion(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeartifact_package_version.getCodeArtifactPackageVersion", "api_error", err)
+		return nil, err
+	}
+
+	return op.PackageVersion, nil
+}
@@ -0,0 +1,354 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeBuildBuild(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codebuild_build",
+		Description: "AWS CodeBuild Build",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidInputException"}),
+			},
+			Hydrate: getCodeBuildBuild,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeBuildBuilds,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_name", Require: plugin.Optional},
+				{Name: "build_status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique ID for the build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_number",
+				Description: "The number of the build. For each project, the build number of its first build is 1, and it is incremented by 1 for each subsequent build.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the CodeBuild project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_status",
+				Description: "The current status of the build. Valid values are FAILED, FAULT, IN_PROGRESS, STOPPED, SUCCEEDED and TIMED_OUT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_complete",
+				Description: "Whether the build is complete.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "current_phase",
+				Description: "The current build phase.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "When the build process started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "When the build process ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "initiator",
+				Description: "The entity that started the build, such as a user name, role name or CodePipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_version",
+				Description: "Any version identifier for the version of the source code to be built.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resolved_source_version",
+				Description: "An identifier for the version of this build's source code, such as a commit ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_type",
+				Description: "The type of compute used for the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Environment.ComputeType"),
+			},
+			{
+				Name:        "timeout_in_minutes",
+				Description: "How long, in minutes, for CodeBuild to wait before timing out this build if it does not get marked as completed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "queued_timeout_in_minutes",
+				Description: "The number of minutes a build is allowed to be queued before it times out.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "service_role",
+				Description: "The name of a service role used for this build.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "encryption_key",
+				Description: "The Key Management Service customer master key (CMK) to be used for encrypting the build output artifacts.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_batch_arn",
+				Description: "The ARN of the batch build that this build is a member of, if applicable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logs_deep_link",
+				Description: "The URL to an individual build log in CloudWatch Logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Logs.DeepLink"),
+			},
+			{
+				Name:        "phases",
+				Description: "Information about all previous build phases that are complete and information about any current build phase that is not yet complete, including their durations.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "logs",
+				Description: "Information about the build's logs in CloudWatch Logs and Amazon S3.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "environment",
+				Description: "Information about the build environment for this build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source",
+				Description: "Information about the source code to be built.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "secondary_sources",
+				Description: "An array of project source inputs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "secondary_source_versions",
+				Description: "An array of project source versions used by the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "artifacts",
+				Description: "Information about the output artifacts for the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "secondary_artifacts",
+				Description: "An array of build output artifacts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cache",
+				Description: "Information about the cache for the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_config",
+				Description: "Information about the VPC configuration that CodeBuild accesses.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_interface",
+				Description: "Describes a network interface.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "exported_environment_variables",
+				Description: "A list of exported environment variables for this build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "file_system_locations",
+				Description: "An array of file system locations for the build.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "report_arns",
+				Description: "An array of the ARNs associated with this build's reports.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "debug_session",
+				Description: "Contains information about the debug session for this build.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeBuildBuilds(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeBuildClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codebuild_build.listCodeBuildBuilds", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	projectName := d.KeyColumnQualString("project_name")
+	buildStatus := d.KeyColumnQualString("build_status")
+
+	// Both list APIs return up to 100 build IDs per page, which is also the BatchGetBuilds limit
+	streamBuilds := func(ids []string) (bool, error) {
+		if len(ids) == 0 {
+			return true, nil
+		}
+
+		output, err := svc.BatchGetBuilds(ctx, &codebuild.BatchGetBuildsInput{
+			Ids: ids,
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codebuild_build.listCodeBuildBuilds", "api_error", err)
+			return false, err
+		}
+
+		for _, build := range output.Builds {
+			if buildStatus != "" && buildStatus != string(build.BuildStatus) {
+				continue
+			}
+			d.StreamListItem(ctx, build)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	if projectName != "" {
+		input := &codebuild.ListBuildsForProjectInput{
+			ProjectName: &projectName,
+		}
+
+		paginator := codebuild.NewListBuildsForProjectPaginator(svc, input, func(o *codebuild.ListBuildsForProjectPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_codebuild_build.listCodeBuildBuilds", "api_error", err)
+				return nil, err
+			}
+
+			more, err := streamBuilds(output.Ids)
+			if err != nil || !more {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	input := &codebuild.ListBuildsInput{}
+
+	paginator := codebuild.NewListBuildsPaginator(svc, input, func(o *codebuild.ListBuildsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codebuild_build.listCodeBuildBuilds", "api_error", err)
+			return nil, err
+		}
+
+		more, err := streamBuilds(output.Ids)
+		if err != nil || !more {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeBuildBuild(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeBuildClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codebuild_build.getCodeBuildBuild", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	// Get call
+	op, err := svc.BatchGetBuilds(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codebuild_build.getCodeBuildBuild", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Builds) > 0 {
+		return op.Builds[0], nil
+	}
+	return nil, nil
+}
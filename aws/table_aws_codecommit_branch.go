@@ -0,0 +1,252 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	go_kit_packs "github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type codeCommitBranchInfo = struct {
+	BranchName     *string
+	RepositoryName *string
+	DefaultBranch  *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCodeCommitBranch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codecommit_branch",
+		Description: "AWS CodeCommit Branch",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodeCommitRepositories,
+			Hydrate:       listCodeCommitBranches,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameter"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "branch_name", Require: plugin.Optional},
+			},
+		},
+		HydrateDependencies: []plugin.HydrateDependencies{
+			{
+				Func:    getCodeCommitBranchLastCommit,
+				Depends: []plugin.HydrateFunc{getCodeCommitBranch},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "branch_name",
+				Description: "The name of the branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository that contains the branch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_default_branch",
+				Description: "True if the branch is the default branch of the repository.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(codeCommitBranchIsDefault),
+			},
+			{
+				Name:        "commit_id",
+				Description: "The ID of the last commit made to the branch.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeCommitBranch,
+			},
+			{
+				Name:        "last_commit_author",
+				Description: "The name of the author of the last commit made to the branch.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeCommitBranchLastCommit,
+				Transform:   transform.FromField("Author.Name"),
+			},
+			{
+				Name:        "last_commit_date",
+				Description: "The date and time the last commit made to the branch was committed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCodeCommitBranchLastCommit,
+				Transform:   transform.FromField("Committer.Date").Transform(codeCommitDateToTimestamp),
+			},
+			{
+				Name:        "last_commit_message",
+				Description: "The commit message of the last commit made to the branch.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeCommitBranchLastCommit,
+				Transform:   transform.FromField("Message"),
+			},
+			{
+				Name:        "last_commit",
+				Description: "Information about the last commit made to the branch, including its author, committer and parents.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeCommitBranchLastCommit,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BranchName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeCommitBranches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(types.RepositoryMetadata)
+
+	// Minimize API calls when a specific repository has been requested
+	if d.KeyColumnQualString("repository_name") != "" && d.KeyColumnQualString("repository_name") != *repository.RepositoryName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_branch.listCodeCommitBranches", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &codecommit.ListBranchesInput{
+		RepositoryName: repository.RepositoryName,
+	}
+
+	paginator := codecommit.NewListBranchesPaginator(svc, input, func(o *codecommit.ListBranchesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codecommit_branch.listCodeCommitBranches", "api_error", err)
+			return nil, err
+		}
+
+		for _, branch := range output.Branches {
+			if d.KeyColumnQualString("branch_name") != "" && d.KeyColumnQualString("branch_name") != branch {
+				continue
+			}
+
+			d.StreamLeafListItem(ctx, codeCommitBranchInfo{
+				BranchName:     aws.String(branch),
+				RepositoryName: repository.RepositoryName,
+				DefaultBranch:  repository.DefaultBranch,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeCommitBranch(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	branch := h.Item.(codeCommitBranchInfo)
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_branch.getCodeCommitBranch", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codecommit.GetBranchInput{
+		BranchName:     branch.BranchName,
+		RepositoryName: branch.RepositoryName,
+	}
+
+	op, err := svc.GetBranch(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_branch.getCodeCommitBranch", "api_error", err)
+		return nil, err
+	}
+
+	return op.Branch, nil
+}
+
+func getCodeCommitBranchLastCommit(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	branch := h.Item.(codeCommitBranchInfo)
+	branchInfo := h.HydrateResults["getCodeCommitBranch"].(*types.BranchInfo)
+
+	if branchInfo == nil || branchInfo.CommitId == nil {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_branch.getCodeCommitBranchLastCommit", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codecommit.GetCommitInput{
+		CommitId:       branchInfo.CommitId,
+		RepositoryName: branch.RepositoryName,
+	}
+
+	op, err := svc.GetCommit(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_branch.getCodeCommitBranchLastCommit", "api_error", err)
+		return nil, err
+	}
+
+	return op.Commit, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func codeCommitBranchIsDefault(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	branch := d.HydrateItem.(codeCommitBranchInfo)
+
+	return branch.DefaultBranch != nil && *branch.DefaultBranch == *branch.BranchName, nil
+}
+
+// CodeCommit returns commit dates as a Unix timestamp followed by the GMT offset, e.g. "1484167798 -0800"
+func codeCommitDateToTimestamp(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	date := go_kit_packs.SafeString(d.Value)
+	if date == "" {
+		return nil, nil
+	}
+
+	seconds, err := strconv.ParseInt(strings.Fields(date)[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return time.Unix(seconds, 0), nil
+}
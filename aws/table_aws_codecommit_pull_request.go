@@ -0,0 +1,363 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeCommitPullRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codecommit_pull_request",
+		Description: "AWS CodeCommit Pull Request",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("pull_request_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"PullRequestDoesNotExistException", "InvalidPullRequestIdException"}),
+			},
+			Hydrate: getCodeCommitPullRequest,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodeCommitRepositories,
+			Hydrate:       listCodeCommitPullRequests,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidParameter"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_name", Require: plugin.Optional},
+				{Name: "pull_request_status", Require: plugin.Optional},
+				{Name: "author_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "pull_request_id",
+				Description: "The system-generated ID of the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: "The user-defined title of the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pull_request_status",
+				Description: "The status of the pull request. Valid values are OPEN and CLOSED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "repository_name",
+				Description: "The name of the repository that contains the pull request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "RepositoryName"),
+			},
+			{
+				Name:        "author_arn",
+				Description: "The Amazon Resource Name (ARN) of the user who created the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The user-defined description of the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the pull request was originally created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_activity_date",
+				Description: "The day and time of the last user or system activity on the pull request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "revision_id",
+				Description: "The system-generated revision ID for the pull request.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_reference",
+				Description: "The branch of the repository that contains the changes for the pull request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "SourceReference"),
+			},
+			{
+				Name:        "destination_reference",
+				Description: "The branch of the repository where the pull request changes are merged.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "DestinationReference"),
+			},
+			{
+				Name:        "is_merged",
+				Description: "True if the pull request has been merged.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "IsMerged"),
+			},
+			{
+				Name:        "merged_by",
+				Description: "The Amazon Resource Name (ARN) of the user who merged the branches.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "MergedBy"),
+			},
+			{
+				Name:        "merge_option",
+				Description: "The merge strategy used in the merge.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(codeCommitPullRequestTargetValue, "MergeOption"),
+			},
+			{
+				Name:        "approved",
+				Description: "True if all approval rule requirements for the pull request have been met.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCodeCommitPullRequestApprovalEvaluation,
+			},
+			{
+				Name:        "overridden",
+				Description: "True if the approval rule requirements for the pull request have been overridden and no longer apply.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCodeCommitPullRequestApprovalEvaluation,
+			},
+			{
+				Name:        "approval_rules_satisfied",
+				Description: "The names of the approval rules that have had their conditions met.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeCommitPullRequestApprovalEvaluation,
+			},
+			{
+				Name:        "approval_rules_not_satisfied",
+				Description: "The names of the approval rules that have not had their conditions met.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeCommitPullRequestApprovalEvaluation,
+			},
+			{
+				Name:        "approval_rules",
+				Description: "The approval rules applied to the pull request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "approvals",
+				Description: "The approval states for the current revision of the pull request.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeCommitPullRequestApprovalStates,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "pull_request_targets",
+				Description: "The targets of the pull request, including the source branch and destination branch.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeCommitPullRequests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(types.RepositoryMetadata)
+
+	// Minimize API calls when a specific repository has been requested
+	if d.KeyColumnQualString("repository_name") != "" && d.KeyColumnQualString("repository_name") != *repository.RepositoryName {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.listCodeCommitPullRequests", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codecommit.ListPullRequestsInput{
+		RepositoryName: repository.RepositoryName,
+		MaxResults:     aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQualString("pull_request_status") != "" {
+		input.PullRequestStatus = types.PullRequestStatusEnum(d.KeyColumnQualString("pull_request_status"))
+	}
+	if d.KeyColumnQualString("author_arn") != "" {
+		input.AuthorArn = aws.String(d.KeyColumnQualString("author_arn"))
+	}
+
+	paginator := codecommit.NewListPullRequestsPaginator(svc, input, func(o *codecommit.ListPullRequestsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codecommit_pull_request.listCodeCommitPullRequests", "api_error", err)
+			return nil, err
+		}
+
+		// ListPullRequests only returns IDs, so get the details of each pull request
+		for _, pullRequestId := range output.PullRequestIds {
+			op, err := svc.GetPullRequest(ctx, &codecommit.GetPullRequestInput{
+				PullRequestId: aws.String(pullRequestId),
+			})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_codecommit_pull_request.listCodeCommitPullRequests", "api_error", err)
+				return nil, err
+			}
+			d.StreamLeafListItem(ctx, op.PullRequest)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeCommitPullRequest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pullRequestId := d.KeyColumnQuals["pull_request_id"].GetStringValue()
+
+	// Empty check
+	if pullRequestId == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequest", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codecommit.GetPullRequestInput{
+		PullRequestId: aws.String(pullRequestId),
+	}
+
+	op, err := svc.GetPullRequest(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequest", "api_error", err)
+		return nil, err
+	}
+
+	return op.PullRequest, nil
+}
+
+func getCodeCommitPullRequestApprovalEvaluation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pullRequest := h.Item.(*types.PullRequest)
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequestApprovalEvaluation", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codecommit.EvaluatePullRequestApprovalRulesInput{
+		PullRequestId: pullRequest.PullRequestId,
+		RevisionId:    pullRequest.RevisionId,
+	}
+
+	op, err := svc.EvaluatePullRequestApprovalRules(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequestApprovalEvaluation", "api_error", err)
+		return nil, err
+	}
+
+	return op.Evaluation, nil
+}
+
+func getCodeCommitPullRequestApprovalStates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pullRequest := h.Item.(*types.PullRequest)
+
+	// Create service
+	svc, err := CodeCommitClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequestApprovalStates", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codecommit.GetPullRequestApprovalStatesInput{
+		PullRequestId: pullRequest.PullRequestId,
+		RevisionId:    pullRequest.RevisionId,
+	}
+
+	op, err := svc.GetPullRequestApprovalStates(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codecommit_pull_request.getCodeCommitPullRequestApprovalStates", "api_error", err)
+		return nil, err
+	}
+
+	return op.Approvals, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Pull requests created through the API and console always have a single target
+func codeCommitPullRequestTargetValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	pullRequest := d.HydrateItem.(*types.PullRequest)
+
+	if len(pullRequest.PullRequestTargets) == 0 {
+		return nil, nil
+	}
+	target := pullRequest.PullRequestTargets[0]
+
+	switch d.Param.(string) {
+	case "RepositoryName":
+		return target.RepositoryName, nil
+	case "SourceReference":
+		return target.SourceReference, nil
+	case "DestinationReference":
+		return target.DestinationReference, nil
+	}
+
+	if target.MergeMetadata == nil {
+		return nil, nil
+	}
+	switch d.Param.(string) {
+	case "IsMerged":
+		return target.MergeMetadata.IsMerged, nil
+	case "MergedBy":
+		return target.MergeMetadata.MergedBy, nil
+	case "MergeOption":
+		return target.MergeMetadata.MergeOption, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeDeployDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codedeploy_deployment",
+		Description: "AWS CodeDeploy Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("deployment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DeploymentDoesNotExistException", "InvalidDeploymentIdException"}),
+			},
+			Hydrate: getCodeDeployDeployment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeDeployDeployments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ApplicationDoesNotExistException", "DeploymentGroupDoesNotExistException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "application_name", Require: plugin.Optional},
+				{Name: "deployment_group_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "create_time", Operators: []string{">", ">=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "deployment_id",
+				Description: "The unique ID of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "application_name",
+				Description: "The application name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_group_name",
+				Description: "The deployment group name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the deployment as a whole.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_config_name",
+				Description: "The deployment configuration name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_platform",
+				Description: "The destination platform type for the deployment. Valid values are Server, Lambda and ECS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator",
+				Description: "The means by which the deployment was created, such as user, autoscaling or codeDeployRollback.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A comment about the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "A timestamp that indicates when the deployment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "start_time",
+				Description: "A timestamp that indicates when the deployment was deployed to the deployment group.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "complete_time",
+				Description: "A timestamp that indicates when the deployment was complete.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "error_code",
+				Description: "The error code for the deployment, if it failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ErrorInformation.Code"),
+			},
+			{
+				Name:        "error_message",
+				Description: "An accompanying error message for the deployment, if it failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ErrorInformation.Message"),
+			},
+			{
+				Name:        "rollback_deployment_id",
+				Description: "The ID of the deployment rollback.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RollbackInfo.RollbackDeploymentId"),
+			},
+			{
+				Name:        "rollback_triggering_deployment_id",
+				Description: "The deployment ID of the deployment that was underway and triggered a rollback deployment because it failed or was stopped.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RollbackInfo.RollbackTriggeringDeploymentId"),
+			},
+			{
+				Name:        "ignore_application_stop_failures",
+				Description: "If true, then if an ApplicationStop, BeforeBlockTraffic, or AfterBlockTraffic deployment lifecycle event to an instance fails, then the deployment continues to the next deployment lifecycle event.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "instance_termination_wait_time_started",
+				Description: "Indicates whether the wait period set for the termination of instances in the original environment has started.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "update_outdated_instances_only",
+				Description: "Indicates whether only instances that are not running the latest application revision are to be deployed to.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "file_exists_behavior",
+				Description: "Information about how CodeDeploy handles files that already exist in a deployment target location but weren't part of the previous successful deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_id",
+				Description: "The unique ID for an external resource (for example, a CloudFormation stack ID) that is linked to this deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_overview",
+				Description: "A summary of the deployment status of the instances in the deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "error_information",
+				Description: "Information about any error associated with this deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "rollback_info",
+				Description: "Information about a deployment rollback.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "revision",
+				Description: "Information about the location of stored application artifacts and the service from which to retrieve them.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "previous_revision",
+				Description: "Information about the application revision that was deployed to the deployment group before the most recent successful deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "auto_rollback_configuration",
+				Description: "Information about the automatic rollback configuration associated with the deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "blue_green_deployment_configuration",
+				Description: "Information about blue/green deployment options for this deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "deployment_style",
+				Description: "Information about the type of deployment, either in-place or blue/green, you want to run and whether to route deployment traffic behind a load balancer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "load_balancer_info",
+				Description: "Information about the load balancer used in the deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_instances",
+				Description: "Information about the instances that belong to the replacement environment in a blue/green deployment.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeploymentId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeDeployDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeDeployClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codedeploy_deployment.listCodeDeployDeployments", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &codedeploy.ListDeploymentsInput{}
+
+	// A deployment group name can only be passed along with its application name
+	if d.KeyColumnQualString("application_name") != "" {
+		input.ApplicationName = aws.String(d.KeyColumnQualString("application_name"))
+		if d.KeyColumnQualString("deployment_group_name") != "" {
+			input.DeploymentGroupName = aws.String(d.KeyColumnQualString("deployment_group_name"))
+		}
+	}
+	if d.KeyColumnQualString("status") != "" {
+		input.IncludeOnlyStatuses = []types.DeploymentStatus{types.DeploymentStatus(d.KeyColumnQualString("status"))}
+	}
+	if d.Quals["create_time"] != nil {
+		timeRange := &types.TimeRange{}
+		for _, q := range d.Quals["create_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				timeRange.Start = aws.Time(timestamp)
+			case "<", "<=":
+				timeRange.End = aws.Time(timestamp)
+			}
+		}
+		input.CreateTimeRange = timeRange
+	}
+
+	paginator := codedeploy.NewListDeploymentsPaginator(svc, input, func(o *codedeploy.ListDeploymentsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codedeploy_deployment.listCodeDeployDeployments", "api_error", err)
+			return nil, err
+		}
+
+		// BatchGetDeployments api can take maximum 25 number of deployment IDs at a time
+		for start := 0; start < len(output.Deployments); start += 25 {
+			end := start + 25
+			if end > len(output.Deployments) {
+				end = len(output.Deployments)
+			}
+
+			result, err := svc.BatchGetDeployments(ctx, &codedeploy.BatchGetDeploymentsInput{
+				DeploymentIds: output.Deployments[start:end],
+			})
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_codedeploy_deployment.listCodeDeployDeployments", "api_error", err)
+				return nil, err
+			}
+
+			for _, deployment := range result.DeploymentsInfo {
+				d.StreamListItem(ctx, deployment)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeDeployDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	deploymentId := d.KeyColumnQuals["deployment_id"].GetStringValue()
+
+	// Empty check
+	if deploymentId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeDeployClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codedeploy_deployment.getCodeDeployDeployment", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentId),
+	}
+
+	op, err := svc.GetDeployment(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codedeploy_deployment.getCodeDeployDeployment", "api_error", err)
+		return nil, err
+	}
+
+	// Return the same item type as the list call
+	return *op.DeploymentInfo, nil
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeGuruProfilerProfilingGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codeguruprofiler_profiling_group",
+		Description: "AWS CodeGuru Profiler Profiling Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getCodeGuruProfilerProfilingGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeGuruProfilerProfilingGroups,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the profiling group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) identifying the profiling group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compute_platform",
+				Description: "The compute platform of the profiling group. Valid values are Default and AWSLambda.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time when the profiling group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when the profiling group was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "profiling_enabled",
+				Description: "Indicates whether profiling is enabled for the profiling group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AgentOrchestrationConfig.ProfilingEnabled"),
+			},
+			{
+				Name:        "latest_agent_profile_reported_at",
+				Description: "The date and time when the profiling agent most recently submitted a profile.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ProfilingStatus.LatestAgentProfileReportedAt"),
+			},
+			{
+				Name:        "latest_agent_orchestrated_at",
+				Description: "The date and time when the profiling agent most recently pinged back.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ProfilingStatus.LatestAgentOrchestratedAt"),
+			},
+			{
+				Name:        "total_number_of_findings",
+				Description: "The total number of recommendations found in the latest findings report of the profiling group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCodeGuruProfilerProfilingGroupFindingsReport,
+				Transform:   transform.FromField("TotalNumberOfFindings"),
+			},
+			{
+				Name:        "latest_findings_report",
+				Description: "Information about the latest findings report of the profiling group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeGuruProfilerProfilingGroupFindingsReport,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "profiling_status",
+				Description: "The status of the profiling group, including when profiles were last submitted and aggregated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "policy",
+				Description: "The resource-based policy that grants permissions to submit profiles and post agent data to the profiling group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeGuruProfilerProfilingGroupPolicy,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeGuruProfilerProfilingGroupPolicy,
+				Transform:   transform.FromValue().Transform(unescape).Transform(policyToCanonical),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeGuruProfilerProfilingGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeGuruProfilerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.listCodeGuruProfilerProfilingGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codeguruprofiler.ListProfilingGroupsInput{
+		IncludeDescription: aws.Bool(true),
+		MaxResults:         aws.Int32(maxLimit),
+	}
+
+	paginator := codeguruprofiler.NewListProfilingGroupsPaginator(svc, input, func(o *codeguruprofiler.ListProfilingGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.listCodeGuruProfilerProfilingGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ProfilingGroups {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeGuruProfilerProfilingGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeGuruProfilerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroup", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codeguruprofiler.DescribeProfilingGroupInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	op, err := svc.DescribeProfilingGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroup", "api_error", err)
+		return nil, err
+	}
+
+	return *op.ProfilingGroup, nil
+}
+
+func getCodeGuruProfilerProfilingGroupPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	profilingGroup := h.Item.(types.ProfilingGroupDescription)
+
+	// Create session
+	svc, err := CodeGuruProfilerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroupPolicy", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: profilingGroup.Name,
+	}
+
+	op, err := svc.GetPolicy(ctx, params)
+	if err != nil {
+		// A profiling group without any agent permissions has no policy
+		if strings.Contains(err.Error(), "ResourceNotFoundException") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroupPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op.Policy, nil
+}
+
+func getCodeGuruProfilerProfilingGroupFindingsReport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	profilingGroup := h.Item.(types.ProfilingGroupDescription)
+
+	// Create session
+	svc, err := CodeGuruProfilerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroupFindingsReport", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// The account summary contains the latest findings report of each profiling group
+	input := &codeguruprofiler.GetFindingsReportAccountSummaryInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	paginator := codeguruprofiler.NewGetFindingsReportAccountSummaryPaginator(svc, input, func(o *codeguruprofiler.GetFindingsReportAccountSummaryPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codeguruprofiler_profiling_group.getCodeGuruProfilerProfilingGroupFindingsReport", "api_error", err)
+			return nil, err
+		}
+
+		for _, report := range output.ReportSummaries {
+			if report.ProfilingGroupName != nil && *report.ProfilingGroupName == *profilingGroup.Name {
+				return report, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
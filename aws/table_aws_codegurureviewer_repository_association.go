@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codegurureviewer"
+	"github.com/aws/aws-sdk-go-v2/service/codegurureviewer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCodeGuruReviewerRepositoryAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codegurureviewer_repository_association",
+		Description: "AWS CodeGuru Reviewer Repository Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("association_arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "ValidationException"}),
+			},
+			Hydrate: getCodeGuruReviewerRepositoryAssociation,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCodeGuruReviewerRepositoryAssociations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "owner", Require: plugin.Optional},
+				{Name: "provider_type", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_arn",
+				Description: "The Amazon Resource Name (ARN) identifying the repository association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_id",
+				Description: "The ID of the repository association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the repository association. Valid values are Associated, Associating, Failed, Disassociating and Disassociated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provider_type",
+				Description: "The provider type of the repository association.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the repository. For a CodeCommit repository, this is the Amazon Web Services account ID of the account that owns the repository.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connection_arn",
+				Description: "The Amazon Resource Name (ARN) of an Amazon Web Services CodeStar Connections connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_time_stamp",
+				Description: "The time, in milliseconds since the epoch, since the repository association was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_time_stamp",
+				Description: "The time, in milliseconds since the epoch, when the repository association was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociation,
+			},
+			{
+				Name:        "state_reason",
+				Description: "A description of why the repository association is in the current state.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociation,
+			},
+			{
+				Name:        "encryption_option",
+				Description: "The encryption option for the repository association. Valid values are AWS_OWNED_CMK and CUSTOMER_MANAGED_CMK.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociation,
+				Transform:   transform.FromField("KMSKeyDetails.EncryptionOption"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the Key Management Service key that is associated with the repository association.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociation,
+				Transform:   transform.FromField("KMSKeyDetails.KMSKeyId"),
+			},
+			{
+				Name:        "findings_count",
+				Description: "The total number of recommendations found across all code reviews of the repository.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociationFindingsCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "s3_repository_details",
+				Description: "Information about the S3 bucket and code artifacts of an S3 repository association.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociation,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodeGuruReviewerRepositoryAssociationTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssociationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodeGuruReviewerRepositoryAssociations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CodeGuruReviewerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.listCodeGuruReviewerRepositoryAssociations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codegurureviewer.ListRepositoryAssociationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQualString("name") != "" {
+		input.Names = []string{d.KeyColumnQualString("name")}
+	}
+	if d.KeyColumnQualString("owner") != "" {
+		input.Owners = []string{d.KeyColumnQualString("owner")}
+	}
+	if d.KeyColumnQualString("provider_type") != "" {
+		input.ProviderTypes = []types.ProviderType{types.ProviderType(d.KeyColumnQualString("provider_type"))}
+	}
+	if d.KeyColumnQualString("state") != "" {
+		input.States = []types.RepositoryAssociationState{types.RepositoryAssociationState(d.KeyColumnQualString("state"))}
+	}
+
+	paginator := codegurureviewer.NewListRepositoryAssociationsPaginator(svc, input, func(o *codegurureviewer.ListRepositoryAssociationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.listCodeGuruReviewerRepositoryAssociations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.RepositoryAssociationSummaries {
+			d.StreamListItem(ctx, types.RepositoryAssociation{
+				AssociationArn:       item.AssociationArn,
+				AssociationId:        item.AssociationId,
+				ConnectionArn:        item.ConnectionArn,
+				LastUpdatedTimeStamp: item.LastUpdatedTimeStamp,
+				Name:                 item.Name,
+				Owner:                item.Owner,
+				ProviderType:         item.ProviderType,
+				State:                item.State,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodeGuruReviewerRepositoryAssociation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	output, err := describeCodeGuruReviewerRepositoryAssociation(ctx, d, h)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	return *output.RepositoryAssociation, nil
+}
+
+func getCodeGuruReviewerRepositoryAssociationTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	output, err := describeCodeGuruReviewerRepositoryAssociation(ctx, d, h)
+	if err != nil || output == nil {
+		return nil, err
+	}
+
+	return output.Tags, nil
+}
+
+func describeCodeGuruReviewerRepositoryAssociation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (*codegurureviewer.DescribeRepositoryAssociationOutput, error) {
+	var associationArn string
+	if h.Item != nil {
+		associationArn = *h.Item.(types.RepositoryAssociation).AssociationArn
+	} else {
+		associationArn = d.KeyColumnQuals["association_arn"].GetStringValue()
+	}
+
+	// Empty check
+	if associationArn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := CodeGuruReviewerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.describeCodeGuruReviewerRepositoryAssociation", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codegurureviewer.DescribeRepositoryAssociationInput{
+		AssociationArn: aws.String(associationArn),
+	}
+
+	op, err := svc.DescribeRepositoryAssociation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.describeCodeGuruReviewerRepositoryAssociation", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getCodeGuruReviewerRepositoryAssociationFindingsCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	association := h.Item.(types.RepositoryAssociation)
+
+	// Create session
+	svc, err := CodeGuruReviewerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.getCodeGuruReviewerRepositoryAssociationFindingsCount", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Code reviews can only be listed for one type at a time
+	var findingsCount int64
+	for _, reviewType := range []types.Type{types.TypePullRequest, types.TypeRepositoryAnalysis} {
+		input := &codegurureviewer.ListCodeReviewsInput{
+			Type:            reviewType,
+			RepositoryNames: []string{*association.Name},
+			ProviderTypes:   []types.ProviderType{association.ProviderType},
+			MaxResults:      aws.Int32(100),
+		}
+
+		paginator := codegurureviewer.NewListCodeReviewsPaginator(svc, input, func(o *codegurureviewer.ListCodeReviewsPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_codegurureviewer_repository_association.getCodeGuruReviewerRepositoryAssociationFindingsCount", "api_error", err)
+				return nil, err
+			}
+
+			for _, codeReview := range output.CodeReviewSummaries {
+				// Code reviews are listed by repository name, so skip reviews of other repositories with the same name
+				if codeReview.Owner != nil && association.Owner != nil && *codeReview.Owner != *association.Owner {
+					continue
+				}
+				if codeReview.MetricsSummary != nil && codeReview.MetricsSummary.FindingsCount != nil {
+					findingsCount += *codeReview.MetricsSummary.FindingsCount
+				}
+			}
+		}
+	}
+
+	return findingsCount, nil
+}
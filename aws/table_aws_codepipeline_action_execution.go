@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type codepipelineActionExecutionInfo = struct {
+	types.ActionExecutionDetail
+	PipelineName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCodepipelineActionExecution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codepipeline_action_execution",
+		Description: "AWS Codepipeline Action Execution",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodepipelinePipelines,
+			Hydrate:       listCodepipelineActionExecutions,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"PipelineNotFoundException", "PipelineExecutionNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "pipeline_name", Require: plugin.Optional},
+				{Name: "pipeline_execution_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "action_execution_id",
+				Description: "The action execution ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action_name",
+				Description: "The name of the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stage_name",
+				Description: "The name of the stage that contains the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "The name of the pipeline that contains the action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_execution_id",
+				Description: "The pipeline execution ID for the action execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_version",
+				Description: "The version of the pipeline where the action was run.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the action execution. Valid values are InProgress, Abandoned, Succeeded and Failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the action execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The last update time of the action execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "action_category",
+				Description: "The category of the action. Valid values are Source, Build, Deploy, Test, Invoke and Approval.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Input.ActionTypeId.Category"),
+			},
+			{
+				Name:        "action_provider",
+				Description: "The provider of the service being called by the action, such as CodeBuild or CodeDeploy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Input.ActionTypeId.Provider"),
+			},
+			{
+				Name:        "external_execution_id",
+				Description: "The action provider's external ID for the action execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.ExecutionResult.ExternalExecutionId"),
+			},
+			{
+				Name:        "external_execution_summary",
+				Description: "The action provider's summary for the action execution, including any error details.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.ExecutionResult.ExternalExecutionSummary"),
+			},
+			{
+				Name:        "external_execution_url",
+				Description: "The deepest external link to the external resource (for example, a repository URL or deployment endpoint) that is used when running the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Output.ExecutionResult.ExternalExecutionUrl"),
+			},
+			{
+				Name:        "input",
+				Description: "Input details for the action execution, such as role ARN, Region, and input artifacts.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "output",
+				Description: "Output details for the action execution, such as the action execution result.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodepipelineActionExecutions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipeline := h.Item.(types.PipelineSummary)
+
+	// Minimize API calls when a specific pipeline has been requested
+	if d.KeyColumnQualString("pipeline_name") != "" && d.KeyColumnQualString("pipeline_name") != *pipeline.Name {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := CodePipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codepipeline_action_execution.listCodepipelineActionExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codepipeline.ListActionExecutionsInput{
+		PipelineName: pipeline.Name,
+		MaxResults:   aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQualString("pipeline_execution_id") != "" {
+		input.Filter = &types.ActionExecutionFilter{
+			PipelineExecutionId: aws.String(d.KeyColumnQualString("pipeline_execution_id")),
+		}
+	}
+
+	paginator := codepipeline.NewListActionExecutionsPaginator(svc, input, func(o *codepipeline.ListActionExecutionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codepipeline_action_execution.listCodepipelineActionExecutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ActionExecutionDetails {
+			d.StreamLeafListItem(ctx, codepipelineActionExecutionInfo{
+				ActionExecutionDetail: item,
+				PipelineName:          pipeline.Name,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type codepipelinePipelineExecutionInfo = struct {
+	types.PipelineExecutionSummary
+	PipelineName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsCodepipelinePipelineExecution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_codepipeline_pipeline_execution",
+		Description: "AWS Codepipeline Pipeline Execution",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCodepipelinePipelines,
+			Hydrate:       listCodepipelinePipelineExecutions,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"PipelineNotFoundException"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "pipeline_name", Require: plugin.Optional},
+				{Name: "pipeline_execution_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "pipeline_execution_id",
+				Description: "The ID of the pipeline execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "The name of the pipeline that was executed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the pipeline execution. Valid values are Cancelled, InProgress, Stopped, Stopping, Succeeded, Superseded and Failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time when the pipeline execution began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The date and time of the last change to the pipeline execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "pipeline_version",
+				Description: "The version number of the pipeline with the specified pipeline execution.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCodepipelinePipelineExecution,
+			},
+			{
+				Name:        "status_summary",
+				Description: "A summary that contains a description of the pipeline execution status.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCodepipelinePipelineExecution,
+			},
+			{
+				Name:        "trigger_type",
+				Description: "The type of change-detection method, command, or user interaction that started the pipeline execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Trigger.TriggerType"),
+			},
+			{
+				Name:        "trigger",
+				Description: "The interaction or event that started the pipeline execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "stop_trigger",
+				Description: "The interaction that stopped the pipeline execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "source_revisions",
+				Description: "A list of the source artifact revisions that initiated the pipeline execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "artifact_revisions",
+				Description: "A list of the artifact revisions used by the pipeline execution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCodepipelinePipelineExecution,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PipelineExecutionId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCodepipelinePipelineExecutions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipeline := h.Item.(types.PipelineSummary)
+
+	// Minimize API calls when a specific pipeline has been requested
+	if d.KeyColumnQualString("pipeline_name") != "" && d.KeyColumnQualString("pipeline_name") != *pipeline.Name {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := CodePipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codepipeline_pipeline_execution.listCodepipelinePipelineExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &codepipeline.ListPipelineExecutionsInput{
+		PipelineName: pipeline.Name,
+		MaxResults:   aws.Int32(maxLimit),
+	}
+
+	paginator := codepipeline.NewListPipelineExecutionsPaginator(svc, input, func(o *codepipeline.ListPipelineExecutionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_codepipeline_pipeline_execution.listCodepipelinePipelineExecutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.PipelineExecutionSummaries {
+			if d.KeyColumnQualString("pipeline_execution_id") != "" && d.KeyColumnQualString("pipeline_execution_id") != *item.PipelineExecutionId {
+				continue
+			}
+			if d.KeyColumnQualString("status") != "" && d.KeyColumnQualString("status") != string(item.Status) {
+				continue
+			}
+
+			d.StreamLeafListItem(ctx, codepipelinePipelineExecutionInfo{
+				PipelineExecutionSummary: item,
+				PipelineName:             pipeline.Name,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCodepipelinePipelineExecution(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	execution := h.Item.(codepipelinePipelineExecutionInfo)
+
+	// Create Session
+	svc, err := CodePipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codepipeline_pipeline_execution.getCodepipelinePipelineExecution", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &codepipeline.GetPipelineExecutionInput{
+		PipelineName:        execution.PipelineName,
+		PipelineExecutionId: execution.PipelineExecutionId,
+	}
+
+	op, err := svc.GetPipelineExecution(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_codepipeline_pipeline_execution.getCodepipelinePipelineExecution", "api_error", err)
+		return nil, err
+	}
+
+	return op.PipelineExecution, nil
+}
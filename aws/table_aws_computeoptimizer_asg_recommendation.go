@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerAsgRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_asg_recommendation",
+		Description: "AWS Compute Optimizer Auto Scaling Group Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeOptimizerAsgRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "auto_scaling_group_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OptInRequiredException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "auto_scaling_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_scaling_group_name",
+				Description: "The name of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding",
+				Description: "The finding classification of the Auto Scaling group. Possible values are NotOptimized and Optimized.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_instance_type",
+				Description: "The instance type of the Auto Scaling group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentConfiguration.InstanceType"),
+			},
+			{
+				Name:        "current_performance_risk",
+				Description: "The risk of the current Auto Scaling group not meeting the performance needs of its workloads.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the Auto Scaling group recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the Auto Scaling group.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(asgRecommendationTopSavings, "Currency"),
+			},
+			{
+				Name:        "estimated_monthly_savings_value",
+				Description: "The value of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(asgRecommendationTopSavings, "Value"),
+			},
+			{
+				Name:        "savings_opportunity_percentage",
+				Description: "The estimated monthly savings possible as a percentage of monthly cost for the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(asgRecommendationTopSavings, "Percentage"),
+			},
+			{
+				Name:        "current_configuration",
+				Description: "An object that describes the current configuration of the Auto Scaling group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "inferred_workload_types",
+				Description: "The applications that might be running on the instances in the Auto Scaling group as inferred by Compute Optimizer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "effective_recommendation_preferences",
+				Description: "An object that describes the effective recommendation preferences for the Auto Scaling group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommendation_options",
+				Description: "An array of objects that describe the recommendation options for the Auto Scaling group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "An array of objects that describe the utilization metrics of the Auto Scaling group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AutoScalingGroupName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AutoScalingGroupArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerAsgRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_asg_recommendation.listComputeOptimizerAsgRecommendations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &computeoptimizer.GetAutoScalingGroupRecommendationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("auto_scaling_group_arn"); arn != "" {
+		input.AutoScalingGroupArns = []string{arn}
+	}
+	if finding := d.KeyColumnQualString("finding"); finding != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   types.FilterNameFinding,
+				Values: []string{finding},
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.GetAutoScalingGroupRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_asg_recommendation.listComputeOptimizerAsgRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.AutoScalingGroupRecommendations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func asgRecommendationTopSavings(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	recommendation := d.HydrateItem.(types.AutoScalingGroupRecommendation)
+
+	// Recommendation options are ranked, with rank 1 being the best option
+	for _, option := range recommendation.RecommendationOptions {
+		if option.Rank == 1 {
+			return computeOptimizerSavingsOpportunityValue(option.SavingsOpportunity, d.Param.(string)), nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerEbsRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_ebs_recommendation",
+		Description: "AWS Compute Optimizer EBS Volume Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeOptimizerEbsRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "volume_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OptInRequiredException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "volume_arn",
+				Description: "The Amazon Resource Name (ARN) of the current volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding",
+				Description: "The finding classification of the volume. Possible values are NotOptimized and Optimized.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_performance_risk",
+				Description: "The risk of the current EBS volume not meeting the performance needs of its workloads.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_type",
+				Description: "The volume type of the current volume.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CurrentConfiguration.VolumeType"),
+			},
+			{
+				Name:        "volume_size",
+				Description: "The size of the current volume, in GiB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CurrentConfiguration.VolumeSize"),
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the volume recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the volume.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(ebsRecommendationTopSavings, "Currency"),
+			},
+			{
+				Name:        "estimated_monthly_savings_value",
+				Description: "The value of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(ebsRecommendationTopSavings, "Value"),
+			},
+			{
+				Name:        "savings_opportunity_percentage",
+				Description: "The estimated monthly savings possible as a percentage of monthly cost for the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(ebsRecommendationTopSavings, "Percentage"),
+			},
+			{
+				Name:        "current_configuration",
+				Description: "An object that describes the current configuration of the volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "volume_recommendation_options",
+				Description: "An array of objects that describe the recommendation options for the volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "An array of objects that describe the utilization metrics of the volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VolumeArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VolumeArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerEbsRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_ebs_recommendation.listComputeOptimizerEbsRecommendations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &computeoptimizer.GetEBSVolumeRecommendationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("volume_arn"); arn != "" {
+		input.VolumeArns = []string{arn}
+	}
+	if finding := d.KeyColumnQualString("finding"); finding != "" {
+		input.Filters = []types.EBSFilter{
+			{
+				Name:   types.EBSFilterNameFinding,
+				Values: []string{finding},
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.GetEBSVolumeRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_ebs_recommendation.listComputeOptimizerEbsRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.VolumeRecommendations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ebsRecommendationTopSavings(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	recommendation := d.HydrateItem.(types.VolumeRecommendation)
+
+	// Recommendation options are ranked, with rank 1 being the best option
+	for _, option := range recommendation.VolumeRecommendationOptions {
+		if option.Rank == 1 {
+			return computeOptimizerSavingsOpportunityValue(option.SavingsOpportunity, d.Param.(string)), nil
+		}
+	}
+
+	return nil, nil
+}
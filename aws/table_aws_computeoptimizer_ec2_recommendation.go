@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerEc2Recommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_ec2_recommendation",
+		Description: "AWS Compute Optimizer EC2 Instance Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeOptimizerEc2Recommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "instance_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OptInRequiredException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_arn",
+				Description: "The Amazon Resource Name (ARN) of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_name",
+				Description: "The name of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_instance_type",
+				Description: "The instance type of the current instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding",
+				Description: "The finding classification of the instance. Possible values are Underprovisioned, Overprovisioned and Optimized.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_performance_risk",
+				Description: "The risk of the current instance not meeting the performance needs of its workloads.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the instance recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "look_back_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the instance.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(ec2RecommendationTopSavings, "Currency"),
+			},
+			{
+				Name:        "estimated_monthly_savings_value",
+				Description: "The value of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(ec2RecommendationTopSavings, "Value"),
+			},
+			{
+				Name:        "savings_opportunity_percentage",
+				Description: "The estimated monthly savings possible as a percentage of monthly cost for the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(ec2RecommendationTopSavings, "Percentage"),
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "inferred_workload_types",
+				Description: "The applications that might be running on the instance as inferred by Compute Optimizer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "effective_recommendation_preferences",
+				Description: "An object that describes the effective recommendation preferences for the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommendation_options",
+				Description: "An array of objects that describe the recommendation options for the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "recommendation_sources",
+				Description: "An array of objects that describe the source resource of the recommendation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "An array of objects that describe the utilization metrics of the instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceName", "InstanceArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InstanceArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerEc2Recommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_ec2_recommendation.listComputeOptimizerEc2Recommendations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &computeoptimizer.GetEC2InstanceRecommendationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("instance_arn"); arn != "" {
+		input.InstanceArns = []string{arn}
+	}
+	if finding := d.KeyColumnQualString("finding"); finding != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   types.FilterNameFinding,
+				Values: []string{finding},
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.GetEC2InstanceRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_ec2_recommendation.listComputeOptimizerEc2Recommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.InstanceRecommendations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ec2RecommendationTopSavings(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	recommendation := d.HydrateItem.(types.InstanceRecommendation)
+
+	// Recommendation options are ranked, with rank 1 being the best option
+	for _, option := range recommendation.RecommendationOptions {
+		if option.Rank == 1 {
+			return computeOptimizerSavingsOpportunityValue(option.SavingsOpportunity, d.Param.(string)), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// computeOptimizerSavingsOpportunityValue extracts a single attribute of the
+// savings opportunity shared by all Compute Optimizer recommendation options
+func computeOptimizerSavingsOpportunityValue(savings *types.SavingsOpportunity, field string) interface{} {
+	if savings == nil {
+		return nil
+	}
+
+	switch field {
+	case "Percentage":
+		return savings.SavingsOpportunityPercentage
+	case "Currency":
+		if savings.EstimatedMonthlySavings != nil {
+			return savings.EstimatedMonthlySavings.Currency
+		}
+	case "Value":
+		if savings.EstimatedMonthlySavings != nil {
+			return savings.EstimatedMonthlySavings.Value
+		}
+	}
+
+	return nil
+}
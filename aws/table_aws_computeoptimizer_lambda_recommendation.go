@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsComputeOptimizerLambdaRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_computeoptimizer_lambda_recommendation",
+		Description: "AWS Compute Optimizer Lambda Function Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeOptimizerLambdaRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "function_arn", Require: plugin.Optional},
+				{Name: "finding", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OptInRequiredException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "function_arn",
+				Description: "The Amazon Resource Name (ARN) of the current function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "function_version",
+				Description: "The version number of the current function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finding",
+				Description: "The finding classification of the function. Possible values are Optimized, NotOptimized and Unavailable.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_memory_size",
+				Description: "The amount of memory, in MB, that's allocated to the current function.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "current_performance_risk",
+				Description: "The risk of the current Lambda function not meeting the performance needs of its workloads.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "number_of_invocations",
+				Description: "The number of times your function code was applied during the look-back period.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_refresh_timestamp",
+				Description: "The timestamp of when the function recommendation was last generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "lookback_period_in_days",
+				Description: "The number of days for which utilization metrics were analyzed for the function.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "estimated_monthly_savings_currency",
+				Description: "The currency of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(lambdaRecommendationTopSavings, "Currency"),
+			},
+			{
+				Name:        "estimated_monthly_savings_value",
+				Description: "The value of the estimated monthly savings of the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(lambdaRecommendationTopSavings, "Value"),
+			},
+			{
+				Name:        "savings_opportunity_percentage",
+				Description: "The estimated monthly savings possible as a percentage of monthly cost for the top ranked recommendation option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromP(lambdaRecommendationTopSavings, "Percentage"),
+			},
+			{
+				Name:        "finding_reason_codes",
+				Description: "The reasons for the finding classification of the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "memory_size_recommendation_options",
+				Description: "An array of objects that describe the memory configuration recommendation options for the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "utilization_metrics",
+				Description: "An array of objects that describe the utilization metrics of the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FunctionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeOptimizerLambdaRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ComputeOptimizerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_computeoptimizer_lambda_recommendation.listComputeOptimizerLambdaRecommendations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &computeoptimizer.GetLambdaFunctionRecommendationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("function_arn"); arn != "" {
+		input.FunctionArns = []string{arn}
+	}
+	if finding := d.KeyColumnQualString("finding"); finding != "" {
+		input.Filters = []types.LambdaFunctionRecommendationFilter{
+			{
+				Name:   types.LambdaFunctionRecommendationFilterNameFinding,
+				Values: []string{finding},
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.GetLambdaFunctionRecommendations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_computeoptimizer_lambda_recommendation.listComputeOptimizerLambdaRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.LambdaFunctionRecommendations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lambdaRecommendationTopSavings(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	recommendation := d.HydrateItem.(types.LambdaFunctionRecommendation)
+
+	// Recommendation options are ranked, with rank 1 being the best option
+	for _, option := range recommendation.MemorySizeRecommendationOptions {
+		if option.Rank == 1 {
+			return computeOptimizerSavingsOpportunityValue(option.SavingsOpportunity, d.Param.(string)), nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAllocationTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_allocation_tag",
+		Description: "AWS Cost Explorer - Cost Allocation Tag",
+		List: &plugin.ListConfig{
+			Hydrate: listCostAllocationTags,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "tag_key", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "tag_key",
+				Description: "The key for the cost allocation tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the cost allocation tag. Possible values are Active and Inactive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of cost allocation tag. You can use AWSGenerated or UserDefined type tags.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TagKey"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAllocationTags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_allocation_tag.listCostAllocationTags", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &costexplorer.ListCostAllocationTagsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if tagKey := d.KeyColumnQualString("tag_key"); tagKey != "" {
+		input.TagKeys = []string{tagKey}
+	}
+	if status := d.KeyColumnQualString("status"); status != "" {
+		input.Status = types.CostAllocationTagStatus(status)
+	}
+	if tagType := d.KeyColumnQualString("type"); tagType != "" {
+		input.Type = types.CostAllocationTagType(tagType)
+	}
+
+	paginator := costexplorer.NewListCostAllocationTagsPaginator(svc, input, func(o *costexplorer.ListCostAllocationTagsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_allocation_tag.listCostAllocationTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, tag := range output.CostAllocationTags {
+			d.StreamListItem(ctx, tag)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCostAllocationTagBackfillHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_allocation_tag_backfill_history",
+		Description: "AWS Cost Explorer - Cost Allocation Tag Backfill History",
+		List: &plugin.ListConfig{
+			Hydrate: listCostAllocationTagBackfillHistory,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "backfill_from",
+				Description: "The date the backfill starts from.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "backfill_status",
+				Description: "The status of the cost allocation tag backfill request. Possible values are SUCCEEDED, PROCESSING and FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "requested_at",
+				Description: "The time when the backfill was requested.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "completed_at",
+				Description: "The backfill completion time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The time when the backfill status was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedAt"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostAllocationTagBackfillHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cost_allocation_tag_backfill_history.listCostAllocationTagBackfillHistory", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &costexplorer.ListCostAllocationTagBackfillHistoryInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := costexplorer.NewListCostAllocationTagBackfillHistoryPaginator(svc, input, func(o *costexplorer.ListCostAllocationTagBackfillHistoryPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cost_allocation_tag_backfill_history.listCostAllocationTagBackfillHistory", "api_error", err)
+			return nil, err
+		}
+
+		for _, request := range output.BackfillRequests {
+			d.StreamListItem(ctx, request)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsCostByDimension(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cost_by_dimension",
+		Description: "AWS Cost Explorer - Cost by arbitrary dimension, tag or cost category",
+		List: &plugin.ListConfig{
+			Hydrate: listCostByDimension,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "granularity", Require: plugin.Required},
+				{Name: "group_by_1", Require: plugin.Required},
+				{Name: "group_by_2", Require: plugin.Optional},
+				{Name: "period_start", Operators: []string{"=", ">=", ">"}, Require: plugin.Optional},
+				{Name: "period_end", Operators: []string{"=", "<=", "<"}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns(
+			costExplorerColumns([]*plugin.Column{
+				{
+					Name:        "group_key_1",
+					Description: "The value of the first group by key. For tags the value is returned as <tag key>$<tag value>, for cost categories as <cost category name>$<cost category value>.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("Dimension1"),
+				},
+				{
+					Name:        "group_key_2",
+					Description: "The value of the second group by key. For tags the value is returned as <tag key>$<tag value>, for cost categories as <cost category name>$<cost category value>.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromField("Dimension2"),
+				},
+
+				// Quals columns - to filter the lookups
+				{
+					Name:        "granularity",
+					Description: "The granularity of the cost data. Valid values are DAILY, MONTHLY and HOURLY.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromQual("granularity"),
+				},
+				{
+					Name:        "group_by_1",
+					Description: "The first key to group costs by. Use a dimension name such as SERVICE, LINKED_ACCOUNT or USAGE_TYPE, TAG:<tag key> for a cost allocation tag, or COST_CATEGORY:<name> for a cost category.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromQual("group_by_1"),
+				},
+				{
+					Name:        "group_by_2",
+					Description: "The second key to group costs by, in the same format as group_by_1.",
+					Type:        proto.ColumnType_STRING,
+					Transform:   transform.FromQual("group_by_2"),
+				},
+			}),
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostByDimension(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	params := buildCostByDimensionInput(d)
+	return streamCostAndUsage(ctx, d, params)
+}
+
+func buildCostByDimensionInput(d *plugin.QueryData) *costexplorer.GetCostAndUsageInput {
+	granularity := strings.ToUpper(d.KeyColumnQualString("granularity"))
+	startTime, endTime := getCETimePeriodFromQuals(d, granularity)
+
+	params := &costexplorer.GetCostAndUsageInput{
+		TimePeriod: &types.DateInterval{
+			Start: aws.String(startTime),
+			End:   aws.String(endTime),
+		},
+		Granularity: types.Granularity(granularity),
+		Metrics:     AllCostMetrics(),
+	}
+
+	var groupings []types.GroupDefinition
+	for _, column := range []string{"group_by_1", "group_by_2"} {
+		if groupBy := d.KeyColumnQualString(column); groupBy != "" {
+			groupings = append(groupings, buildCostGroupDefinition(groupBy))
+		}
+	}
+	params.GroupBy = groupings
+
+	return params
+}
+
+// buildCostGroupDefinition converts a group by key such as SERVICE, TAG:team
+// or COST_CATEGORY:project into a Cost Explorer group definition
+func buildCostGroupDefinition(groupBy string) types.GroupDefinition {
+	if parts := strings.SplitN(groupBy, ":", 2); len(parts) == 2 {
+		switch strings.ToUpper(parts[0]) {
+		case "TAG":
+			return types.GroupDefinition{
+				Type: types.GroupDefinitionTypeTag,
+				Key:  aws.String(parts[1]),
+			}
+		case "COST_CATEGORY":
+			return types.GroupDefinition{
+				Type: types.GroupDefinitionTypeCostCategory,
+				Key:  aws.String(parts[1]),
+			}
+		}
+	}
+
+	return types.GroupDefinition{
+		Type: types.GroupDefinitionTypeDimension,
+		Key:  aws.String(strings.ToUpper(groupBy)),
+	}
+}
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsCurReportDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_cur_report_definition",
+		Description: "AWS Cost and Usage Report Definition",
+		List: &plugin.ListConfig{
+			Hydrate: listCurReportDefinitions,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "report_name",
+				Description: "The name of the report that you want to create.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the report definition.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCurReportDefinitionArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "time_unit",
+				Description: "The length of time covered by the report. Possible values are HOURLY, DAILY and MONTHLY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "format",
+				Description: "The format that AWS saves the report in. Possible values are textORcsv and Parquet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compression",
+				Description: "The compression format that AWS uses for the report. Possible values are ZIP, GZIP and Parquet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The S3 bucket where AWS delivers the report.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_prefix",
+				Description: "The prefix that AWS adds to the report name when AWS delivers the report.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_region",
+				Description: "The region of the S3 bucket that AWS delivers the report into.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "report_versioning",
+				Description: "Whether AWS creates a new report version or overwrites the previous one. Possible values are CREATE_NEW_REPORT and OVERWRITE_REPORT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "refresh_closed_reports",
+				Description: "Whether AWS updates the report after it has been finalized if AWS detects charges related to previous months.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "billing_view_arn",
+				Description: "The Amazon Resource Name (ARN) of the billing view.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "additional_artifacts",
+				Description: "A list of manifests that you want AWS to create for the report, such as REDSHIFT, QUICKSIGHT or ATHENA.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_schema_elements",
+				Description: "A list of strings that indicate additional content that AWS includes in the report, such as individual resource IDs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReportName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCurReportDefinitionArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCurReportDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostAndUsageReportClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cur_report_definition.listCurReportDefinitions", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(5)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &costandusagereportservice.DescribeReportDefinitionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := costandusagereportservice.NewDescribeReportDefinitionsPaginator(svc, input, func(o *costandusagereportservice.DescribeReportDefinitionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_cur_report_definition.listCurReportDefinitions", "api_error", err)
+			return nil, err
+		}
+
+		for _, report := range output.ReportDefinitions {
+			d.StreamListItem(ctx, report)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCurReportDefinitionArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	report := h.Item.(types.ReportDefinition)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_cur_report_definition.getCurReportDefinitionArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Report definitions don't have an ARN in the API response, so build it
+	arn := "arn:" + commonColumnData.Partition + ":cur:us-east-1:" + commonColumnData.AccountId + ":definition/" + *report.ReportName
+
+	return arn, nil
+}
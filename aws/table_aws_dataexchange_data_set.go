@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataExchangeDataSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dataexchange_data_set",
+		Description: "AWS Data Exchange Data Set",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getDataExchangeDataSet,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataExchangeDataSets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "origin", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "asset_type",
+				Description: "The type of asset that is added to the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "origin",
+				Description: "A property that defines the data set as OWNED by the account (for providers) or ENTITLED to the account (for subscribers).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description for the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_id",
+				Description: "The ID of the product that the entitled data set is part of.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginDetails.ProductId"),
+			},
+			{
+				Name:        "source_id",
+				Description: "The data set ID of the owned data set corresponding to the entitled data set being viewed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the data set was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time that the data set was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataExchangeResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataExchangeDataSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_data_set.listDataExchangeDataSets", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(200)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &dataexchange.ListDataSetsInput{}
+	if d.KeyColumnQuals["origin"] != nil {
+		input.Origin = aws.String(d.KeyColumnQuals["origin"].GetStringValue())
+	}
+
+	paginator := dataexchange.NewListDataSetsPaginator(svc, input, func(o *dataexchange.ListDataSetsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dataexchange_data_set.listDataExchangeDataSets", "api_error", err)
+			return nil, err
+		}
+
+		for _, dataSet := range output.DataSets {
+			d.StreamListItem(ctx, dataSet)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataExchangeDataSet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_data_set.getDataExchangeDataSet", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dataexchange.GetDataSetInput{
+		DataSetId: aws.String(id),
+	}
+
+	op, err := svc.GetDataSet(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_data_set.getDataExchangeDataSet", "api_error", err)
+		return nil, err
+	}
+
+	return types.DataSetEntry{
+		Arn:           op.Arn,
+		AssetType:     op.AssetType,
+		CreatedAt:     op.CreatedAt,
+		Description:   op.Description,
+		Id:            op.Id,
+		Name:          op.Name,
+		Origin:        op.Origin,
+		OriginDetails: op.OriginDetails,
+		SourceId:      op.SourceId,
+		UpdatedAt:     op.UpdatedAt,
+	}, nil
+}
+
+// getDataExchangeResourceTags returns the tags of a data set or revision
+func getDataExchangeResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.DataSetEntry:
+		arn = item.Arn
+	case types.RevisionEntry:
+		arn = item.Arn
+	}
+
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange.getDataExchangeResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dataexchange.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange.getDataExchangeResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataExchangeJob(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dataexchange_job",
+		Description: "AWS Data Exchange Job",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getDataExchangeJob,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataExchangeJobs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "data_set_id", Require: plugin.Optional},
+				{Name: "revision_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier for the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The job type, such as IMPORT_ASSETS_FROM_S3 or EXPORT_REVISIONS_TO_S3.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_set_id",
+				Description: "The unique identifier for the data set associated with the job. Only populated when used as a qualifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("data_set_id"),
+			},
+			{
+				Name:        "revision_id",
+				Description: "The unique identifier for the revision associated with the job. Only populated when used as a qualifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("revision_id"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the job was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time that the job was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "details",
+				Description: "Details of the operation to be performed by the job, such as the source and destination of the assets.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "errors",
+				Description: "Errors for the job.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataExchangeJobs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_job.listDataExchangeJobs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(200)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &dataexchange.ListJobsInput{}
+	if d.KeyColumnQuals["data_set_id"] != nil {
+		input.DataSetId = aws.String(d.KeyColumnQuals["data_set_id"].GetStringValue())
+	}
+	if d.KeyColumnQuals["revision_id"] != nil {
+		input.RevisionId = aws.String(d.KeyColumnQuals["revision_id"].GetStringValue())
+	}
+
+	paginator := dataexchange.NewListJobsPaginator(svc, input, func(o *dataexchange.ListJobsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dataexchange_job.listDataExchangeJobs", "api_error", err)
+			return nil, err
+		}
+
+		for _, job := range output.Jobs {
+			d.StreamListItem(ctx, job)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataExchangeJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_job.getDataExchangeJob", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dataexchange.GetJobInput{
+		JobId: aws.String(id),
+	}
+
+	op, err := svc.GetJob(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_job.getDataExchangeJob", "api_error", err)
+		return nil, err
+	}
+
+	return types.JobEntry{
+		Arn:       op.Arn,
+		CreatedAt: op.CreatedAt,
+		Details:   op.Details,
+		Errors:    op.Errors,
+		Id:        op.Id,
+		State:     op.State,
+		Type:      op.Type,
+		UpdatedAt: op.UpdatedAt,
+	}, nil
+}
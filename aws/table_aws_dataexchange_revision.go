@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataExchangeRevision(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dataexchange_revision",
+		Description: "AWS Data Exchange Revision",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"data_set_id", "id"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getDataExchangeRevision,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDataExchangeDataSets,
+			Hydrate:       listDataExchangeRevisions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "data_set_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier for the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_set_id",
+				Description: "The unique identifier for the data set associated with the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment",
+				Description: "An optional comment about the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "finalized",
+				Description: "Indicates whether the revision is finalized. Finalized revisions are visible to subscribers of products that include the data set.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "source_id",
+				Description: "The revision ID of the owned revision corresponding to the entitled revision being viewed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the revision was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time that the revision was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "revoked",
+				Description: "Indicates whether the revision has been revoked, making its assets unavailable to subscribers.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "revoked_at",
+				Description: "The date and time that the revision was revoked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "revocation_comment",
+				Description: "A required comment to inform subscribers of the reason their access to the revision was revoked.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataExchangeResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataExchangeRevisions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSet := h.Item.(types.DataSetEntry)
+
+	// Minimize API calls when a specific data set has been requested
+	if d.KeyColumnQuals["data_set_id"] != nil && d.KeyColumnQuals["data_set_id"].GetStringValue() != *dataSet.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_revision.listDataExchangeRevisions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(200)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &dataexchange.ListDataSetRevisionsInput{
+		DataSetId: dataSet.Id,
+	}
+
+	paginator := dataexchange.NewListDataSetRevisionsPaginator(svc, input, func(o *dataexchange.ListDataSetRevisionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dataexchange_revision.listDataExchangeRevisions", "api_error", err)
+			return nil, err
+		}
+
+		for _, revision := range output.Revisions {
+			d.StreamLeafListItem(ctx, revision)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataExchangeRevision(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	dataSetID := d.KeyColumnQuals["data_set_id"].GetStringValue()
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if data set id or id is empty
+	if dataSetID == "" || id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DataExchangeClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_revision.getDataExchangeRevision", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dataexchange.GetRevisionInput{
+		DataSetId:  aws.String(dataSetID),
+		RevisionId: aws.String(id),
+	}
+
+	op, err := svc.GetRevision(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dataexchange_revision.getDataExchangeRevision", "api_error", err)
+		return nil, err
+	}
+
+	return types.RevisionEntry{
+		Arn:               op.Arn,
+		Comment:           op.Comment,
+		CreatedAt:         op.CreatedAt,
+		DataSetId:         op.DataSetId,
+		Finalized:         op.Finalized,
+		Id:                op.Id,
+		RevocationComment: op.RevocationComment,
+		Revoked:           op.Revoked,
+		RevokedAt:         op.RevokedAt,
+		SourceId:          op.SourceId,
+		UpdatedAt:         op.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/datapipeline"
+	"github.com/aws/aws-sdk-go-v2/service/datapipeline/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataPipelinePipeline(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_datapipeline_pipeline",
+		Description: "AWS Data Pipeline Pipeline",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("pipeline_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"PipelineNotFoundException", "PipelineDeletedException", "InvalidRequestException"}),
+			},
+			Hydrate: getDataPipelinePipeline,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataPipelinePipelines,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "The ID of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDataPipelinePipeline,
+			},
+			{
+				Name:        "pipeline_state",
+				Description: "The state of the pipeline, such as PENDING, SCHEDULED or FINISHED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "@pipelineState"),
+			},
+			{
+				Name:        "health_status",
+				Description: "The health status of the pipeline, either HEALTHY or ERROR.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "@healthStatus"),
+			},
+			{
+				Name:        "unique_id",
+				Description: "The unique identifier that was provided when the pipeline was created.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "uniqueId"),
+			},
+			{
+				Name:        "pipeline_creator",
+				Description: "The user that created the pipeline.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "pipelineCreator"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the pipeline was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "@creationTime"),
+			},
+			{
+				Name:        "latest_run_time",
+				Description: "The time of the latest run of the pipeline.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "@latestRunTime"),
+			},
+			{
+				Name:        "next_run_time",
+				Description: "The time of the next scheduled run of the pipeline.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Fields").TransformP(dataPipelineFieldValue, "@nextRunTime"),
+			},
+			{
+				Name:        "fields",
+				Description: "A list of read-only fields that contain metadata about the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeDataPipelinePipeline,
+			},
+			{
+				Name:        "pipeline_objects",
+				Description: "The objects defined in the latest version of the pipeline definition.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataPipelinePipelineDefinition,
+			},
+			{
+				Name:        "parameter_objects",
+				Description: "The parameter objects used in the pipeline definition.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataPipelinePipelineDefinition,
+			},
+			{
+				Name:        "parameter_values",
+				Description: "The parameter values used in the pipeline definition.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataPipelinePipelineDefinition,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the pipeline.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeDataPipelinePipeline,
+				Transform:   transform.FromField("Tags").Transform(dataPipelineTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataPipelinePipelineAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataPipelinePipelines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DataPipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.listDataPipelinePipelines", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Does not support limit
+	paginator := datapipeline.NewListPipelinesPaginator(svc, &datapipeline.ListPipelinesInput{}, func(o *datapipeline.ListPipelinesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_datapipeline_pipeline.listDataPipelinePipelines", "api_error", err)
+			return nil, err
+		}
+
+		for _, pipeline := range output.PipelineIdList {
+			d.StreamListItem(ctx, pipeline)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataPipelinePipeline(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	pipelineID := d.KeyColumnQuals["pipeline_id"].GetStringValue()
+
+	// check if pipeline id is empty
+	if pipelineID == "" {
+		return nil, nil
+	}
+
+	pipeline, err := describePipeline(ctx, d, pipelineID)
+	if err != nil || pipeline == nil {
+		return nil, err
+	}
+
+	return types.PipelineIdName{
+		Id:   pipeline.PipelineId,
+		Name: pipeline.Name,
+	}, nil
+}
+
+// describeDataPipelinePipeline returns the description, fields and tags of the
+// pipeline, which are not included in the list response
+func describeDataPipelinePipeline(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipelineID := h.Item.(types.PipelineIdName).Id
+
+	pipeline, err := describePipeline(ctx, d, *pipelineID)
+	if err != nil || pipeline == nil {
+		return nil, err
+	}
+
+	return pipeline, nil
+}
+
+func describePipeline(ctx context.Context, d *plugin.QueryData, pipelineID string) (*types.PipelineDescription, error) {
+	// Create Session
+	svc, err := DataPipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.describePipeline", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &datapipeline.DescribePipelinesInput{
+		PipelineIds: []string{pipelineID},
+	}
+
+	op, err := svc.DescribePipelines(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.describePipeline", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.PipelineDescriptionList) == 0 {
+		return nil, nil
+	}
+
+	return &op.PipelineDescriptionList[0], nil
+}
+
+func getDataPipelinePipelineDefinition(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipelineID := h.Item.(types.PipelineIdName).Id
+
+	// Create Session
+	svc, err := DataPipelineClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.getDataPipelinePipelineDefinition", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &datapipeline.GetPipelineDefinitionInput{
+		PipelineId: pipelineID,
+	}
+
+	op, err := svc.GetPipelineDefinition(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.getDataPipelinePipelineDefinition", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getDataPipelinePipelineAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pipelineID := h.Item.(types.PipelineIdName).Id
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datapipeline_pipeline.getDataPipelinePipelineAkas", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Pipelines don't have an ARN in the API response, so build it
+	arn := "arn:" + commonColumnData.Partition + ":datapipeline:" + region + ":" + commonColumnData.AccountId + ":pipeline/" + *pipelineID
+
+	return []string{arn}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// dataPipelineFieldValue returns the value of the pipeline field with the key
+// passed as the transform param
+func dataPipelineFieldValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fields, ok := d.Value.([]types.Field)
+	if !ok {
+		return nil, nil
+	}
+	key := d.Param.(string)
+
+	for _, field := range fields {
+		if field.Key == nil || *field.Key != key {
+			continue
+		}
+		if field.StringValue != nil {
+			return *field.StringValue, nil
+		}
+		if field.RefValue != nil {
+			return *field.RefValue, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func dataPipelineTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.Tag)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/aws/aws-sdk-go-v2/service/datasync/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataSyncLocation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_datasync_location",
+		Description: "AWS DataSync Location",
+		List: &plugin.ListConfig{
+			Hydrate: listDataSyncLocations,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the location.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationArn"),
+			},
+			{
+				Name:        "location_uri",
+				Description: "The URI of the location, e.g. s3://bucket/prefix/ or nfs://server/path/.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location_type",
+				Description: "The type of the location, derived from the URI scheme, e.g. s3, efs, nfs, smb, hdfs, fsxw, fsxl, fsxz, fsxo, object-storage or azure-blob.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationUri").Transform(dataSyncLocationType),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the location was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDataSyncLocationDetails,
+			},
+			{
+				Name:        "details",
+				Description: "The type-specific configuration of the location, as returned by the corresponding DescribeLocation* operation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncLocationDetails,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the location.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataSyncResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationUri"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataSyncResourceTags,
+				Transform:   transform.FromValue().Transform(dataSyncTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LocationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataSyncLocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_location.listDataSyncLocations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &datasync.ListLocationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := datasync.NewListLocationsPaginator(svc, input, func(o *datasync.ListLocationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_datasync_location.listDataSyncLocations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Locations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataSyncLocationDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := h.Item.(types.LocationListEntry)
+	arn := location.LocationArn
+
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_location.getDataSyncLocationDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Each location type is described by its own API operation
+	var op interface{}
+	switch getDataSyncLocationType(*location.LocationUri) {
+	case "s3":
+		op, err = svc.DescribeLocationS3(ctx, &datasync.DescribeLocationS3Input{LocationArn: arn})
+	case "efs":
+		op, err = svc.DescribeLocationEfs(ctx, &datasync.DescribeLocationEfsInput{LocationArn: arn})
+	case "nfs":
+		op, err = svc.DescribeLocationNfs(ctx, &datasync.DescribeLocationNfsInput{LocationArn: arn})
+	case "smb":
+		op, err = svc.DescribeLocationSmb(ctx, &datasync.DescribeLocationSmbInput{LocationArn: arn})
+	case "hdfs":
+		op, err = svc.DescribeLocationHdfs(ctx, &datasync.DescribeLocationHdfsInput{LocationArn: arn})
+	case "fsxw":
+		op, err = svc.DescribeLocationFsxWindows(ctx, &datasync.DescribeLocationFsxWindowsInput{LocationArn: arn})
+	case "fsxl":
+		op, err = svc.DescribeLocationFsxLustre(ctx, &datasync.DescribeLocationFsxLustreInput{LocationArn: arn})
+	case "fsxz":
+		op, err = svc.DescribeLocationFsxOpenZfs(ctx, &datasync.DescribeLocationFsxOpenZfsInput{LocationArn: arn})
+	case "fsxo":
+		op, err = svc.DescribeLocationFsxOntap(ctx, &datasync.DescribeLocationFsxOntapInput{LocationArn: arn})
+	case "object-storage":
+		op, err = svc.DescribeLocationObjectStorage(ctx, &datasync.DescribeLocationObjectStorageInput{LocationArn: arn})
+	case "azure-blob":
+		op, err = svc.DescribeLocationAzureBlob(ctx, &datasync.DescribeLocationAzureBlobInput{LocationArn: arn})
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_location.getDataSyncLocationDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func dataSyncLocationType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	uri, ok := d.Value.(*string)
+	if !ok || uri == nil {
+		return nil, nil
+	}
+	return getDataSyncLocationType(*uri), nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getDataSyncLocationType returns the scheme of a DataSync location URI
+func getDataSyncLocationType(uri string) string {
+	if i := strings.Index(uri, "://"); i > 0 {
+		return uri[:i]
+	}
+	return ""
+}
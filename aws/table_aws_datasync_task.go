@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/aws/aws-sdk-go-v2/service/datasync/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataSyncTask(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_datasync_task",
+		Description: "AWS DataSync Task",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidRequestException"}),
+			},
+			Hydrate: getDataSyncTask,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataSyncTasks,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the task.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the task, which can be AVAILABLE, CREATING, QUEUED, RUNNING or UNAVAILABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the task was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "source_location_arn",
+				Description: "The Amazon Resource Name (ARN) of the source file system's location.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "destination_location_arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon Web Services storage resource's location.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "current_task_execution_arn",
+				Description: "The Amazon Resource Name (ARN) of the task execution that is transferring files.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "cloud_watch_log_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon CloudWatch log group that was used to monitor and log events in the task.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "bytes_per_second",
+				Description: "The bandwidth limit of the task in bytes per second. A value of -1 means there is no limit.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTask,
+				Transform:   transform.FromField("Options.BytesPerSecond"),
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "The schedule used to periodically transfer files from a source to a destination location.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+				Transform:   transform.FromField("Schedule.ScheduleExpression"),
+			},
+			{
+				Name:        "error_code",
+				Description: "Errors that DataSync encountered during execution of the task.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "error_detail",
+				Description: "Detailed description of an error that was encountered during the task execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "options",
+				Description: "The configuration options that control the behavior of the task, such as verification, overwrite and preservation settings and the bandwidth throttle.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "includes",
+				Description: "A list of filter rules that include specific data during the transfer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "excludes",
+				Description: "A list of filter rules that exclude specific data during the transfer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule used to periodically transfer files from a source to a destination location.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "source_network_interface_arns",
+				Description: "The Amazon Resource Names (ARNs) of the network interfaces created for the source location.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "destination_network_interface_arns",
+				Description: "The Amazon Resource Names (ARNs) of the network interfaces created for the destination location.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTask,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the task.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataSyncResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(dataSyncTaskTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataSyncResourceTags,
+				Transform:   transform.FromValue().Transform(dataSyncTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataSyncTasks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task.listDataSyncTasks", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &datasync.ListTasksInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := datasync.NewListTasksPaginator(svc, input, func(o *datasync.ListTasksPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_datasync_task.listDataSyncTasks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Tasks {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataSyncTask(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	if h.Item != nil {
+		arn = *h.Item.(types.TaskListEntry).TaskArn
+	} else {
+		arn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task.getDataSyncTask", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &datasync.DescribeTaskInput{
+		TaskArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeTask(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task.getDataSyncTask", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// listDataSyncResourceTags returns the tags of a DataSync task or location
+func listDataSyncResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.TaskListEntry:
+		arn = item.TaskArn
+	case *datasync.DescribeTaskOutput:
+		arn = item.TaskArn
+	case types.LocationListEntry:
+		arn = item.LocationArn
+	}
+
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync.listDataSyncResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &datasync.ListTagsForResourceInput{
+		ResourceArn: arn,
+		MaxResults:  aws.Int32(100),
+	}
+
+	paginator := datasync.NewListTagsForResourcePaginator(svc, params, func(o *datasync.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var tags []types.TagListEntry
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_datasync.listDataSyncResourceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func dataSyncTaskTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch item := d.HydrateItem.(type) {
+	case types.TaskListEntry:
+		if item.Name != nil {
+			return *item.Name, nil
+		}
+		return item.TaskArn, nil
+	case *datasync.DescribeTaskOutput:
+		if item.Name != nil {
+			return *item.Name, nil
+		}
+		return item.TaskArn, nil
+	}
+	return nil, nil
+}
+
+func dataSyncTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.TagListEntry)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		if i.Value != nil {
+			turbotTagsMap[*i.Key] = *i.Value
+		} else {
+			turbotTagsMap[*i.Key] = ""
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync"
+	"github.com/aws/aws-sdk-go-v2/service/datasync/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDataSyncTaskExecution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_datasync_task_execution",
+		Description: "AWS DataSync Task Execution",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidRequestException"}),
+			},
+			Hydrate: getDataSyncTaskExecution,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDataSyncTaskExecutions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "task_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the task execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskExecutionArn"),
+			},
+			{
+				Name:        "task_arn",
+				Description: "The Amazon Resource Name (ARN) of the task that was executed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskExecutionArn").Transform(dataSyncTaskExecutionTaskArn),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the task execution, which can be QUEUED, LAUNCHING, PREPARING, TRANSFERRING, VERIFYING, SUCCESS or ERROR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time that the task execution was started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "estimated_bytes_to_transfer",
+				Description: "The estimated physical number of bytes that will transfer over the network.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "estimated_files_to_transfer",
+				Description: "The expected number of files that is to be transferred over the network.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "bytes_transferred",
+				Description: "The total number of bytes that are involved in the transfer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "bytes_written",
+				Description: "The number of logical bytes written to the destination location.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "bytes_compressed",
+				Description: "The physical number of bytes transferred over the network after compression was applied.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "files_transferred",
+				Description: "The actual number of files that was transferred over the network.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "files_deleted",
+				Description: "The number of files, objects, and directories that DataSync deleted in the destination location.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "files_skipped",
+				Description: "The number of files, objects, and directories that DataSync skipped during the transfer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "files_verified",
+				Description: "The number of files, objects, and directories that DataSync verified during the transfer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "error_code",
+				Description: "Errors that DataSync encountered during execution of the task.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTaskExecution,
+				Transform:   transform.FromField("Result.ErrorCode"),
+			},
+			{
+				Name:        "error_detail",
+				Description: "Detailed description of an error that was encountered during the task execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDataSyncTaskExecution,
+				Transform:   transform.FromField("Result.ErrorDetail"),
+			},
+			{
+				Name:        "result",
+				Description: "The result of the task execution, including the duration and status of each phase.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "options",
+				Description: "The configuration options that were used for the task execution.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "includes",
+				Description: "A list of filter rules that include specific data during the transfer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+			{
+				Name:        "excludes",
+				Description: "A list of filter rules that exclude specific data during the transfer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDataSyncTaskExecution,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskExecutionArn").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskExecutionArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDataSyncTaskExecutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task_execution.listDataSyncTaskExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &datasync.ListTaskExecutionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["task_arn"] != nil {
+		input.TaskArn = aws.String(d.KeyColumnQuals["task_arn"].GetStringValue())
+	}
+
+	paginator := datasync.NewListTaskExecutionsPaginator(svc, input, func(o *datasync.ListTaskExecutionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_datasync_task_execution.listDataSyncTaskExecutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TaskExecutions {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataSyncTaskExecution(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	if h.Item != nil {
+		arn = *h.Item.(types.TaskExecutionListEntry).TaskExecutionArn
+	} else {
+		arn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := DataSyncClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task_execution.getDataSyncTaskExecution", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &datasync.DescribeTaskExecutionInput{
+		TaskExecutionArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeTaskExecution(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_datasync_task_execution.getDataSyncTaskExecution", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The task execution ARN has the form arn:aws:datasync:<region>:<account>:task/<task-id>/execution/<execution-id>
+func dataSyncTaskExecutionTaskArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn, ok := d.Value.(*string)
+	if !ok || arn == nil {
+		return nil, nil
+	}
+	if i := strings.Index(*arn, "/execution/"); i > 0 {
+		return (*arn)[:i], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/devopsguru"
+	"github.com/aws/aws-sdk-go-v2/service/devopsguru/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type devOpsGuruInsightInfo struct {
+	Id                  *string
+	Name                *string
+	Type                types.InsightType
+	Severity            types.InsightSeverity
+	Status              types.InsightStatus
+	InsightTimeRange    *types.InsightTimeRange
+	PredictionTimeRange *types.PredictionTimeRange
+	ResourceCollection  *types.ResourceCollection
+}
+
+//// TABLE DEFINITION
+
+func tableAwsDevOpsGuruInsight(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_devopsguru_insight",
+		Description: "AWS DevOps Guru Insight",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDevOpsGuruInsight,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDevOpsGuruInsights,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "type", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "start_time", Operators: []string{">", ">="}, Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the insight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the insight, REACTIVE or PROACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the insight, LOW, MEDIUM or HIGH.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the insight, ONGOING or CLOSED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time when the behavior described in the insight started. Insights started in the last 90 days are listed unless a start_time qual is given.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InsightTimeRange.StartTime"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The time when the behavior described in the insight ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InsightTimeRange.EndTime"),
+			},
+			{
+				Name:        "prediction_start_time",
+				Description: "The time range during which the behavior described in a proactive insight is expected to start.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PredictionTimeRange.StartTime"),
+			},
+			{
+				Name:        "prediction_end_time",
+				Description: "The time range during which the behavior described in a proactive insight is expected to end.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PredictionTimeRange.EndTime"),
+			},
+			{
+				Name:        "description",
+				Description: "Describes the insight.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDevOpsGuruInsight,
+			},
+			{
+				Name:        "ssm_ops_item_id",
+				Description: "The ID of the AWS Systems Manager OpsItem created for the insight.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeDevOpsGuruInsight,
+			},
+			{
+				Name:        "resource_collection",
+				Description: "The CloudFormation stacks or tagged resources analyzed to generate the insight.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "anomalies",
+				Description: "The anomalies that are part of the insight, with their severity, status and anomaly time range.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDevOpsGuruInsightAnomalies,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDevOpsGuruInsights(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DevOpsGuruClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_devopsguru_insight.listDevOpsGuruInsights", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	insightTypes := []types.InsightType{types.InsightTypeReactive, types.InsightTypeProactive}
+	if d.KeyColumnQuals["type"] != nil {
+		insightTypes = []types.InsightType{types.InsightType(d.KeyColumnQuals["type"].GetStringValue())}
+	}
+
+	// ListInsights requires a start time range unless only ongoing insights are
+	// requested
+	fromTime := time.Now().AddDate(0, 0, -90)
+	if d.Quals["start_time"] != nil {
+		for _, q := range d.Quals["start_time"].Quals {
+			fromTime = q.Value.GetTimestampValue().AsTime()
+		}
+	}
+
+	for _, insightType := range insightTypes {
+		input := &devopsguru.ListInsightsInput{
+			StatusFilter: &types.ListInsightsStatusFilter{},
+		}
+		if d.KeyColumnQuals["status"] != nil && d.KeyColumnQuals["status"].GetStringValue() == string(types.InsightStatusOngoing) {
+			input.StatusFilter.Ongoing = &types.ListInsightsOngoingStatusFilter{Type: insightType}
+		} else {
+			input.StatusFilter.Any = &types.ListInsightsAnyStatusFilter{
+				Type: insightType,
+				StartTimeRange: &types.StartTimeRange{
+					FromTime: aws.Time(fromTime),
+					ToTime:   aws.Time(time.Now()),
+				},
+			}
+		}
+
+		paginator := devopsguru.NewListInsightsPaginator(svc, input, func(o *devopsguru.ListInsightsPaginatorOptions) {
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_devopsguru_insight.listDevOpsGuruInsights", "api_error", err)
+				return nil, err
+			}
+
+			for _, insight := range output.ReactiveInsights {
+				d.StreamListItem(ctx, devOpsGuruInsightInfo{
+					Id:                 insight.Id,
+					Name:               insight.Name,
+					Type:               types.InsightTypeReactive,
+					Severity:           insight.Severity,
+					Status:             insight.Status,
+					InsightTimeRange:   insight.InsightTimeRange,
+					ResourceCollection: insight.ResourceCollection,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			for _, insight := range output.ProactiveInsights {
+				d.StreamListItem(ctx, devOpsGuruInsightInfo{
+					Id:                  insight.Id,
+					Name:                insight.Name,
+					Type:                types.InsightTypeProactive,
+					Severity:            insight.Severity,
+					Status:              insight.Status,
+					InsightTimeRange:    insight.InsightTimeRange,
+					PredictionTimeRange: insight.PredictionTimeRange,
+					ResourceCollection:  insight.ResourceCollection,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevOpsGuruInsight(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	op, err := getDevOpsGuruInsightDetails(ctx, d, id)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	if insight := op.ReactiveInsight; insight != nil {
+		return devOpsGuruInsightInfo{
+			Id:                 insight.Id,
+			Name:               insight.Name,
+			Type:               types.InsightTypeReactive,
+			Severity:           insight.Severity,
+			Status:             insight.Status,
+			InsightTimeRange:   insight.InsightTimeRange,
+			ResourceCollection: insight.ResourceCollection,
+		}, nil
+	}
+
+	if insight := op.ProactiveInsight; insight != nil {
+		return devOpsGuruInsightInfo{
+			Id:                  insight.Id,
+			Name:                insight.Name,
+			Type:                types.InsightTypeProactive,
+			Severity:            insight.Severity,
+			Status:              insight.Status,
+			InsightTimeRange:    insight.InsightTimeRange,
+			PredictionTimeRange: insight.PredictionTimeRange,
+			ResourceCollection:  insight.ResourceCollection,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// describeDevOpsGuruInsight returns the description and OpsItem of the
+// insight, which are not included in the list response
+func describeDevOpsGuruInsight(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(devOpsGuruInsightInfo).Id
+
+	op, err := getDevOpsGuruInsightDetails(ctx, d, *id)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	if op.ReactiveInsight != nil {
+		return op.ReactiveInsight, nil
+	}
+	if op.ProactiveInsight != nil {
+		return op.ProactiveInsight, nil
+	}
+
+	return nil, nil
+}
+
+func getDevOpsGuruInsightDetails(ctx context.Context, d *plugin.QueryData, id string) (*devopsguru.DescribeInsightOutput, error) {
+	// Create Session
+	svc, err := DevOpsGuruClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_devopsguru_insight.getDevOpsGuruInsightDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &devopsguru.DescribeInsightInput{
+		Id: aws.String(id),
+	}
+
+	op, err := svc.DescribeInsight(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_devopsguru_insight.getDevOpsGuruInsightDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listDevOpsGuruInsightAnomalies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	insight := h.Item.(devOpsGuruInsightInfo)
+
+	// Create Session
+	svc, err := DevOpsGuruClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_devopsguru_insight.listDevOpsGuruInsightAnomalies", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &devopsguru.ListAnomaliesForInsightInput{
+		InsightId: insight.Id,
+	}
+
+	paginator := devopsguru.NewListAnomaliesForInsightPaginator(svc, input, func(o *devopsguru.ListAnomaliesForInsightPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var anomalies []interface{}
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_devopsguru_insight.listDevOpsGuruInsightAnomalies", "api_error", err)
+			return nil, err
+		}
+
+		for _, anomaly := range output.ReactiveAnomalies {
+			anomalies = append(anomalies, anomaly)
+		}
+		for _, anomaly := range output.ProactiveAnomalies {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	return anomalies, nil
+}
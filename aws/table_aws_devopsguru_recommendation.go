@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/devopsguru"
+	"github.com/aws/aws-sdk-go-v2/service/devopsguru/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type devOpsGuruRecommendationInfo = struct {
+	types.Recommendation
+	InsightId   *string
+	InsightName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsDevOpsGuruRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_devopsguru_recommendation",
+		Description: "AWS DevOps Guru Recommendation",
+		List: &plugin.ListConfig{
+			ParentHydrate: listDevOpsGuruInsights,
+			Hydrate:       listDevOpsGuruRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "insight_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "insight_id",
+				Description: "The ID of the insight the recommendation was generated for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "insight_name",
+				Description: "The name of the insight the recommendation was generated for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category type of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "The reason DevOps Guru flagged the anomalous behavior as a problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "link",
+				Description: "A hyperlink to information to help you address the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "related_anomalies",
+				Description: "Anomalies that are related to the problem.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "related_events",
+				Description: "Events that are related to the problem.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDevOpsGuruRecommendations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	insight := h.Item.(devOpsGuruInsightInfo)
+
+	// Minimize API calls when a specific insight has been requested
+	if d.KeyColumnQuals["insight_id"] != nil && d.KeyColumnQuals["insight_id"].GetStringValue() != *insight.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DevOpsGuruClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_devopsguru_recommendation.listDevOpsGuruRecommendations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &devopsguru.ListRecommendationsInput{
+		InsightId: insight.Id,
+	}
+
+	paginator := devopsguru.NewListRecommendationsPaginator(svc, input, func(o *devopsguru.ListRecommendationsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_devopsguru_recommendation.listDevOpsGuruRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, recommendation := range output.Recommendations {
+			d.StreamLeafListItem(ctx, devOpsGuruRecommendationInfo{recommendation, insight.Id, insight.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationdiscoveryservice"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDiscoveryAgent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_discovery_agent",
+		Description: "AWS Application Discovery Service Agent",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("agent_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"HomeRegionNotSetException", "InvalidParameterValueException"}),
+			},
+			Hydrate: getDiscoveryAgent,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDiscoveryAgents,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"HomeRegionNotSetException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "agent_id",
+				Description: "The agent or connector ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_name",
+				Description: "The name of the host where the agent or connector resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "agent_type",
+				Description: "The type of agent, such as an agent or a connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_status",
+				Description: "The health of the agent or connector. Valid values are HEALTHY, UNHEALTHY, RUNNING, UNKNOWN, BLACKLISTED, SHUTDOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "collection_status",
+				Description: "Status of the collection process for an agent or connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connector_id",
+				Description: "The ID of the connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The agent or connector version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_health_ping_time",
+				Description: "Time since agent or connector health was reported.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "registered_time",
+				Description: "Agent's first registration timestamp.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "agent_network_info_list",
+				Description: "Network details about the host where the agent or connector resides.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AgentId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDiscoveryAgents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ApplicationDiscoveryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_discovery_agent.listDiscoveryAgents", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &applicationdiscoveryservice.DescribeAgentsInput{}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.DescribeAgents(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_discovery_agent.listDiscoveryAgents", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.AgentsInfo {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDiscoveryAgent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	agentId := d.KeyColumnQuals["agent_id"].GetStringValue()
+
+	// Empty check
+	if agentId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ApplicationDiscoveryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_discovery_agent.getDiscoveryAgent", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &applicationdiscoveryservice.DescribeAgentsInput{
+		AgentIds: []string{agentId},
+	}
+
+	op, err := svc.DescribeAgents(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_discovery_agent.getDiscoveryAgent", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.AgentsInfo) > 0 {
+		return op.AgentsInfo[0], nil
+	}
+	return nil, nil
+}
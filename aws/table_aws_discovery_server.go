@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationdiscoveryservice"
+	"github.com/aws/aws-sdk-go-v2/service/applicationdiscoveryservice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDiscoveryServer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_discovery_server",
+		Description: "AWS Application Discovery Service Server",
+		List: &plugin.ListConfig{
+			Hydrate: listDiscoveryServers,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"HomeRegionNotSetException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "configuration_id",
+				Description: "The configuration ID of the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.configurationId"),
+			},
+			{
+				Name:        "host_name",
+				Description: "The host name of the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.hostName"),
+			},
+			{
+				Name:        "agent_id",
+				Description: "The ID of the agent or connector that discovered the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.agentId"),
+			},
+			{
+				Name:        "server_type",
+				Description: "The type of the server, such as EC2 or VMWARE_VM.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.type"),
+			},
+			{
+				Name:        "os_name",
+				Description: "The name of the operating system of the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.osName"),
+			},
+			{
+				Name:        "os_version",
+				Description: "The version of the operating system of the server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.osVersion"),
+			},
+			{
+				Name:        "time_of_creation",
+				Description: "The time the server configuration item was created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.timeOfCreation"),
+			},
+			{
+				Name:        "configuration",
+				Description: "All the attributes of the server configuration item, keyed by attribute name.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(discoveryConfigurationAttribute, "server.hostName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDiscoveryServers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ApplicationDiscoveryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_discovery_server.listDiscoveryServers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &applicationdiscoveryservice.ListConfigurationsInput{
+		ConfigurationType: types.ConfigurationItemTypeServer,
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListConfigurations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_discovery_server.listDiscoveryServers", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Configurations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Configuration items are returned as flat maps with dotted attribute names, e.g. "server.hostName"
+func discoveryConfigurationAttribute(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	configuration := d.HydrateItem.(map[string]string)
+
+	if value, ok := configuration[d.Param.(string)]; ok && value != "" {
+		return value, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDocDBElasticCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_docdb_elastic_cluster",
+		Description: "AWS DocumentDB Elastic Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDocDBElasticCluster,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDocDBElasticClusters,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "cluster_name",
+				Description: "The name of the elastic cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the elastic cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the elastic cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the elastic cluster was created in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "admin_user_name",
+				Description: "The name of the elastic cluster administrator.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "auth_type",
+				Description: "The authentication type for the elastic cluster, which can be PLAIN_TEXT or SECRET_ARN.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "cluster_endpoint",
+				Description: "The URL used to connect to the elastic cluster.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "shard_capacity",
+				Description: "The number of vCPUs assigned to each elastic cluster shard.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "shard_count",
+				Description: "The number of shards assigned to the elastic cluster.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key identifier to use to encrypt the elastic cluster.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "The weekly time range during which system maintenance can occur, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The Amazon EC2 subnet IDs for the elastic cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBElasticCluster,
+			},
+			{
+				Name:        "vpc_security_group_ids",
+				Description: "A list of EC2 VPC security groups associated with the elastic cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBElasticCluster,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDocDBElasticResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ClusterArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDocDBElasticClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DocDBElasticClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster.listDocDBElasticClusters", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &docdbelastic.ListClustersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// Page through the results manually
+	for {
+		output, err := svc.ListClusters(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_docdb_elastic_cluster.listDocDBElasticClusters", "api_error", err)
+			return nil, err
+		}
+
+		for _, cluster := range output.Clusters {
+			d.StreamListItem(ctx, cluster)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDocDBElasticCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var clusterArn string
+	if h.Item != nil {
+		clusterArn = *h.Item.(types.ClusterInList).ClusterArn
+	} else {
+		clusterArn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if clusterArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DocDBElasticClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster.getDocDBElasticCluster", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &docdbelastic.GetClusterInput{
+		ClusterArn: aws.String(clusterArn),
+	}
+
+	op, err := svc.GetCluster(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster.getDocDBElasticCluster", "api_error", err)
+		return nil, err
+	}
+
+	return op.Cluster, nil
+}
+
+// listDocDBElasticResourceTags is shared by the elastic cluster and elastic cluster snapshot tables
+func listDocDBElasticResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var resourceArn *string
+	switch item := h.Item.(type) {
+	case types.ClusterInList:
+		resourceArn = item.ClusterArn
+	case *types.Cluster:
+		resourceArn = item.ClusterArn
+	case types.ClusterSnapshotInList:
+		resourceArn = item.SnapshotArn
+	case *types.ClusterSnapshot:
+		resourceArn = item.SnapshotArn
+	}
+
+	if resourceArn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DocDBElasticClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic.listDocDBElasticResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &docdbelastic.ListTagsForResourceInput{
+		ResourceArn: resourceArn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic.listDocDBElasticResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
+	"github.com/aws/aws-sdk-go-v2/service/docdbelastic/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDocDBElasticClusterSnapshot(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_docdb_elastic_cluster_snapshot",
+		Description: "AWS DocumentDB Elastic Cluster Snapshot",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDocDBElasticClusterSnapshot,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDocDBElasticClusterSnapshots,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "cluster_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "snapshot_name",
+				Description: "The name of the elastic cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the elastic cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotArn"),
+			},
+			{
+				Name:        "cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the elastic cluster the snapshot was taken from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the elastic cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_creation_time",
+				Description: "The time when the elastic cluster snapshot was created in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cluster_creation_time",
+				Description: "The time when the elastic cluster was created in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDocDBElasticClusterSnapshot,
+			},
+			{
+				Name:        "admin_user_name",
+				Description: "The name of the elastic cluster administrator.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticClusterSnapshot,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The KMS key identifier used to encrypt the elastic cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDocDBElasticClusterSnapshot,
+			},
+			{
+				Name:        "subnet_ids",
+				Description: "The Amazon EC2 subnet IDs for the elastic cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBElasticClusterSnapshot,
+			},
+			{
+				Name:        "vpc_security_group_ids",
+				Description: "A list of EC2 VPC security groups associated with the elastic cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDocDBElasticClusterSnapshot,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDocDBElasticResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SnapshotArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDocDBElasticClusterSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DocDBElasticClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster_snapshot.listDocDBElasticClusterSnapshots", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &docdbelastic.ListClusterSnapshotsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.KeyColumnQuals["cluster_arn"] != nil {
+		input.ClusterArn = aws.String(d.KeyColumnQuals["cluster_arn"].GetStringValue())
+	}
+
+	// Page through the results manually
+	for {
+		output, err := svc.ListClusterSnapshots(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_docdb_elastic_cluster_snapshot.listDocDBElasticClusterSnapshots", "api_error", err)
+			return nil, err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			d.StreamListItem(ctx, snapshot)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDocDBElasticClusterSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var snapshotArn string
+	if h.Item != nil {
+		snapshotArn = *h.Item.(types.ClusterSnapshotInList).SnapshotArn
+	} else {
+		snapshotArn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if snapshotArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DocDBElasticClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster_snapshot.getDocDBElasticClusterSnapshot", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &docdbelastic.GetClusterSnapshotInput{
+		SnapshotArn: aws.String(snapshotArn),
+	}
+
+	op, err := svc.GetClusterSnapshot(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_docdb_elastic_cluster_snapshot.getDocDBElasticClusterSnapshot", "api_error", err)
+		return nil, err
+	}
+
+	return op.Snapshot, nil
+}
@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDynamoDBImport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dynamodb_import",
+		Description: "AWS DynamoDB Import",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ImportNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDynamoDBImport,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDynamoDBImports,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "table_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Number (ARN) corresponding to the import request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImportArn"),
+			},
+			{
+				Name:        "import_status",
+				Description: "The status of the import, which can be IN_PROGRESS, COMPLETED, CANCELLING, CANCELLED or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_arn",
+				Description: "The Amazon Resource Number (ARN) of the table being imported into.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_id",
+				Description: "The table id corresponding to the table created by import table process.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "input_format",
+				Description: "The format of the source data, which can be CSV, DYNAMODB_JSON or ION.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "input_compression_type",
+				Description: "The compression options for the data that has been imported into the target table, which can be GZIP, ZSTD or NONE.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "start_time",
+				Description: "The time when this import task started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The time at which the creation of the table associated with this import task completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cloudwatch_log_group_arn",
+				Description: "The Amazon Resource Number (ARN) of the CloudWatch Log Group associated with the target table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CloudWatchLogGroupArn"),
+			},
+			{
+				Name:        "imported_item_count",
+				Description: "The number of items successfully imported into the new table.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "processed_item_count",
+				Description: "The total number of items processed from the source file.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "processed_size_bytes",
+				Description: "The total size of data processed from the source file, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "error_count",
+				Description: "The number of errors occurred on importing the source file into the target table.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "failure_code",
+				Description: "The error code corresponding to the failure that the import job ran into during execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "failure_message",
+				Description: "The error message corresponding to the failure that the import job ran into during execution.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "s3_bucket_source",
+				Description: "Values for the S3 bucket the source file is imported from, including the bucket name, owner and key prefix.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "input_format_options",
+				Description: "The format options for the data that was imported into the target table.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDynamoDBImport,
+			},
+			{
+				Name:        "table_creation_parameters",
+				Description: "The parameters for the new table that is being imported into.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDynamoDBImport,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ImportArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ImportArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDynamoDBImports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DynamoDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_import.listDynamoDBImports", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &dynamodb.ListImportsInput{
+		PageSize: aws.Int32(maxLimit),
+	}
+
+	// Additonal Filter
+	if d.KeyColumnQuals["table_arn"] != nil {
+		input.TableArn = aws.String(d.KeyColumnQuals["table_arn"].GetStringValue())
+	}
+
+	paginator := dynamodb.NewListImportsPaginator(svc, input, func(o *dynamodb.ListImportsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dynamodb_import.listDynamoDBImports", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.ImportSummaryList {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDynamoDBImport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var importArn string
+	if h.Item != nil {
+		importArn = *h.Item.(types.ImportSummary).ImportArn
+	} else {
+		importArn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if importArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DynamoDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_import.getDynamoDBImport", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dynamodb.DescribeImportInput{
+		ImportArn: aws.String(importArn),
+	}
+
+	op, err := svc.DescribeImport(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_import.getDynamoDBImport", "api_error", err)
+		return nil, err
+	}
+
+	return op.ImportTableDescription, nil
+}
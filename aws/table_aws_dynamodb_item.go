@@ -0,0 +1,396 @@
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type dynamoDBItemInfo struct {
+	TableName         string
+	PartitionKeyValue *string
+	SortKeyValue      *string
+	Item              map[string]interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsDynamoDBItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dynamodb_item",
+		Description: "AWS DynamoDB Item",
+		List: &plugin.ListConfig{
+			Hydrate: listDynamoDBItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "table_name"},
+				{Name: "index_name", Require: plugin.Optional},
+				{Name: "partition_key_value"},
+				{Name: "sort_key_value", Operators: []string{"=", ">", ">=", "<", "<="}, Require: plugin.Optional},
+				{Name: "filter_expression", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "expression_attribute_names", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "expression_attribute_values", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "table_name",
+				Description: "The name of the table containing the item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "item",
+				Description: "The attributes of the item, converted from DynamoDB attribute values to plain JSON.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "index_name",
+				Description: "The name of a secondary index to read from, instead of the table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("index_name"),
+			},
+			{
+				Name:        "partition_key_value",
+				Description: "The value of the item's partition key (or of the index's partition key if index_name is set). Binary values are base64 encoded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sort_key_value",
+				Description: "The value of the item's sort key (or of the index's sort key if index_name is set). Supports the =, >, >=, < and <= operators, compared as text. Binary values are base64 encoded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter_expression",
+				Description: "A DynamoDB filter expression applied by the service to the items read, e.g. 'attribute_exists(email)'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter_expression"),
+			},
+			{
+				Name:        "expression_attribute_names",
+				Description: "Substitution tokens for attribute names used in the filter expression, e.g. {\"#s\": \"status\"}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("expression_attribute_names"),
+			},
+			{
+				Name:        "expression_attribute_values",
+				Description: "Values used in the filter expression, as plain JSON, e.g. {\":s\": \"active\"}.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("expression_attribute_values"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDynamoDBItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	tableName := d.KeyColumnQuals["table_name"].GetStringValue()
+
+	// Empty check
+	if tableName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DynamoDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_item.listDynamoDBItems", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	var indexName *string
+	if d.KeyColumnQuals["index_name"] != nil {
+		indexName = aws.String(d.KeyColumnQuals["index_name"].GetStringValue())
+	}
+
+	// Expression attributes supplied by the user for the filter expression
+	attributeNames := map[string]string{}
+	if d.KeyColumnQuals["expression_attribute_names"] != nil {
+		err := json.Unmarshal([]byte(d.KeyColumnQuals["expression_attribute_names"].GetJsonbValue()), &attributeNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse 'expression_attribute_names': %v", err)
+		}
+	}
+	attributeValues := map[string]types.AttributeValue{}
+	if d.KeyColumnQuals["expression_attribute_values"] != nil {
+		attributeValues, err = jsonToDynamoDBAttributeValues(d.KeyColumnQuals["expression_attribute_values"].GetJsonbValue())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse 'expression_attribute_values': %v", err)
+		}
+	}
+
+	var filterExpression *string
+	if d.KeyColumnQuals["filter_expression"] != nil {
+		filterExpression = aws.String(d.KeyColumnQuals["filter_expression"].GetStringValue())
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The key condition needs the names and types of the key attributes
+	table, err := svc.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_item.listDynamoDBItems", "api_error", err)
+		return nil, err
+	}
+	partitionKey, sortKey := getDynamoDBItemKeySchema(table.Table, indexName)
+	if partitionKey == "" {
+		return nil, fmt.Errorf("unable to find the partition key of table %s", tableName)
+	}
+
+	partitionKeyValue, err := dynamoDBKeyAttributeValue(table.Table, partitionKey, d.KeyColumnQuals["partition_key_value"].GetStringValue())
+	if err != nil {
+		return nil, err
+	}
+	attributeNames["#pk"] = partitionKey
+	attributeValues[":pk"] = partitionKeyValue
+	keyConditions := []string{"#pk = :pk"}
+
+	if d.Quals["sort_key_value"] != nil && sortKey != "" {
+		// A key condition holds a single sort key condition, so only one qual is
+		// pushed down, preferring equality. Any other sort_key_value quals are
+		// applied by Steampipe to the returned rows.
+		// sort_key_value is a string column, so those quals compare text. A range on a
+		// number sort key is not pushed down, since DynamoDB would compare numerically
+		// and could drop rows that match the text comparison.
+		numeric := dynamoDBKeyAttributeType(table.Table, sortKey) == types.ScalarAttributeTypeN
+		var operator string
+		var value *proto.QualValue
+		for _, q := range d.Quals["sort_key_value"].Quals {
+			if q.Operator == "=" {
+				operator, value = q.Operator, q.Value
+				break
+			}
+			if value == nil && !numeric {
+				operator, value = q.Operator, q.Value
+			}
+		}
+
+		if value != nil {
+			sortKeyValue, err := dynamoDBKeyAttributeValue(table.Table, sortKey, value.GetStringValue())
+			if err != nil {
+				return nil, err
+			}
+			attributeNames["#sk"] = sortKey
+			attributeValues[":sk"] = sortKeyValue
+			keyConditions = append(keyConditions, "#sk "+operator+" :sk")
+		}
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(tableName),
+		IndexName:                 indexName,
+		KeyConditionExpression:    aws.String(strings.Join(keyConditions, " AND ")),
+		FilterExpression:          filterExpression,
+		ExpressionAttributeNames:  attributeNames,
+		ExpressionAttributeValues: attributeValues,
+		Limit:                     aws.Int32(maxLimit),
+	}
+
+	paginator := dynamodb.NewQueryPaginator(svc, input, func(o *dynamodb.QueryPaginatorOptions) {
+		o.Limit = maxLimit
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dynamodb_item.listDynamoDBItems", "api_error", err)
+			return nil, err
+		}
+		for _, item := range output.Items {
+			d.StreamListItem(ctx, dynamoDBItemInfo{
+				TableName:         tableName,
+				PartitionKeyValue: dynamoDBKeyAttributeString(item[partitionKey]),
+				SortKeyValue:      dynamoDBKeyAttributeString(item[sortKey]),
+				Item:              dynamoDBAttributeValuesToJSON(item),
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getDynamoDBItemKeySchema returns the partition and sort key names of the table, or of the given secondary index
+func getDynamoDBItemKeySchema(table *types.TableDescription, indexName *string) (string, string) {
+	keySchema := table.KeySchema
+	if indexName != nil {
+		keySchema = nil
+		for _, index := range table.GlobalSecondaryIndexes {
+			if aws.ToString(index.IndexName) == *indexName {
+				keySchema = index.KeySchema
+			}
+		}
+		for _, index := range table.LocalSecondaryIndexes {
+			if aws.ToString(index.IndexName) == *indexName {
+				keySchema = index.KeySchema
+			}
+		}
+	}
+
+	var partitionKey, sortKey string
+	for _, key := range keySchema {
+		switch key.KeyType {
+		case types.KeyTypeHash:
+			partitionKey = aws.ToString(key.AttributeName)
+		case types.KeyTypeRange:
+			sortKey = aws.ToString(key.AttributeName)
+		}
+	}
+	return partitionKey, sortKey
+}
+
+// dynamoDBKeyAttributeType returns the declared type of a key attribute, defaulting to string
+func dynamoDBKeyAttributeType(table *types.TableDescription, attributeName string) types.ScalarAttributeType {
+	for _, definition := range table.AttributeDefinitions {
+		if aws.ToString(definition.AttributeName) == attributeName {
+			return definition.AttributeType
+		}
+	}
+	return types.ScalarAttributeTypeS
+}
+
+// dynamoDBKeyAttributeValue converts a key value passed as a string into an attribute value of the key's declared type
+func dynamoDBKeyAttributeValue(table *types.TableDescription, attributeName string, value string) (types.AttributeValue, error) {
+	switch dynamoDBKeyAttributeType(table, attributeName) {
+	case types.ScalarAttributeTypeN:
+		return &types.AttributeValueMemberN{Value: value}, nil
+	case types.ScalarAttributeTypeB:
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("binary key attribute %s must be base64 encoded: %v", attributeName, err)
+		}
+		return &types.AttributeValueMemberB{Value: data}, nil
+	}
+	return &types.AttributeValueMemberS{Value: value}, nil
+}
+
+// dynamoDBKeyAttributeString returns a key attribute value as a string, the reverse of dynamoDBKeyAttributeValue
+func dynamoDBKeyAttributeString(value types.AttributeValue) *string {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return aws.String(v.Value)
+	case *types.AttributeValueMemberN:
+		return aws.String(v.Value)
+	case *types.AttributeValueMemberB:
+		return aws.String(base64.StdEncoding.EncodeToString(v.Value))
+	}
+	return nil
+}
+
+// dynamoDBAttributeValuesToJSON converts DynamoDB attribute values into plain JSON values
+func dynamoDBAttributeValuesToJSON(values map[string]types.AttributeValue) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		result[name] = dynamoDBAttributeValueToJSON(value)
+	}
+	return result
+}
+
+func dynamoDBAttributeValueToJSON(value types.AttributeValue) interface{} {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *types.AttributeValueMemberB:
+		return v.Value
+	case *types.AttributeValueMemberBOOL:
+		return v.Value
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberSS:
+		return v.Value
+	case *types.AttributeValueMemberNS:
+		numbers := make([]json.Number, len(v.Value))
+		for i, n := range v.Value {
+			numbers[i] = json.Number(n)
+		}
+		return numbers
+	case *types.AttributeValueMemberBS:
+		return v.Value
+	case *types.AttributeValueMemberL:
+		list := make([]interface{}, len(v.Value))
+		for i, item := range v.Value {
+			list[i] = dynamoDBAttributeValueToJSON(item)
+		}
+		return list
+	case *types.AttributeValueMemberM:
+		return dynamoDBAttributeValuesToJSON(v.Value)
+	}
+	return nil
+}
+
+// jsonToDynamoDBAttributeValues converts a plain JSON object into DynamoDB attribute values
+func jsonToDynamoDBAttributeValues(data string) (map[string]types.AttributeValue, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]types.AttributeValue, len(values))
+	for name, value := range values {
+		result[name] = jsonToDynamoDBAttributeValue(value)
+	}
+	return result, nil
+}
+
+func jsonToDynamoDBAttributeValue(value interface{}) types.AttributeValue {
+	switch v := value.(type) {
+	case string:
+		return &types.AttributeValueMemberS{Value: v}
+	case json.Number:
+		return &types.AttributeValueMemberN{Value: v.String()}
+	case bool:
+		return &types.AttributeValueMemberBOOL{Value: v}
+	case []interface{}:
+		list := make([]types.AttributeValue, len(v))
+		for i, item := range v {
+			list[i] = jsonToDynamoDBAttributeValue(item)
+		}
+		return &types.AttributeValueMemberL{Value: list}
+	case map[string]interface{}:
+		m := make(map[string]types.AttributeValue, len(v))
+		for key, item := range v {
+			m[key] = jsonToDynamoDBAttributeValue(item)
+		}
+		return &types.AttributeValueMemberM{Value: m}
+	}
+	return &types.AttributeValueMemberNULL{Value: true}
+}
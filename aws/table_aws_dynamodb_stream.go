@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsDynamoDBStream(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_dynamodb_stream",
+		Description: "AWS DynamoDB Stream",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getDynamoDBStream,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDynamoDBStreams,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "table_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the stream.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StreamArn"),
+			},
+			{
+				Name:        "table_name",
+				Description: "The DynamoDB table with which the stream is associated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stream_label",
+				Description: "A timestamp, in ISO 8601 format, for this stream.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "stream_status",
+				Description: "Indicates the current status of the stream, which can be ENABLING, ENABLED, DISABLING or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBStream,
+			},
+			{
+				Name:        "stream_view_type",
+				Description: "Indicates the format of the records within the stream, which can be KEYS_ONLY, NEW_IMAGE, OLD_IMAGE or NEW_AND_OLD_IMAGES.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDynamoDBStream,
+			},
+			{
+				Name:        "creation_request_date_time",
+				Description: "The date and time when the request to create this stream was issued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getDynamoDBStream,
+			},
+			{
+				Name:        "shard_count",
+				Description: "The number of shards that comprise the stream.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDynamoDBStream,
+				Transform:   transform.From(getDynamoDBStreamShardCount),
+			},
+			{
+				Name:        "key_schema",
+				Description: "The key attribute(s) of the stream's DynamoDB table.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDynamoDBStream,
+			},
+			{
+				Name:        "shards",
+				Description: "The shards that comprise the stream.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDynamoDBStream,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StreamLabel"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("StreamArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDynamoDBStreams(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := DynamoDBStreamsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_stream.listDynamoDBStreams", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &dynamodbstreams.ListStreamsInput{
+		Limit: aws.Int32(100),
+	}
+
+	// Additonal Filter
+	if d.KeyColumnQuals["table_name"] != nil {
+		input.TableName = aws.String(d.KeyColumnQuals["table_name"].GetStringValue())
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.Limit {
+			if limit < 1 {
+				input.Limit = aws.Int32(1)
+			} else {
+				input.Limit = aws.Int32(limit)
+			}
+		}
+	}
+
+	// ListStreams has no paginator, so page through the results manually
+	for {
+		output, err := svc.ListStreams(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dynamodb_stream.listDynamoDBStreams", "api_error", err)
+			return nil, err
+		}
+
+		for _, stream := range output.Streams {
+			d.StreamListItem(ctx, stream)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.LastEvaluatedStreamArn == nil {
+			break
+		}
+		input.ExclusiveStartStreamArn = output.LastEvaluatedStreamArn
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDynamoDBStream(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var streamArn string
+	if h.Item != nil {
+		streamArn = *h.Item.(types.Stream).StreamArn
+	} else {
+		streamArn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if streamArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := DynamoDBStreamsClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_dynamodb_stream.getDynamoDBStream", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &dynamodbstreams.DescribeStreamInput{
+		StreamArn: aws.String(streamArn),
+		Limit:     aws.Int32(100),
+	}
+
+	// DescribeStream returns the shards a page at a time, so collect all of them
+	// to report the complete shard list and count
+	var description *types.StreamDescription
+	for {
+		op, err := svc.DescribeStream(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_dynamodb_stream.getDynamoDBStream", "api_error", err)
+			return nil, err
+		}
+		if op.StreamDescription == nil {
+			break
+		}
+
+		if description == nil {
+			description = op.StreamDescription
+		} else {
+			description.Shards = append(description.Shards, op.StreamDescription.Shards...)
+		}
+
+		if op.StreamDescription.LastEvaluatedShardId == nil {
+			break
+		}
+		params.ExclusiveStartShardId = op.StreamDescription.LastEvaluatedShardId
+	}
+
+	if description == nil {
+		return nil, nil
+	}
+	return *description, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getDynamoDBStreamShardCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	description := d.HydrateItem.(types.StreamDescription)
+	return len(description.Shards), nil
+}
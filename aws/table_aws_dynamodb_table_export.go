@@ -25,6 +25,9 @@ func tableAwsDynamoDBTableExport(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listDynamoDBTables,
 			Hydrate:       listTableExports,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "table_arn", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItemFunc: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -142,7 +145,13 @@ func tableAwsDynamoDBTableExport(_ context.Context) *plugin.Table {
 				Hydrate:     getTableExport,
 			},
 
-			// Steampipe standard column
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExportArn"),
+			},
 			{
 				Name:        "akas",
 				Description: resourceInterfaceDescription("akas"),
@@ -169,6 +178,11 @@ func listTableExports(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	commonColumnData := c.(*awsCommonColumnData)
 	tableArn := "arn:" + commonColumnData.Partition + ":dynamodb:" + region + ":" + commonColumnData.AccountId + ":table/" + *tableName
 
+	// Minimize API calls when a specific table has been requested
+	if d.KeyColumnQualString("table_arn") != "" && d.KeyColumnQualString("table_arn") != tableArn {
+		return nil, nil
+	}
+
 	// Create Session
 	svc, err := DynamoDBClient(ctx, d)
 	if err != nil {
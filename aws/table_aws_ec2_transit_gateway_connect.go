@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsEc2TransitGatewayConnect(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name: "aws_ec2_transit_gateway_connect",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_attachment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayAttachmentID.NotFound", "InvalidTransitGatewayAttachmentID.Unavailable", "InvalidTransitGatewayAttachmentID.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayConnect,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayConnects,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+				{Name: "transport_transit_gateway_attachment_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the Connect attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transport_transit_gateway_attachment_id",
+				Description: "The ID of the attachment from which the Connect attachment was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the Connect attachment.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "protocol",
+				Description: "The tunnel protocol of the Connect attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.Protocol"),
+			},
+			{
+				Name:        "connect_peers",
+				Description: "The Connect peers of the attachment, including the GRE tunnel addresses and BGP configurations of each peer.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listEc2TransitGatewayConnectPeers,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			/// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TransitGatewayTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2TransitGatewayConnectTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEc2TransitGatewayConnectAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayConnects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.listEc2TransitGatewayConnects", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2TransitGatewayConnectFilter(d.Quals)
+
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayConnectsPaginator(svc, input, func(o *ec2.DescribeTransitGatewayConnectsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.listEc2TransitGatewayConnects", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.TransitGatewayConnects {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayConnect(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	transitGatewayAttachmentID := d.KeyColumnQuals["transit_gateway_attachment_id"].GetStringValue()
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.getEc2TransitGatewayConnect", "connection_error", err)
+		return nil, err
+	}
+
+	// Build params
+	params := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: []string{transitGatewayAttachmentID},
+	}
+
+	op, err := svc.DescribeTransitGatewayConnects(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.getEc2TransitGatewayConnect", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayConnects) > 0 {
+		return op.TransitGatewayConnects[0], nil
+	}
+	return nil, nil
+}
+
+func listEc2TransitGatewayConnectPeers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	transitGatewayConnect := h.Item.(types.TransitGatewayConnect)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.listEc2TransitGatewayConnectPeers", "connection_error", err)
+		return nil, err
+	}
+
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		MaxResults: aws.Int32(1000),
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("transit-gateway-attachment-id"),
+				Values: []string{*transitGatewayConnect.TransitGatewayAttachmentId},
+			},
+		},
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayConnectPeersPaginator(svc, input, func(o *ec2.DescribeTransitGatewayConnectPeersPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	var peers []types.TransitGatewayConnectPeer
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_connect.listEc2TransitGatewayConnectPeers", "api_error", err)
+			return nil, err
+		}
+		peers = append(peers, output.TransitGatewayConnectPeers...)
+	}
+
+	return peers, nil
+}
+
+func getAwsEc2TransitGatewayConnectAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	transitGatewayConnect := h.Item.(types.TransitGatewayConnect)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get the resource akas
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":transit-gateway-attachment/" + *transitGatewayConnect.TransitGatewayAttachmentId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// ec2TransitGatewayTagsToTurbotTags converts a list of EC2 tags to a map. It is
+// used by the transit gateway tables and other EC2 tables that return []types.Tag
+func ec2TransitGatewayTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || tags == nil {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return &turbotTagsMap, nil
+}
+
+func getEc2TransitGatewayConnectTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.TransitGatewayConnect)
+	title := data.TransitGatewayAttachmentId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+// // UTILITY FUNCTION
+// Build ec2 transit gateway Connect list call input filter
+func buildEc2TransitGatewayConnectFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"state":              "state",
+		"transit_gateway_id": "transit-gateway-id",
+		"transport_transit_gateway_attachment_id": "transport-transit-gateway-attachment-id",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsEc2TransitGatewayPeeringAttachment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name: "aws_ec2_transit_gateway_peering_attachment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_attachment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayAttachmentID.NotFound", "InvalidTransitGatewayAttachmentID.Unavailable", "InvalidTransitGatewayAttachmentID.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayPeeringAttachment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayPeeringAttachments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_attachment_id",
+				Description: "The ID of the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "accepter_transit_gateway_attachment_id",
+				Description: "The ID of the accepter transit gateway attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the transit gateway peering attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The status code of the peering attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Code"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message of the peering attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status.Message"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the transit gateway peering attachment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "requester_transit_gateway_id",
+				Description: "The ID of the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.TransitGatewayId"),
+			},
+			{
+				Name:        "requester_owner_id",
+				Description: "The ID of the AWS account that owns the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.OwnerId"),
+			},
+			{
+				Name:        "requester_region",
+				Description: "The Region of the requester transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequesterTgwInfo.Region"),
+			},
+			{
+				Name:        "accepter_transit_gateway_id",
+				Description: "The ID of the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.TransitGatewayId"),
+			},
+			{
+				Name:        "accepter_owner_id",
+				Description: "The ID of the AWS account that owns the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.OwnerId"),
+			},
+			{
+				Name:        "accepter_region",
+				Description: "The Region of the accepter transit gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.Region"),
+			},
+			{
+				Name:        "accepter_core_network_id",
+				Description: "The ID of the Cloud WAN core network, if the accepter is a core network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccepterTgwInfo.CoreNetworkId"),
+			},
+			{
+				Name:        "dynamic_routing",
+				Description: "Whether dynamic routing is enabled or disabled for the peering attachment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options.DynamicRouting"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			/// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TransitGatewayTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2TransitGatewayPeeringAttachmentTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEc2TransitGatewayPeeringAttachmentAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayPeeringAttachments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.listEc2TransitGatewayPeeringAttachments", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2TransitGatewayPeeringAttachmentFilter(d.Quals)
+
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayPeeringAttachmentsPaginator(svc, input, func(o *ec2.DescribeTransitGatewayPeeringAttachmentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.listEc2TransitGatewayPeeringAttachments", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.TransitGatewayPeeringAttachments {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayPeeringAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	transitGatewayAttachmentID := d.KeyColumnQuals["transit_gateway_attachment_id"].GetStringValue()
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.getEc2TransitGatewayPeeringAttachment", "connection_error", err)
+		return nil, err
+	}
+
+	// Build params
+	params := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{transitGatewayAttachmentID},
+	}
+
+	op, err := svc.DescribeTransitGatewayPeeringAttachments(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_peering_attachment.getEc2TransitGatewayPeeringAttachment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayPeeringAttachments) > 0 {
+		return op.TransitGatewayPeeringAttachments[0], nil
+	}
+	return nil, nil
+}
+
+func getAwsEc2TransitGatewayPeeringAttachmentAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	peeringAttachment := h.Item.(types.TransitGatewayPeeringAttachment)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get the resource akas
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":transit-gateway-attachment/" + *peeringAttachment.TransitGatewayAttachmentId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2TransitGatewayPeeringAttachmentTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.TransitGatewayPeeringAttachment)
+	title := data.TransitGatewayAttachmentId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+// // UTILITY FUNCTION
+// Build ec2 transit gateway peering attachment list call input filter
+func buildEc2TransitGatewayPeeringAttachmentFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"state": "state",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
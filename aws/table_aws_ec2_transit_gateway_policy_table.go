@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsEc2TransitGatewayPolicyTable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name: "aws_ec2_transit_gateway_policy_table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("transit_gateway_policy_table_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidTransitGatewayPolicyTableId.NotFound", "InvalidTransitGatewayPolicyTableId.Malformed", "InvalidAction"}),
+			},
+			Hydrate: getEc2TransitGatewayPolicyTable,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEc2TransitGatewayPolicyTables,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "transit_gateway_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "transit_gateway_policy_table_id",
+				Description: "The ID of the transit gateway policy table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the transit gateway policy table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The timestamp when the transit gateway policy table was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "associations",
+				Description: "The attachments associated with the transit gateway policy table.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPolicyTableAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "entries",
+				Description: "The entries of the transit gateway policy table, including the policy rule and target route table of each entry.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEc2TransitGatewayPolicyTableEntries,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			/// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TransitGatewayTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getEc2TransitGatewayPolicyTableTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsEc2TransitGatewayPolicyTableAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEc2TransitGatewayPolicyTables(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.listEc2TransitGatewayPolicyTables", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 5 {
+				maxLimit = 5
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ec2.DescribeTransitGatewayPolicyTablesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filters := buildEc2TransitGatewayPolicyTableFilter(d.Quals)
+
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayPolicyTablesPaginator(svc, input, func(o *ec2.DescribeTransitGatewayPolicyTablesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.listEc2TransitGatewayPolicyTables", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.TransitGatewayPolicyTables {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEc2TransitGatewayPolicyTable(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	policyTableID := d.KeyColumnQuals["transit_gateway_policy_table_id"].GetStringValue()
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTable", "connection_error", err)
+		return nil, err
+	}
+
+	// Build params
+	params := &ec2.DescribeTransitGatewayPolicyTablesInput{
+		TransitGatewayPolicyTableIds: []string{policyTableID},
+	}
+
+	op, err := svc.DescribeTransitGatewayPolicyTables(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTable", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.TransitGatewayPolicyTables) > 0 {
+		return op.TransitGatewayPolicyTables[0], nil
+	}
+	return nil, nil
+}
+
+func getEc2TransitGatewayPolicyTableAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableAssociations", "connection_error", err)
+		return nil, err
+	}
+
+	input := &ec2.GetTransitGatewayPolicyTableAssociationsInput{
+		TransitGatewayPolicyTableId: policyTable.TransitGatewayPolicyTableId,
+		MaxResults:                  aws.Int32(1000),
+	}
+
+	paginator := ec2.NewGetTransitGatewayPolicyTableAssociationsPaginator(svc, input, func(o *ec2.GetTransitGatewayPolicyTableAssociationsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	var associations []types.TransitGatewayPolicyTableAssociation
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.Associations...)
+	}
+
+	return associations, nil
+}
+
+func getEc2TransitGatewayPolicyTableEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableEntries", "connection_error", err)
+		return nil, err
+	}
+
+	// GetTransitGatewayPolicyTableEntries does not support pagination
+	params := &ec2.GetTransitGatewayPolicyTableEntriesInput{
+		TransitGatewayPolicyTableId: policyTable.TransitGatewayPolicyTableId,
+	}
+
+	op, err := svc.GetTransitGatewayPolicyTableEntries(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ec2_transit_gateway_policy_table.getEc2TransitGatewayPolicyTableEntries", "api_error", err)
+		return nil, err
+	}
+
+	return op.TransitGatewayPolicyTableEntries, nil
+}
+
+func getAwsEc2TransitGatewayPolicyTableAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	policyTable := h.Item.(types.TransitGatewayPolicyTable)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Get the resource akas
+	akas := []string{"arn:" + commonColumnData.Partition + ":ec2:" + region + ":" + commonColumnData.AccountId + ":transit-gateway-policy-table/" + *policyTable.TransitGatewayPolicyTableId}
+
+	return akas, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getEc2TransitGatewayPolicyTableTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(types.TransitGatewayPolicyTable)
+	title := data.TransitGatewayPolicyTableId
+	if data.Tags != nil {
+		for _, i := range data.Tags {
+			if *i.Key == "Name" {
+				title = i.Value
+			}
+		}
+	}
+	return title, nil
+}
+
+// // UTILITY FUNCTION
+// Build ec2 transit gateway policy table list call input filter
+func buildEc2TransitGatewayPolicyTableFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"state":              "state",
+		"transit_gateway_id": "transit-gateway-id",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type efsReplicationConfigurationInfo struct {
+	SourceFileSystemId          *string
+	SourceFileSystemArn         *string
+	SourceFileSystemRegion      *string
+	OriginalSourceFileSystemArn *string
+	CreationTime                *time.Time
+	DestinationFileSystemId     *string
+	DestinationRegion           *string
+	Status                      types.ReplicationStatus
+	LastReplicatedTimestamp     *time.Time
+}
+
+//// TABLE DEFINITION
+
+func tableAwsEfsReplicationConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_efs_replication_configuration",
+		Description: "AWS EFS Replication Configuration",
+		List: &plugin.ListConfig{
+			Hydrate: listEfsReplicationConfigurations,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"FileSystemNotFound", "ReplicationNotFound"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source_file_system_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "source_file_system_id",
+				Description: "The ID of the source Amazon EFS file system that is being replicated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_file_system_arn",
+				Description: "The Amazon Resource Name (ARN) of the current source file system in the replication configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_file_system_region",
+				Description: "The Amazon Web Services Region in which the source Amazon EFS file system is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "original_source_file_system_arn",
+				Description: "The Amazon Resource Name (ARN) of the original source Amazon EFS file system in the replication configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the replication configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "destination_file_system_id",
+				Description: "The ID of the destination Amazon EFS file system.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_region",
+				Description: "The Amazon Web Services Region in which the destination file system is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Describes the status of the destination Amazon EFS file system, which can be ENABLED, ENABLING, DELETING, ERROR, PAUSED or PAUSING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_replicated_timestamp",
+				Description: "The time when the most recent sync was successfully completed on the destination file system. Any changes to data on the source file system that occurred before this time have been successfully replicated to the destination file system.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationFileSystemId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEfsReplicationConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := EFSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_efs_replication_configuration.listEfsReplicationConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxLimit := int32(100)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+	input := &efs.DescribeReplicationConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["source_file_system_id"] != nil {
+		input.FileSystemId = aws.String(equalQuals["source_file_system_id"].GetStringValue())
+	}
+
+	// DescribeReplicationConfigurations has no paginator in the SDK, so page through the results manually
+	for {
+		output, err := svc.DescribeReplicationConfigurations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_efs_replication_configuration.listEfsReplicationConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, replication := range output.Replications {
+			for _, destination := range replication.Destinations {
+				d.StreamListItem(ctx, efsReplicationConfigurationInfo{
+					SourceFileSystemId:          replication.SourceFileSystemId,
+					SourceFileSystemArn:         replication.SourceFileSystemArn,
+					SourceFileSystemRegion:      replication.SourceFileSystemRegion,
+					OriginalSourceFileSystemArn: replication.OriginalSourceFileSystemArn,
+					CreationTime:                replication.CreationTime,
+					DestinationFileSystemId:     destination.FileSystemId,
+					DestinationRegion:           destination.Region,
+					Status:                      destination.Status,
+					LastReplicatedTimestamp:     destination.LastReplicatedTimestamp,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
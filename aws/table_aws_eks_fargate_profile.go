@@ -119,7 +119,7 @@ func listEKSFargateProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.
 	if clusterName == nil {
 		return nil, nil
 	}
-	
+
 	// Create client
 	svc, err := EKSClient(ctx, d)
 	if err != nil {
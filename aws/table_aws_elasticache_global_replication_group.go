@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsElastiCacheGlobalReplicationGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_elasticache_global_replication_group",
+		Description: "AWS ElastiCache Global Replication Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("global_replication_group_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"GlobalReplicationGroupNotFoundFault", "InvalidParameterValue"}),
+			},
+			Hydrate: getElastiCacheGlobalReplicationGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listElastiCacheGlobalReplicationGroups,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "global_replication_group_id",
+				Description: "The name of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the global replication group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "description",
+				Description: "The optional description of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalReplicationGroupDescription"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The Elasticache engine. For Redis only.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The Elasticache Redis engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cache_node_type",
+				Description: "The cache node type of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_enabled",
+				Description: "A flag that indicates whether the Global datastore is cluster enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "at_rest_encryption_enabled",
+				Description: "A flag that enables encryption at rest when set to true.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "transit_encryption_enabled",
+				Description: "A flag that enables in-transit encryption when set to true.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "auth_token_enabled",
+				Description: "A flag that enables using an AuthToken (password) when issuing Redis commands.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "primary_replication_group_id",
+				Description: "The identifier of the primary member replication group of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(getElastiCacheGlobalReplicationGroupPrimaryMember, "ReplicationGroupId"),
+			},
+			{
+				Name:        "primary_replication_group_region",
+				Description: "The Amazon region of the primary member replication group of the Global datastore.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(getElastiCacheGlobalReplicationGroupPrimaryMember, "ReplicationGroupRegion"),
+			},
+			{
+				Name:        "members",
+				Description: "The replication groups that comprise the Global datastore, with the role, status and automatic failover state of each member.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "global_node_groups",
+				Description: "Indicates the slot configuration and global identifier for each slice group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalReplicationGroupId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listElastiCacheGlobalReplicationGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// Create Session
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_global_replication_group.listElastiCacheGlobalReplicationGroups", "connection_error", err)
+		return nil, err
+	}
+
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		MaxRecords:     aws.Int32(100),
+		ShowMemberInfo: aws.Bool(true),
+	}
+
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := elasticache.NewDescribeGlobalReplicationGroupsPaginator(svc, input, func(o *elasticache.DescribeGlobalReplicationGroupsPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_elasticache_global_replication_group.listElastiCacheGlobalReplicationGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, globalReplicationGroup := range output.GlobalReplicationGroups {
+			// The Global datastore is visible from the regions of all of its members,
+			// so only report it from the region of its primary member
+			if !isElastiCacheGlobalReplicationGroupHomeRegion(globalReplicationGroup, region) {
+				continue
+			}
+
+			d.StreamListItem(ctx, globalReplicationGroup)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getElastiCacheGlobalReplicationGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	id := d.KeyColumnQuals["global_replication_group_id"].GetStringValue()
+
+	// Empty check
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := ElastiCacheClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_global_replication_group.getElastiCacheGlobalReplicationGroup", "connection_error", err)
+		return nil, err
+	}
+
+	params := &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: aws.String(id),
+		ShowMemberInfo:           aws.Bool(true),
+	}
+
+	op, err := svc.DescribeGlobalReplicationGroups(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_elasticache_global_replication_group.getElastiCacheGlobalReplicationGroup", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.GlobalReplicationGroups) > 0 && isElastiCacheGlobalReplicationGroupHomeRegion(op.GlobalReplicationGroups[0], region) {
+		return op.GlobalReplicationGroups[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getElastiCacheGlobalReplicationGroupPrimaryMember(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	globalReplicationGroup := d.HydrateItem.(types.GlobalReplicationGroup)
+
+	for _, member := range globalReplicationGroup.Members {
+		if aws.ToString(member.Role) == "primary" {
+			switch d.Param.(string) {
+			case "ReplicationGroupId":
+				return member.ReplicationGroupId, nil
+			case "ReplicationGroupRegion":
+				return member.ReplicationGroupRegion, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// A Global datastore belongs to the region of its primary member. Global datastores
+// without a primary member are reported in every region they are visible from.
+func isElastiCacheGlobalReplicationGroupHomeRegion(globalReplicationGroup types.GlobalReplicationGroup, region string) bool {
+	for _, member := range globalReplicationGroup.Members {
+		if aws.ToString(member.Role) == "primary" {
+			return aws.ToString(member.ReplicationGroupRegion) == region
+		}
+	}
+	return true
+}
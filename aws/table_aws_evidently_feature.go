@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evidently"
+	"github.com/aws/aws-sdk-go-v2/service/evidently/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEvidentlyFeature(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_evidently_feature",
+		Description: "AWS CloudWatch Evidently Feature",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"project_arn", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getEvidentlyFeature,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEvidentlyProjects,
+			Hydrate:       listEvidentlyFeatures,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the feature.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the feature.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_arn",
+				Description: "The ARN of the project that contains the feature.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project"),
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the feature, AVAILABLE or UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "evaluation_strategy",
+				Description: "If this value is ALL_RULES, the traffic allocation specified by any ongoing launches or experiments is being used. If this is DEFAULT_VARIATION, the default variation is being served to all users.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_variation",
+				Description: "The name of the variation that is used as the default variation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the feature was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The date and time that the feature was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the feature.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeEvidentlyFeature,
+			},
+			{
+				Name:        "value_type",
+				Description: "The data type used for the feature variation values.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeEvidentlyFeature,
+			},
+			{
+				Name:        "evaluation_rules",
+				Description: "The launches or experiments that are currently using the feature.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "entity_overrides",
+				Description: "A set of key-value pairs that specify users who should always be served a specific variation of the feature.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeEvidentlyFeature,
+			},
+			{
+				Name:        "variations",
+				Description: "The variations of the feature and their values.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeEvidentlyFeature,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEvidentlyFeatures(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(types.ProjectSummary)
+
+	// Minimize API calls when a specific project has been requested
+	if d.KeyColumnQuals["project_arn"] != nil && d.KeyColumnQuals["project_arn"].GetStringValue() != *project.Arn {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_feature.listEvidentlyFeatures", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &evidently.ListFeaturesInput{
+		Project: project.Arn,
+	}
+
+	paginator := evidently.NewListFeaturesPaginator(svc, input, func(o *evidently.ListFeaturesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_evidently_feature.listEvidentlyFeatures", "api_error", err)
+			return nil, err
+		}
+
+		for _, feature := range output.Features {
+			d.StreamLeafListItem(ctx, feature)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEvidentlyFeature(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	projectArn := d.KeyColumnQuals["project_arn"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if project_arn or name is empty
+	if projectArn == "" || name == "" {
+		return nil, nil
+	}
+
+	feature, err := getEvidentlyFeatureDetails(ctx, d, projectArn, name)
+	if err != nil || feature == nil {
+		return nil, err
+	}
+
+	return types.FeatureSummary{
+		Arn:                feature.Arn,
+		CreatedTime:        feature.CreatedTime,
+		EvaluationStrategy: feature.EvaluationStrategy,
+		LastUpdatedTime:    feature.LastUpdatedTime,
+		Name:               feature.Name,
+		Status:             feature.Status,
+		DefaultVariation:   feature.DefaultVariation,
+		EvaluationRules:    feature.EvaluationRules,
+		Project:            feature.Project,
+		Tags:               feature.Tags,
+	}, nil
+}
+
+// describeEvidentlyFeature returns the variations and overrides of the
+// feature, which are not included in the list response
+func describeEvidentlyFeature(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	feature := h.Item.(types.FeatureSummary)
+
+	op, err := getEvidentlyFeatureDetails(ctx, d, *feature.Project, *feature.Name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getEvidentlyFeatureDetails(ctx context.Context, d *plugin.QueryData, project string, name string) (*types.Feature, error) {
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_feature.getEvidentlyFeatureDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &evidently.GetFeatureInput{
+		Project: aws.String(project),
+		Feature: aws.String(name),
+	}
+
+	op, err := svc.GetFeature(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_feature.getEvidentlyFeatureDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Feature, nil
+}
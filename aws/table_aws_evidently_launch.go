@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evidently"
+	"github.com/aws/aws-sdk-go-v2/service/evidently/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEvidentlyLaunch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_evidently_launch",
+		Description: "AWS CloudWatch Evidently Launch",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"project_arn", "name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getEvidentlyLaunch,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEvidentlyProjects,
+			Hydrate:       listEvidentlyLaunches,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_arn", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the launch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the launch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_arn",
+				Description: "The ARN of the project that contains the launch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project"),
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the launch, e.g. CREATED, UPDATING, RUNNING, COMPLETED or CANCELLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "If the launch was stopped, this is the string that was entered by the person who stopped the launch, to explain why it was stopped.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of launch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the launch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the launch was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The date and time that the launch was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "started_time",
+				Description: "The date and time that the launch started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Execution.StartedTime"),
+			},
+			{
+				Name:        "ended_time",
+				Description: "The date and time that the launch ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Execution.EndedTime"),
+			},
+			{
+				Name:        "groups",
+				Description: "The launch groups, each of which serves a feature variation to part of the audience.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metric_monitors",
+				Description: "The metrics that are being used to monitor the launch performance.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scheduled_splits_definition",
+				Description: "The traffic allocation steps of the launch, and the percentage of traffic served to each launch group at each step.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEvidentlyLaunches(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(types.ProjectSummary)
+
+	// Minimize API calls when a specific project has been requested
+	if d.KeyColumnQuals["project_arn"] != nil && d.KeyColumnQuals["project_arn"].GetStringValue() != *project.Arn {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_launch.listEvidentlyLaunches", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &evidently.ListLaunchesInput{
+		Project: project.Arn,
+	}
+	if d.KeyColumnQuals["status"] != nil {
+		input.Status = types.LaunchStatus(d.KeyColumnQuals["status"].GetStringValue())
+	}
+
+	paginator := evidently.NewListLaunchesPaginator(svc, input, func(o *evidently.ListLaunchesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_evidently_launch.listEvidentlyLaunches", "api_error", err)
+			return nil, err
+		}
+
+		for _, launch := range output.Launches {
+			d.StreamLeafListItem(ctx, launch)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEvidentlyLaunch(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	projectArn := d.KeyColumnQuals["project_arn"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if project_arn or name is empty
+	if projectArn == "" || name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_launch.getEvidentlyLaunch", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &evidently.GetLaunchInput{
+		Project: aws.String(projectArn),
+		Launch:  aws.String(name),
+	}
+
+	op, err := svc.GetLaunch(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_launch.getEvidentlyLaunch", "api_error", err)
+		return nil, err
+	}
+
+	if op.Launch == nil {
+		return nil, nil
+	}
+
+	return *op.Launch, nil
+}
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/evidently"
+	"github.com/aws/aws-sdk-go-v2/service/evidently/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsEvidentlyProject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_evidently_project",
+		Description: "AWS CloudWatch Evidently Project",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getEvidentlyProject,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEvidentlyProjects,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the project, AVAILABLE or UPDATING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The user-entered description of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the project was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The date and time that the project was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "feature_count",
+				Description: "The number of features currently in the project.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "launch_count",
+				Description: "The number of launches currently in the project, including all launches that were created, not just those that are ongoing.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "active_launch_count",
+				Description: "The number of ongoing launches currently in the project.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "experiment_count",
+				Description: "The number of experiments currently in the project.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "active_experiment_count",
+				Description: "The number of ongoing experiments currently in the project.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "app_config_resource",
+				Description: "The AppConfig application and environment used for client-side evaluation of the project's features.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeEvidentlyProject,
+			},
+			{
+				Name:        "data_delivery",
+				Description: "The CloudWatch Logs log group or S3 bucket where the project stores evaluation events.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeEvidentlyProject,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEvidentlyProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_project.listEvidentlyProjects", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &evidently.ListProjectsInput{}
+
+	paginator := evidently.NewListProjectsPaginator(svc, input, func(o *evidently.ListProjectsPaginatorOptions) {
+		o.Limit = 50
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_evidently_project.listEvidentlyProjects", "api_error", err)
+			return nil, err
+		}
+
+		for _, project := range output.Projects {
+			d.StreamListItem(ctx, project)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEvidentlyProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	project, err := getEvidentlyProjectDetails(ctx, d, name)
+	if err != nil || project == nil {
+		return nil, err
+	}
+
+	return types.ProjectSummary{
+		Arn:                   project.Arn,
+		CreatedTime:           project.CreatedTime,
+		LastUpdatedTime:       project.LastUpdatedTime,
+		Name:                  project.Name,
+		Status:                project.Status,
+		ActiveExperimentCount: project.ActiveExperimentCount,
+		ActiveLaunchCount:     project.ActiveLaunchCount,
+		Description:           project.Description,
+		ExperimentCount:       project.ExperimentCount,
+		FeatureCount:          project.FeatureCount,
+		LaunchCount:           project.LaunchCount,
+		Tags:                  project.Tags,
+	}, nil
+}
+
+// describeEvidentlyProject returns the data delivery and AppConfig settings of
+// the project, which are not included in the list response
+func describeEvidentlyProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.ProjectSummary).Name
+
+	project, err := getEvidentlyProjectDetails(ctx, d, *name)
+	if err != nil || project == nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func getEvidentlyProjectDetails(ctx context.Context, d *plugin.QueryData, name string) (*types.Project, error) {
+	// Create Session
+	svc, err := EvidentlyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_project.getEvidentlyProjectDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &evidently.GetProjectInput{
+		Project: aws.String(name),
+	}
+
+	op, err := svc.GetProject(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_evidently_project.getEvidentlyProjectDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Project, nil
+}
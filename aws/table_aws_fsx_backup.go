@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsFsxBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_fsx_backup",
+		Description: "AWS FSx Backup",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("backup_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"BackupNotFound", "ValidationException"}),
+			},
+			Hydrate: getFsxBackup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFsxBackups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "file_system_id", Require: plugin.Optional},
+				{Name: "file_system_type", Require: plugin.Optional},
+				{Name: "volume_id", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "backup_id",
+				Description: "The ID of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the backup resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the file-system backup, which can be AUTOMATIC, USER_INITIATED, or AWS_BACKUP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The current state of the backup, following are the possible values AVAILABLE, CREATING, TRANSFERRING, DELETED, FAILED, PENDING, COPYING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when a particular backup was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "resource_type",
+				Description: "Specifies the resource type that's backed up, which can be FILE_SYSTEM or VOLUME.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_system_id",
+				Description: "The ID of the file system that was backed up.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileSystem.FileSystemId"),
+			},
+			{
+				Name:        "file_system_type",
+				Description: "The type of the file system that was backed up.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileSystem.FileSystemType"),
+			},
+			{
+				Name:        "volume_id",
+				Description: "The ID of the volume that was backed up, if the backup is of a volume.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Volume.VolumeId"),
+			},
+			{
+				Name:        "progress_percent",
+				Description: "The current percent of progress of an asynchronous task.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the Key Management Service (KMS) key used to encrypt the backup of the file system's data at rest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account that owns the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_backup_id",
+				Description: "The ID of the source backup, if the backup was copied from another backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_backup_region",
+				Description: "The source region of the backup, if the backup was copied from another region.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "directory_information",
+				Description: "The configuration of the self-managed Microsoft Active Directory to which the Windows File Server instance is joined.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "failure_details",
+				Description: "Details explaining any failures that occurred when creating the backup.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "file_system",
+				Description: "The metadata of the file system associated with the backup, as it was at the time the backup was taken.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "volume",
+				Description: "The metadata of the volume associated with the backup, if the backup is of a volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the backup.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getFsxBackupTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getFsxBackupTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFsxBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.listFsxBackups", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// https://docs.aws.amazon.com/fsx/latest/APIReference/API_DescribeBackups.html
+	maxItems := int32(1000)
+	input := fsx.DescribeBackupsInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	filters := buildFsxBackupFilter(d.KeyColumnQuals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := fsx.NewDescribeBackupsPaginator(svc, &input, func(o *fsx.DescribeBackupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_fsx_backup.listFsxBackups", "api_error", err)
+			return nil, err
+		}
+
+		for _, backup := range output.Backups {
+			d.StreamListItem(ctx, backup)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFsxBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	backupID := d.KeyColumnQuals["backup_id"].GetStringValue()
+
+	// Empty param check
+	if backupID == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.getFsxBackup", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &fsx.DescribeBackupsInput{
+		BackupIds: []string{backupID},
+	}
+
+	op, err := svc.DescribeBackups(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_backup.getFsxBackup", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Backups) > 0 {
+		return op.Backups[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getFsxBackupTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	backup := d.HydrateItem.(types.Backup)
+	return fsxTagsToTurbotTags(backup.Tags), nil
+}
+
+func getFsxBackupTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	backup := d.HydrateItem.(types.Backup)
+
+	for _, i := range backup.Tags {
+		if *i.Key == "Name" && len(*i.Value) > 0 {
+			return *i.Value, nil
+		}
+	}
+	return backup.BackupId, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build FSx backup list call input filter
+func buildFsxBackupFilter(equalQuals plugin.KeyColumnEqualsQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]types.FilterName{
+		"file_system_id":   types.FilterNameFileSystemId,
+		"file_system_type": types.FilterNameFileSystemType,
+		"volume_id":        types.FilterNameVolumeId,
+		"type":             types.FilterNameBackupType,
+	}
+
+	for columnName, filterName := range filterQuals {
+		if equalQuals[columnName] != nil {
+			value := equalQuals[columnName].GetStringValue()
+			if value != "" {
+				filters = append(filters, types.Filter{
+					Name:   filterName,
+					Values: []string{value},
+				})
+			}
+		}
+	}
+	return filters
+}
@@ -43,7 +43,7 @@ func tableAwsFsxFileSystem(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "file_system_type",
-				Description: "The type of Amazon FSx file system, which can be LUSTRE, WINDOWS, or ONTAP.",
+				Description: "The type of Amazon FSx file system, which can be LUSTRE, WINDOWS, ONTAP, or OPENZFS.",
 				Type:        proto.ColumnType_STRING,
 			},
 			{
@@ -117,6 +117,12 @@ func tableAwsFsxFileSystem(_ context.Context) *plugin.Table {
 				Description: "The configuration for this FSx for NetApp ONTAP file system.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "open_zfs_configuration",
+				Description: "The configuration for this Amazon FSx for OpenZFS file system.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenZFSConfiguration"),
+			},
 			{
 				Name:        "subnet_ids",
 				Description: "Specifies the IDs of the subnets that the file system is accessible from.",
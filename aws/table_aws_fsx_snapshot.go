@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsFsxSnapshot(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_fsx_snapshot",
+		Description: "AWS FSx Snapshot",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("snapshot_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"SnapshotNotFound", "ValidationException"}),
+			},
+			Hydrate: getFsxSnapshot,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFsxSnapshots,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "volume_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_id",
+				Description: "The ID of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN"),
+			},
+			{
+				Name:        "volume_id",
+				Description: "The ID of the volume that the snapshot is of.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The lifecycle status of the snapshot, following are the possible values PENDING, CREATING, DELETING, AVAILABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the snapshot was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "lifecycle_transition_reason",
+				Description: "The reason why the snapshot lifecycle status changed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "administrative_actions",
+				Description: "A list of administrative actions for the snapshot that are in process or waiting to be processed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the snapshot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getFsxSnapshotTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getFsxSnapshotTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFsxSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_snapshot.listFsxSnapshots", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// https://docs.aws.amazon.com/fsx/latest/APIReference/API_DescribeSnapshots.html
+	maxItems := int32(1000)
+	input := fsx.DescribeSnapshotsInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	if d.KeyColumnQuals["volume_id"] != nil {
+		input.Filters = []types.SnapshotFilter{
+			{
+				Name:   types.SnapshotFilterNameVolumeId,
+				Values: []string{d.KeyColumnQuals["volume_id"].GetStringValue()},
+			},
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := fsx.NewDescribeSnapshotsPaginator(svc, &input, func(o *fsx.DescribeSnapshotsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_fsx_snapshot.listFsxSnapshots", "api_error", err)
+			return nil, err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			d.StreamListItem(ctx, snapshot)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFsxSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	snapshotID := d.KeyColumnQuals["snapshot_id"].GetStringValue()
+
+	// Empty param check
+	if snapshotID == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_snapshot.getFsxSnapshot", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &fsx.DescribeSnapshotsInput{
+		SnapshotIds: []string{snapshotID},
+	}
+
+	op, err := svc.DescribeSnapshots(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_snapshot.getFsxSnapshot", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Snapshots) > 0 {
+		return op.Snapshots[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getFsxSnapshotTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	snapshot := d.HydrateItem.(types.Snapshot)
+	return fsxTagsToTurbotTags(snapshot.Tags), nil
+}
+
+func getFsxSnapshotTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	snapshot := d.HydrateItem.(types.Snapshot)
+
+	if snapshot.Name != nil {
+		return *snapshot.Name, nil
+	}
+	return snapshot.SnapshotId, nil
+}
@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsFsxVolume(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_fsx_volume",
+		Description: "AWS FSx Volume",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("volume_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"VolumeNotFound", "ValidationException"}),
+			},
+			Hydrate: getFsxVolume,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFsxVolumes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "file_system_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_id",
+				Description: "The system-generated, unique ID of the volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the volume.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceARN"),
+			},
+			{
+				Name:        "file_system_id",
+				Description: "The ID of the file system that the volume belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_type",
+				Description: "The type of the volume, which can be ONTAP or OPENZFS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The lifecycle status of the volume, following are the possible values AVAILABLE, CREATED, CREATING, DELETING, FAILED, MISCONFIGURED, PENDING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the volume was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "lifecycle_transition_reason",
+				Description: "The reason why the volume lifecycle status changed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "administrative_actions",
+				Description: "A list of administrative actions for the volume that are in process or waiting to be processed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ontap_configuration",
+				Description: "The configuration of an Amazon FSx for NetApp ONTAP volume.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "open_zfs_configuration",
+				Description: "The configuration of an Amazon FSx for OpenZFS volume.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenZFSConfiguration"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the volume.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getFsxVolumeTurbotTitle),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getFsxVolumeTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFsxVolumes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_volume.listFsxVolumes", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// https://docs.aws.amazon.com/fsx/latest/APIReference/API_DescribeVolumes.html
+	maxItems := int32(1000)
+	input := fsx.DescribeVolumesInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	if d.KeyColumnQuals["file_system_id"] != nil {
+		input.Filters = []types.VolumeFilter{
+			{
+				Name:   types.VolumeFilterNameFileSystemId,
+				Values: []string{d.KeyColumnQuals["file_system_id"].GetStringValue()},
+			},
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := fsx.NewDescribeVolumesPaginator(svc, &input, func(o *fsx.DescribeVolumesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_fsx_volume.listFsxVolumes", "api_error", err)
+			return nil, err
+		}
+
+		for _, volume := range output.Volumes {
+			d.StreamListItem(ctx, volume)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFsxVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	volumeID := d.KeyColumnQuals["volume_id"].GetStringValue()
+
+	// Empty param check
+	if volumeID == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := FSxClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_volume.getFsxVolume", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &fsx.DescribeVolumesInput{
+		VolumeIds: []string{volumeID},
+	}
+
+	op, err := svc.DescribeVolumes(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_fsx_volume.getFsxVolume", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Volumes) > 0 {
+		return op.Volumes[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getFsxVolumeTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	volume := d.HydrateItem.(types.Volume)
+	return fsxTagsToTurbotTags(volume.Tags), nil
+}
+
+func getFsxVolumeTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	volume := d.HydrateItem.(types.Volume)
+
+	if volume.Name != nil {
+		return *volume.Name, nil
+	}
+	return volume.VolumeId, nil
+}
+
+// fsxTagsToTurbotTags converts a list of FSx tags into a map of key/value pairs
+func fsxTagsToTurbotTags(tags []types.Tag) map[string]string {
+	if tags == nil {
+		return nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+	return turbotTagsMap
+}
@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorCustomRoutingAccelerator(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_custom_routing_accelerator",
+		Description: "AWS Global Accelerator Custom Routing Accelerator",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AcceleratorNotFoundException"}),
+			},
+			Hydrate: getGlobalAcceleratorCustomRoutingAccelerator,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlobalAcceleratorCustomRoutingAccelerators,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the custom routing accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the custom routing accelerator.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AcceleratorArn"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time that the accelerator was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "dns_name",
+				Description: "The Domain Name System (DNS) name that Global Accelerator creates that points to your accelerator's static IP addresses.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the accelerator is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ip_address_type",
+				Description: "The value for the address type must be IPv4.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ip_sets",
+				Description: "The static IP addresses that Global Accelerator associates with the accelerator.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time that the accelerator was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "status",
+				Description: "Describes the deployment status of the accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the accelerator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlobalAcceleratorCustomRoutingAcceleratorTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "accelerator_attributes",
+				Description: "Attributes of the accelerator.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlobalAcceleratorCustomRoutingAcceleratorAttributes,
+				Transform:   transform.FromField("AcceleratorAttributes"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlobalAcceleratorCustomRoutingAcceleratorTags,
+				Transform:   transform.FromField("Tags").Transform(globalacceleratorAcceleratorTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AcceleratorArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorCustomRoutingAccelerators(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.listGlobalAcceleratorCustomRoutingAccelerators", "service_creation_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+	input := &globalaccelerator.ListCustomRoutingAcceleratorsInput{
+		MaxResults: &maxItems,
+	}
+
+	paginator := globalaccelerator.NewListCustomRoutingAcceleratorsPaginator(svc, input, func(o *globalaccelerator.ListCustomRoutingAcceleratorsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.listGlobalAcceleratorCustomRoutingAccelerators", "api_error", err)
+			return nil, err
+		}
+
+		for _, accelerator := range output.Accelerators {
+			d.StreamListItem(ctx, accelerator)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlobalAcceleratorCustomRoutingAccelerator(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.getGlobalAcceleratorCustomRoutingAccelerator", "service_creation_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &globalaccelerator.DescribeCustomRoutingAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+	}
+
+	// Get call
+	data, err := svc.DescribeCustomRoutingAccelerator(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.getGlobalAcceleratorCustomRoutingAccelerator", "api_error", err)
+		return nil, err
+	}
+	return *data.Accelerator, nil
+}
+
+func getGlobalAcceleratorCustomRoutingAcceleratorTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accelerator := h.Item.(types.CustomRoutingAccelerator)
+
+	// Create Session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: accelerator.AcceleratorArn,
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.getGlobalAcceleratorCustomRoutingAcceleratorTags", "api_error", err)
+		return nil, err
+	}
+	return op, nil
+}
+
+func getGlobalAcceleratorCustomRoutingAcceleratorAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accelerator := h.Item.(types.CustomRoutingAccelerator)
+
+	// Create Session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &globalaccelerator.DescribeCustomRoutingAcceleratorAttributesInput{
+		AcceleratorArn: accelerator.AcceleratorArn,
+	}
+
+	// Get call
+	op, err := svc.DescribeCustomRoutingAcceleratorAttributes(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_accelerator.getGlobalAcceleratorCustomRoutingAcceleratorAttributes", "api_error", err)
+		return nil, err
+	}
+	return op, nil
+}
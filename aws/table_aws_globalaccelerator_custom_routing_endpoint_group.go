@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorCustomRoutingEndpointGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_custom_routing_endpoint_group",
+		Description: "AWS Global Accelerator Custom Routing Endpoint Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EndpointGroupNotFoundException"}),
+			},
+			Hydrate: getGlobalAcceleratorCustomRoutingEndpointGroup,
+		},
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "accelerator_arn", Require: plugin.Optional},
+			},
+			ParentHydrate: listGlobalAcceleratorCustomRoutingAccelerators,
+			Hydrate:       listGlobalAcceleratorCustomRoutingEndpointGroups,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointGroup.EndpointGroupArn"),
+			},
+			{
+				Name:        "listener_arn",
+				Description: "The Amazon Resource Name (ARN) of parent listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "accelerator_arn",
+				Description: "The Amazon Resource Name (ARN) of parent custom routing accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_group_region",
+				Description: "The AWS Region where the endpoint group is located.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointGroup.EndpointGroupRegion"),
+			},
+			{
+				Name:        "destination_descriptions",
+				Description: "The port ranges and protocols for the destinations in the endpoint group's VPC subnets.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointGroup.DestinationDescriptions"),
+			},
+			{
+				Name:        "endpoint_descriptions",
+				Description: "The VPC subnet endpoints in the endpoint group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointGroup.EndpointDescriptions"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointGroup.EndpointGroupArn").Transform(arnToTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EndpointGroup.EndpointGroupArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+type turbotCustomRoutingEndpointGroup struct {
+	AcceleratorArn string
+	ListenerArn    string
+	EndpointGroup  types.CustomRoutingEndpointGroup
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorCustomRoutingEndpointGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accelerator := h.Item.(types.CustomRoutingAccelerator)
+	acceleratorArn := aws.ToString(accelerator.AcceleratorArn)
+
+	// Minimize API calls when a specific accelerator has been requested
+	if d.KeyColumnQuals["accelerator_arn"] != nil && d.KeyColumnQuals["accelerator_arn"].GetStringValue() != acceleratorArn {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_endpoint_group.listGlobalAcceleratorCustomRoutingEndpointGroups", "connection_error", err)
+		return nil, err
+	}
+
+	// First get accelerator listener ARNs
+	listenerArns := []*string{}
+
+	input := &globalaccelerator.ListCustomRoutingListenersInput{
+		MaxResults:     aws.Int32(100),
+		AcceleratorArn: accelerator.AcceleratorArn,
+	}
+
+	paginator := globalaccelerator.NewListCustomRoutingListenersPaginator(svc, input, func(o *globalaccelerator.ListCustomRoutingListenersPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	// List listeners
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_endpoint_group.listGlobalAcceleratorCustomRoutingEndpointGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, listener := range output.Listeners {
+			listenerArns = append(listenerArns, listener.ListenerArn)
+		}
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	// Now get endpoint groups for each listener
+	for _, listenerArn := range listenerArns {
+		endpointGroupsInput := &globalaccelerator.ListCustomRoutingEndpointGroupsInput{
+			MaxResults:  aws.Int32(maxItems),
+			ListenerArn: listenerArn,
+		}
+
+		paginatorGroups := globalaccelerator.NewListCustomRoutingEndpointGroupsPaginator(svc, endpointGroupsInput, func(o *globalaccelerator.ListCustomRoutingEndpointGroupsPaginatorOptions) {
+			o.Limit = maxItems
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginatorGroups.HasMorePages() {
+			outputGroups, err := paginatorGroups.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_endpoint_group.listGlobalAcceleratorCustomRoutingEndpointGroups", "api_error", err)
+				return nil, err
+			}
+
+			for _, endpointGroup := range outputGroups.EndpointGroups {
+				d.StreamListItem(ctx, &turbotCustomRoutingEndpointGroup{acceleratorArn, *listenerArn, endpointGroup})
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlobalAcceleratorCustomRoutingEndpointGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_endpoint_group.getGlobalAcceleratorCustomRoutingEndpointGroup", "connection_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &globalaccelerator.DescribeCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(arn),
+	}
+
+	// Get call
+	data, err := svc.DescribeCustomRoutingEndpointGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_custom_routing_endpoint_group.getGlobalAcceleratorCustomRoutingEndpointGroup", "api_error", err)
+		return nil, err
+	}
+
+	// The endpoint group ARN is nested under the listener ARN, which is nested
+	// under the accelerator ARN, e.g.
+	// arn:aws:globalaccelerator::123456789012:accelerator/abc/listener/def/endpoint-group/ghi
+	listenerArn := strings.Split(arn, "/endpoint-group/")[0]
+	acceleratorArn := strings.Split(listenerArn, "/listener/")[0]
+
+	return &turbotCustomRoutingEndpointGroup{acceleratorArn, listenerArn, *data.EndpointGroup}, nil
+}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlobalAcceleratorEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_globalaccelerator_endpoint",
+		Description: "AWS Global Accelerator Endpoint",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "accelerator_arn", Require: plugin.Optional},
+				{Name: "listener_arn", Require: plugin.Optional},
+				{Name: "endpoint_group_arn", Require: plugin.Optional},
+			},
+			ParentHydrate: listGlobalAcceleratorAccelerators,
+			Hydrate:       listGlobalAcceleratorEndpoints,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "endpoint_id",
+				Description: "An ID for the endpoint. For a Network Load Balancer or Application Load Balancer, this is the ARN of the resource; for an Elastic IP address, it is the allocation ID; for an EC2 instance, it is the instance ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the parent endpoint group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_group_region",
+				Description: "The AWS Region where the parent endpoint group is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "listener_arn",
+				Description: "The Amazon Resource Name (ARN) of the parent listener.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "accelerator_arn",
+				Description: "The Amazon Resource Name (ARN) of the parent accelerator.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_state",
+				Description: "The health status of the endpoint, one of INITIAL, HEALTHY or UNHEALTHY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "health_reason",
+				Description: "Returns a null result if the endpoint is healthy. Otherwise, the reason that the health check failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "weight",
+				Description: "The weight associated with the endpoint, which determines the proportion of traffic in the endpoint group that is routed to it.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "client_ip_preservation_enabled",
+				Description: "Indicates whether client IP address preservation is enabled for the endpoint.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EndpointId"),
+			},
+		}),
+	}
+}
+
+type globalAcceleratorEndpoint struct {
+	AcceleratorArn      *string
+	ListenerArn         *string
+	EndpointGroupArn    *string
+	EndpointGroupRegion *string
+	types.EndpointDescription
+}
+
+//// LIST FUNCTION
+
+func listGlobalAcceleratorEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accelerator := h.Item.(types.Accelerator)
+
+	// Minimize API calls when a specific accelerator has been requested
+	if d.KeyColumnQuals["accelerator_arn"] != nil && d.KeyColumnQuals["accelerator_arn"].GetStringValue() != aws.ToString(accelerator.AcceleratorArn) {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := GlobalAcceleratorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_globalaccelerator_endpoint.listGlobalAcceleratorEndpoints", "connection_error", err)
+		return nil, err
+	}
+
+	// First get accelerator listener ARNs
+	listenerArns := []*string{}
+
+	input := &globalaccelerator.ListListenersInput{
+		MaxResults:     aws.Int32(100),
+		AcceleratorArn: accelerator.AcceleratorArn,
+	}
+
+	paginator := globalaccelerator.NewListListenersPaginator(svc, input, func(o *globalaccelerator.ListListenersPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	// List listeners
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_globalaccelerator_endpoint.listGlobalAcceleratorEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, listener := range output.Listeners {
+			if d.KeyColumnQuals["listener_arn"] != nil && d.KeyColumnQuals["listener_arn"].GetStringValue() != aws.ToString(listener.ListenerArn) {
+				continue
+			}
+			listenerArns = append(listenerArns, listener.ListenerArn)
+		}
+	}
+
+	// Now get the endpoints of each endpoint group for each listener
+	for _, listenerArn := range listenerArns {
+		endpointGroupsInput := &globalaccelerator.ListEndpointGroupsInput{
+			MaxResults:  aws.Int32(100),
+			ListenerArn: listenerArn,
+		}
+
+		paginatorGroups := globalaccelerator.NewListEndpointGroupsPaginator(svc, endpointGroupsInput, func(o *globalaccelerator.ListEndpointGroupsPaginatorOptions) {
+			o.Limit = 100
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginatorGroups.HasMorePages() {
+			outputGroups, err := paginatorGroups.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_globalaccelerator_endpoint.listGlobalAcceleratorEndpoints", "api_error", err)
+				return nil, err
+			}
+
+			for _, endpointGroup := range outputGroups.EndpointGroups {
+				if d.KeyColumnQuals["endpoint_group_arn"] != nil && d.KeyColumnQuals["endpoint_group_arn"].GetStringValue() != aws.ToString(endpointGroup.EndpointGroupArn) {
+					continue
+				}
+
+				for _, endpoint := range endpointGroup.EndpointDescriptions {
+					d.StreamListItem(ctx, globalAcceleratorEndpoint{
+						AcceleratorArn:      accelerator.AcceleratorArn,
+						ListenerArn:         listenerArn,
+						EndpointGroupArn:    endpointGroup.EndpointGroupArn,
+						EndpointGroupRegion: endpointGroup.EndpointGroupRegion,
+						EndpointDescription: endpoint,
+					})
+
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
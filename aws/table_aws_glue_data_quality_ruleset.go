@@ -0,0 +1,372 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGlueDataQualityRuleset(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_data_quality_ruleset",
+		Description: "AWS Glue Data Quality Ruleset",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getGlueDataQualityRuleset,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGlueDataQualityRulesets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "database_name", Require: plugin.Optional},
+				{Name: "table_name", Require: plugin.Optional},
+				{Name: "created_on", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+				{Name: "last_modified_on", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the data quality ruleset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the data quality ruleset.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlueDataQualityRulesetArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the data quality ruleset.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_on",
+				Description: "The date and time the data quality ruleset was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "The date and time the data quality ruleset was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules in the ruleset.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "recommendation_run_id",
+				Description: "When a ruleset was created from a recommendation run, this run ID is generated to link the two together.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "catalog_id",
+				Description: "The catalog ID of the table the ruleset is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetTable.CatalogId"),
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database where the target table resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetTable.DatabaseName"),
+			},
+			{
+				Name:        "table_name",
+				Description: "The name of the target table the ruleset is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetTable.TableName"),
+			},
+			{
+				Name:        "ruleset",
+				Description: "A Data Quality Definition Language (DQDL) ruleset.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGlueDataQualityRuleset,
+			},
+			{
+				Name:        "evaluation_results",
+				Description: "The results of the most recent evaluation runs of the ruleset against its target table, including the score and the outcome of each rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueDataQualityRulesetEvaluationResults,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGlueDataQualityRulesetArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueDataQualityRulesets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.listGlueDataQualityRulesets", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &glue.ListDataQualityRulesetsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filter := buildGlueDataQualityRulesetFilter(d)
+	if filter != nil {
+		input.Filter = filter
+	}
+
+	paginator := glue.NewListDataQualityRulesetsPaginator(svc, input, func(o *glue.ListDataQualityRulesetsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.listGlueDataQualityRulesets", "api_error", err)
+			return nil, err
+		}
+		for _, ruleset := range output.Rulesets {
+			d.StreamListItem(ctx, ruleset)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGlueDataQualityRuleset(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.DataQualityRulesetListDetails).Name
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+	}
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRuleset", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &glue.GetDataQualityRulesetInput{
+		Name: aws.String(name),
+	}
+
+	// Get call
+	data, err := svc.GetDataQualityRuleset(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRuleset", "api_error", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// getGlueDataQualityRulesetEvaluationResults returns the results recorded for
+// the ruleset's target table, limited to the most recent 100 results
+func getGlueDataQualityRulesetEvaluationResults(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name, target := glueDataQualityRulesetNameAndTarget(h.Item)
+
+	// Results can only be filtered by data source, so there is nothing to
+	// look up for rulesets that are not associated with a table
+	if name == nil || target == nil || target.DatabaseName == nil || target.TableName == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRulesetEvaluationResults", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	listOutput, err := svc.ListDataQualityResults(ctx, &glue.ListDataQualityResultsInput{
+		MaxResults: aws.Int32(100),
+		Filter: &types.DataQualityResultFilterCriteria{
+			DataSource: &types.DataSource{
+				GlueTable: &types.GlueTable{
+					CatalogId:    target.CatalogId,
+					DatabaseName: target.DatabaseName,
+					TableName:    target.TableName,
+				},
+			},
+		},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRulesetEvaluationResults", "api_error", err)
+		return nil, err
+	}
+
+	var resultIds []string
+	for _, result := range listOutput.Results {
+		resultIds = append(resultIds, *result.ResultId)
+	}
+	if len(resultIds) == 0 {
+		return nil, nil
+	}
+
+	batchOutput, err := svc.BatchGetDataQualityResult(ctx, &glue.BatchGetDataQualityResultInput{
+		ResultIds: resultIds,
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRulesetEvaluationResults", "batch_get_api_error", err)
+		return nil, err
+	}
+
+	var results []types.DataQualityResult
+	for _, result := range batchOutput.Results {
+		if result.RulesetName != nil && *result.RulesetName == *name {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+func getGlueDataQualityRulesetArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	name, _ := glueDataQualityRulesetNameAndTarget(h.Item)
+	if name == nil {
+		return nil, nil
+	}
+
+	// Get common columns
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_data_quality_ruleset.getGlueDataQualityRulesetArn", "common_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn format - https://docs.aws.amazon.com/glue/latest/dg/glue-specifying-resource-arns.html
+	// arn:aws:glue:region:account-id:dataQualityRuleset/ruleset-name
+	arn := "arn:" + commonColumnData.Partition + ":glue:" + region + ":" + commonColumnData.AccountId + ":dataQualityRuleset/" + *name
+
+	return arn, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func glueDataQualityRulesetNameAndTarget(item interface{}) (*string, *types.DataQualityTargetTable) {
+	switch item := item.(type) {
+	case types.DataQualityRulesetListDetails:
+		return item.Name, item.TargetTable
+	case *glue.GetDataQualityRulesetOutput:
+		return item.Name, item.TargetTable
+	}
+	return nil, nil
+}
+
+func buildGlueDataQualityRulesetFilter(d *plugin.QueryData) *types.DataQualityRulesetFilterCriteria {
+	filter := &types.DataQualityRulesetFilterCriteria{}
+	hasFilter := false
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["database_name"] != nil && equalQuals["table_name"] != nil {
+		filter.TargetTable = &types.DataQualityTargetTable{
+			DatabaseName: aws.String(equalQuals["database_name"].GetStringValue()),
+			TableName:    aws.String(equalQuals["table_name"].GetStringValue()),
+		}
+		hasFilter = true
+	}
+
+	if d.Quals["created_on"] != nil {
+		for _, q := range d.Quals["created_on"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				filter.CreatedAfter = aws.Time(timestamp)
+			case "<", "<=":
+				filter.CreatedBefore = aws.Time(timestamp)
+			}
+			hasFilter = true
+		}
+	}
+
+	if d.Quals["last_modified_on"] != nil {
+		for _, q := range d.Quals["last_modified_on"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				filter.LastModifiedAfter = aws.Time(timestamp)
+			case "<", "<=":
+				filter.LastModifiedBefore = aws.Time(timestamp)
+			}
+			hasFilter = true
+		}
+	}
+
+	if !hasFilter {
+		return nil
+	}
+	return filter
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// The optimizer types supported by the Glue Data Catalog for Apache Iceberg tables
+var glueTableOptimizerTypes = []string{"compaction", "retention", "orphan_file_deletion"}
+
+type glueTableOptimizerInfo struct {
+	CatalogId      *string
+	DatabaseName   *string
+	TableName      *string
+	TableOptimizer *types.TableOptimizer
+}
+
+//// TABLE DEFINITION
+
+func tableAwsGlueTableOptimizer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_glue_table_optimizer",
+		Description: "AWS Glue Table Optimizer",
+		List: &plugin.ListConfig{
+			Hydrate: listGlueTableOptimizers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "database_name", Require: plugin.Required},
+				{Name: "table_name", Require: plugin.Required},
+				{Name: "catalog_id", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "catalog_id",
+				Description: "The ID of the Data Catalog in which the table resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database in the catalog in which the table resides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_name",
+				Description: "The name of the table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of table optimizer, which can be compaction, retention or orphan_file_deletion.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableOptimizer.Type"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Whether the table optimization is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("TableOptimizer.Configuration.Enabled"),
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role used by the table optimizer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableOptimizer.Configuration.RoleArn"),
+			},
+			{
+				Name:        "last_run_event_type",
+				Description: "The outcome of the last optimizer run, which can be starting, completed, failed or in_progress.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableOptimizer.LastRun.EventType"),
+			},
+			{
+				Name:        "last_run_start_timestamp",
+				Description: "The time the last optimizer run started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TableOptimizer.LastRun.StartTimestamp"),
+			},
+			{
+				Name:        "last_run_end_timestamp",
+				Description: "The time the last optimizer run ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TableOptimizer.LastRun.EndTimestamp"),
+			},
+			{
+				Name:        "last_run_error",
+				Description: "An error that occurred during the last optimizer run.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableOptimizer.LastRun.Error"),
+			},
+			{
+				Name:        "configuration",
+				Description: "The configuration of the table optimizer, including the retention and orphan file deletion settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableOptimizer.Configuration"),
+			},
+			{
+				Name:        "last_run",
+				Description: "Details of the last optimizer run, including the metrics reported for the run.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableOptimizer.LastRun"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableOptimizer.Type"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGlueTableOptimizers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	equalQuals := d.KeyColumnQuals
+	databaseName := equalQuals["database_name"].GetStringValue()
+	tableName := equalQuals["table_name"].GetStringValue()
+
+	// Empty check
+	if databaseName == "" || tableName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := GlueClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_glue_table_optimizer.listGlueTableOptimizers", "connection_error", err)
+		return nil, err
+	}
+
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// The catalog ID is required by the API and defaults to the account ID
+	catalogId := equalQuals["catalog_id"].GetStringValue()
+	if catalogId == "" {
+		getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+		c, err := getCommonColumnsCached(ctx, d, h)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_glue_table_optimizer.listGlueTableOptimizers", "common_data_error", err)
+			return nil, err
+		}
+		catalogId = c.(*awsCommonColumnData).AccountId
+	}
+
+	optimizerTypes := glueTableOptimizerTypes
+	if equalQuals["type"] != nil {
+		optimizerTypes = []string{equalQuals["type"].GetStringValue()}
+	}
+
+	// Each optimizer type must be requested separately; an optimizer that has
+	// not been configured for the table returns EntityNotFoundException
+	for _, optimizerType := range optimizerTypes {
+		params := &glue.GetTableOptimizerInput{
+			CatalogId:    aws.String(catalogId),
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+			Type:         types.TableOptimizerType(optimizerType),
+		}
+
+		output, err := svc.GetTableOptimizer(ctx, params)
+		if err != nil {
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				if ae.ErrorCode() == "EntityNotFoundException" {
+					continue
+				}
+			}
+			plugin.Logger(ctx).Error("aws_glue_table_optimizer.listGlueTableOptimizers", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, glueTableOptimizerInfo{
+			CatalogId:      output.CatalogId,
+			DatabaseName:   output.DatabaseName,
+			TableName:      output.TableName,
+			TableOptimizer: output.TableOptimizer,
+		})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
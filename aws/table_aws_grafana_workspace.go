@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	"github.com/aws/aws-sdk-go-v2/service/grafana/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGrafanaWorkspace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_grafana_workspace",
+		Description: "AWS Managed Grafana Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getGrafanaWorkspace,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGrafanaWorkspaces,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getGrafanaWorkspaceArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The customer-entered description of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The URL endpoint to use to access the Grafana console in the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grafana_version",
+				Description: "The Grafana version that the workspace is running.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The date that the workspace was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified",
+				Description: "The most recent date that the workspace was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "authentication_providers",
+				Description: "Specifies whether the workspace uses SAML, IAM Identity Center, or both methods for user authentication.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Authentication.Providers"),
+			},
+			{
+				Name:        "saml_configuration_status",
+				Description: "Specifies whether the workspace's SAML configuration is complete.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Authentication.SamlConfigurationStatus"),
+			},
+			{
+				Name:        "notification_destinations",
+				Description: "The Amazon Web Services notification channels that Amazon Managed Grafana can automatically create IAM roles and permissions for.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "account_access_type",
+				Description: "Specifies whether the workspace can access Amazon Web Services resources in this account only, or in all accounts in the organization.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "permission_type",
+				Description: "Specifies whether Amazon Managed Grafana creates the IAM roles and permissions used to access data sources, or whether they are managed by the customer.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "workspace_role_arn",
+				Description: "The IAM role that grants permissions to the Amazon Web Services resources that the workspace will view data from.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "license_type",
+				Description: "Specifies whether this workspace has a full Grafana Enterprise license or a free trial license.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "license_expiration",
+				Description: "If this workspace has a full Grafana Enterprise license, this specifies when the license ends and will need to be renewed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "free_trial_consumed",
+				Description: "Specifies whether this workspace has already fully used its free trial for Grafana Enterprise.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "free_trial_expiration",
+				Description: "If this workspace is currently in the free trial period for Grafana Enterprise, this value specifies when that free trial ends.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "organization_role_name",
+				Description: "The name of the IAM role that is used to access resources through Organizations.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "stack_set_name",
+				Description: "The name of the CloudFormation stack set that is used to generate IAM roles to be used for this workspace.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "data_sources",
+				Description: "Specifies the Amazon Web Services data sources that have been configured to have IAM roles and permissions created to allow Amazon Managed Grafana to read data from these sources.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+			{
+				Name:        "organizational_units",
+				Description: "Specifies the organizational units that this workspace is allowed to use data sources from, if this workspace is in an account that is part of an organization.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGrafanaWorkspace,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGrafanaWorkspaceArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGrafanaWorkspaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := GrafanaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_grafana_workspace.listGrafanaWorkspaces", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &grafana.ListWorkspacesInput{}
+
+	paginator := grafana.NewListWorkspacesPaginator(svc, input, func(o *grafana.ListWorkspacesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_grafana_workspace.listGrafanaWorkspaces", "api_error", err)
+			return nil, err
+		}
+
+		for _, workspace := range output.Workspaces {
+			d.StreamListItem(ctx, workspace)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGrafanaWorkspace(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	workspace, err := getGrafanaWorkspaceDetails(ctx, d, id)
+	if err != nil || workspace == nil {
+		return nil, err
+	}
+
+	summary := types.WorkspaceSummary{
+		Created:                  workspace.Created,
+		Description:              workspace.Description,
+		Endpoint:                 workspace.Endpoint,
+		GrafanaVersion:           workspace.GrafanaVersion,
+		Id:                       workspace.Id,
+		Modified:                 workspace.Modified,
+		Name:                     workspace.Name,
+		NotificationDestinations: workspace.NotificationDestinations,
+		Status:                   workspace.Status,
+		Tags:                     workspace.Tags,
+	}
+	if workspace.Authentication != nil {
+		summary.Authentication = &types.AuthenticationSummary{
+			Providers:               workspace.Authentication.Providers,
+			SamlConfigurationStatus: workspace.Authentication.SamlConfigurationStatus,
+		}
+	}
+
+	return summary, nil
+}
+
+// describeGrafanaWorkspace returns the license, data source and permission
+// settings of the workspace, which are not included in the list response
+func describeGrafanaWorkspace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.WorkspaceSummary).Id
+
+	workspace, err := getGrafanaWorkspaceDetails(ctx, d, *id)
+	if err != nil || workspace == nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+func getGrafanaWorkspaceDetails(ctx context.Context, d *plugin.QueryData, id string) (*types.WorkspaceDescription, error) {
+	// Create Session
+	svc, err := GrafanaClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_grafana_workspace.getGrafanaWorkspaceDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &grafana.DescribeWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	op, err := svc.DescribeWorkspace(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_grafana_workspace.getGrafanaWorkspaceDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Workspace, nil
+}
+
+func getGrafanaWorkspaceArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.WorkspaceSummary).Id
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_grafana_workspace.getGrafanaWorkspaceArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// Workspaces don't have an ARN in the API response, so build it
+	arn := "arn:" + commonColumnData.Partition + ":grafana:" + region + ":" + commonColumnData.AccountId + ":/workspaces/" + *id
+
+	return arn, nil
+}
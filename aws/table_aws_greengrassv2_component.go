@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGreengrassV2Component(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_greengrassv2_component",
+		Description: "AWS IoT Greengrass V2 Component",
+		List: &plugin.ListConfig{
+			Hydrate: listGreengrassV2Components,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "component_name",
+				Description: "The name of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the component.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latest_version",
+				Description: "The version of the latest version of the component.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestVersion.ComponentVersion"),
+			},
+			{
+				Name:        "latest_version_arn",
+				Description: "The Amazon Resource Name (ARN) of the latest version of the component.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestVersion.Arn"),
+			},
+			{
+				Name:        "latest_version_creation_timestamp",
+				Description: "The time at which the latest version of the component was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LatestVersion.CreationTimestamp"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the latest version of the component.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestVersion.Description"),
+			},
+			{
+				Name:        "publisher",
+				Description: "The publisher of the latest version of the component.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestVersion.Publisher"),
+			},
+			{
+				Name:        "platforms",
+				Description: "The platforms that the latest version of the component supports.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LatestVersion.Platforms"),
+			},
+			{
+				Name:        "versions",
+				Description: "The versions of the component.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listGreengrassV2ComponentVersions,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGreengrassV2ComponentTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ComponentName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGreengrassV2Components(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_component.listGreengrassV2Components", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// Only list the components created in the account, not the public
+	// components provided by AWS
+	input := &greengrassv2.ListComponentsInput{
+		Scope: types.ComponentVisibilityScopePrivate,
+	}
+
+	paginator := greengrassv2.NewListComponentsPaginator(svc, input, func(o *greengrassv2.ListComponentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_component.listGreengrassV2Components", "api_error", err)
+			return nil, err
+		}
+
+		for _, component := range output.Components {
+			d.StreamListItem(ctx, component)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listGreengrassV2ComponentVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := h.Item.(types.Component).Arn
+
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_component.listGreengrassV2ComponentVersions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &greengrassv2.ListComponentVersionsInput{
+		Arn: arn,
+	}
+
+	paginator := greengrassv2.NewListComponentVersionsPaginator(svc, input, func(o *greengrassv2.ListComponentVersionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var versions []types.ComponentVersionListItem
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_component.listGreengrassV2ComponentVersions", "api_error", err)
+			return nil, err
+		}
+		versions = append(versions, output.ComponentVersions...)
+	}
+
+	return versions, nil
+}
+
+func getGreengrassV2ComponentTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := h.Item.(types.Component).Arn
+
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_component.getGreengrassV2ComponentTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &greengrassv2.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_component.getGreengrassV2ComponentTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
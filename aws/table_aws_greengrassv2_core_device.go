@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGreengrassV2CoreDevice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_greengrassv2_core_device",
+		Description: "AWS IoT Greengrass V2 Core Device",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("core_device_thing_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getGreengrassV2CoreDevice,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGreengrassV2CoreDevices,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "core_device_thing_name",
+				Description: "The name of the core device. This is also the name of the IoT thing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the core device, either HEALTHY or UNHEALTHY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_status_update_timestamp",
+				Description: "The time at which the core device's status last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "core_version",
+				Description: "The version of the IoT Greengrass Core software that the core device runs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGreengrassV2CoreDevice,
+			},
+			{
+				Name:        "platform",
+				Description: "The operating system platform that the core device runs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGreengrassV2CoreDevice,
+			},
+			{
+				Name:        "architecture",
+				Description: "The computer architecture of the core device.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGreengrassV2CoreDevice,
+			},
+			{
+				Name:        "installed_components",
+				Description: "The components that run on the core device, including their lifecycle state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listGreengrassV2CoreDeviceInstalledComponents,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "effective_deployments",
+				Description: "The deployments that apply to the core device, including the execution status of each deployment on the device.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listGreengrassV2CoreDeviceEffectiveDeployments,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGreengrassV2CoreDevice,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CoreDeviceThingName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGreengrassV2CoreDevices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDevices", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &greengrassv2.ListCoreDevicesInput{}
+	if d.KeyColumnQuals["status"] != nil {
+		input.Status = types.CoreDeviceStatus(d.KeyColumnQuals["status"].GetStringValue())
+	}
+
+	paginator := greengrassv2.NewListCoreDevicesPaginator(svc, input, func(o *greengrassv2.ListCoreDevicesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDevices", "api_error", err)
+			return nil, err
+		}
+
+		for _, coreDevice := range output.CoreDevices {
+			d.StreamListItem(ctx, coreDevice)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGreengrassV2CoreDevice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["core_device_thing_name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := getGreengrassV2CoreDeviceDetails(ctx, d, name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.CoreDevice{
+		CoreDeviceThingName:       op.CoreDeviceThingName,
+		LastStatusUpdateTimestamp: op.LastStatusUpdateTimestamp,
+		Status:                    op.Status,
+	}, nil
+}
+
+// describeGreengrassV2CoreDevice returns the software, platform and tags of
+// the core device, which are not included in the list response
+func describeGreengrassV2CoreDevice(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.CoreDevice).CoreDeviceThingName
+
+	op, err := getGreengrassV2CoreDeviceDetails(ctx, d, *name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getGreengrassV2CoreDeviceDetails(ctx context.Context, d *plugin.QueryData, name string) (*greengrassv2.GetCoreDeviceOutput, error) {
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_core_device.getGreengrassV2CoreDeviceDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &greengrassv2.GetCoreDeviceInput{
+		CoreDeviceThingName: aws.String(name),
+	}
+
+	op, err := svc.GetCoreDevice(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_core_device.getGreengrassV2CoreDeviceDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listGreengrassV2CoreDeviceInstalledComponents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.CoreDevice).CoreDeviceThingName
+
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDeviceInstalledComponents", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &greengrassv2.ListInstalledComponentsInput{
+		CoreDeviceThingName: name,
+	}
+
+	paginator := greengrassv2.NewListInstalledComponentsPaginator(svc, input, func(o *greengrassv2.ListInstalledComponentsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var components []types.InstalledComponent
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDeviceInstalledComponents", "api_error", err)
+			return nil, err
+		}
+		components = append(components, output.InstalledComponents...)
+	}
+
+	return components, nil
+}
+
+func listGreengrassV2CoreDeviceEffectiveDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.CoreDevice).CoreDeviceThingName
+
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDeviceEffectiveDeployments", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &greengrassv2.ListEffectiveDeploymentsInput{
+		CoreDeviceThingName: name,
+	}
+
+	paginator := greengrassv2.NewListEffectiveDeploymentsPaginator(svc, input, func(o *greengrassv2.ListEffectiveDeploymentsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var deployments []types.EffectiveDeployment
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_core_device.listGreengrassV2CoreDeviceEffectiveDeployments", "api_error", err)
+			return nil, err
+		}
+		deployments = append(deployments, output.EffectiveDeployments...)
+	}
+
+	return deployments, nil
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsGreengrassV2Deployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_greengrassv2_deployment",
+		Description: "AWS IoT Greengrass V2 Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("deployment_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getGreengrassV2Deployment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listGreengrassV2Deployments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "target_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "deployment_name",
+				Description: "The name of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_id",
+				Description: "The ID of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision_id",
+				Description: "The revision number of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_status",
+				Description: "The status of the deployment, such as ACTIVE, COMPLETED or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_arn",
+				Description: "The ARN of the target IoT thing or thing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_target_arn",
+				Description: "The parent deployment's target ARN within a subdeployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_latest_for_target",
+				Description: "Whether or not the deployment is the latest revision for its target.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "creation_timestamp",
+				Description: "The time at which the deployment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "iot_job_id",
+				Description: "The ID of the IoT job that applies the deployment to target devices.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+			{
+				Name:        "iot_job_arn",
+				Description: "The ARN of the IoT job that applies the deployment to target devices.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+			{
+				Name:        "components",
+				Description: "The components to deploy, including their versions and configuration updates.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+			{
+				Name:        "deployment_policies",
+				Description: "The deployment policies for the deployment, which define how the deployment updates components and handles failure.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+			{
+				Name:        "iot_job_configuration",
+				Description: "The job configuration for the deployment, including rollout, timeout and stop configurations.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeGreengrassV2Deployment,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeploymentName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGreengrassV2Deployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_deployment.listGreengrassV2Deployments", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// Only the latest revision of each deployment is listed
+	input := &greengrassv2.ListDeploymentsInput{
+		HistoryFilter: types.DeploymentHistoryFilterLatestOnly,
+	}
+	if d.KeyColumnQuals["target_arn"] != nil {
+		input.TargetArn = aws.String(d.KeyColumnQuals["target_arn"].GetStringValue())
+	}
+
+	paginator := greengrassv2.NewListDeploymentsPaginator(svc, input, func(o *greengrassv2.ListDeploymentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_greengrassv2_deployment.listGreengrassV2Deployments", "api_error", err)
+			return nil, err
+		}
+
+		for _, deployment := range output.Deployments {
+			d.StreamListItem(ctx, deployment)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getGreengrassV2Deployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	deploymentID := d.KeyColumnQuals["deployment_id"].GetStringValue()
+
+	// check if deployment id is empty
+	if deploymentID == "" {
+		return nil, nil
+	}
+
+	op, err := getGreengrassV2DeploymentDetails(ctx, d, deploymentID)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.Deployment{
+		CreationTimestamp: op.CreationTimestamp,
+		DeploymentId:      op.DeploymentId,
+		DeploymentName:    op.DeploymentName,
+		DeploymentStatus:  op.DeploymentStatus,
+		IsLatestForTarget: op.IsLatestForTarget,
+		ParentTargetArn:   op.ParentTargetArn,
+		RevisionId:        op.RevisionId,
+		TargetArn:         op.TargetArn,
+	}, nil
+}
+
+// describeGreengrassV2Deployment returns the components, policies and IoT job
+// of the deployment, which are not included in the list response
+func describeGreengrassV2Deployment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	deploymentID := h.Item.(types.Deployment).DeploymentId
+
+	op, err := getGreengrassV2DeploymentDetails(ctx, d, *deploymentID)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getGreengrassV2DeploymentDetails(ctx context.Context, d *plugin.QueryData, deploymentID string) (*greengrassv2.GetDeploymentOutput, error) {
+	// Create Session
+	svc, err := GreengrassV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_deployment.getGreengrassV2DeploymentDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &greengrassv2.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+	}
+
+	op, err := svc.GetDeployment(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_greengrassv2_deployment.getGreengrassV2DeploymentDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
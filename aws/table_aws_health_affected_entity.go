@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+)
+
+func tableAwsHealthAffectedEntity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_health_affected_entity",
+		Description: "AWS Health Affected Entity",
+		List: &plugin.ListConfig{
+			ParentHydrate: listHealthEvents,
+			Hydrate:       listHealthAffectedEntities,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The unique identifier for the entity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EntityArn"),
+			},
+			{
+				Name:        "entity_value",
+				Description: "The ID of the affected entity, for example an EC2 instance ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entity_url",
+				Description: "The URL of the affected entity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_arn",
+				Description: "The Amazon Resource Name (ARN) of the Health event that affects the entity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "entity_account_id",
+				Description: "The 12-digit Amazon Web Services account number that contains the affected entity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AwsAccountId"),
+			},
+			{
+				Name:        "status_code",
+				Description: "The most recent status of the entity affected by the event. The possible values are IMPAIRED, UNIMPAIRED, and UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The most recent time that the entity was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EntityValue"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listHealthAffectedEntities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	event := h.Item.(types.Event)
+
+	// Minimize API calls when a specific event has been requested
+	if d.KeyColumnQuals["event_arn"] != nil && d.KeyColumnQuals["event_arn"].GetStringValue() != *event.Arn {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := HealthClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_health_affected_entity.listHealthAffectedEntities", "client error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 10 {
+				maxLimit = 10
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &health.DescribeAffectedEntitiesInput{
+		Filter: &types.EntityFilter{
+			EventArns: []string{*event.Arn},
+		},
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := health.NewDescribeAffectedEntitiesPaginator(svc, input, func(o *health.DescribeAffectedEntitiesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_health_affected_entity.listHealthAffectedEntities", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Entities {
+			d.StreamLeafListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/aws/aws-sdk-go-v2/service/health/types"
+)
+
+func tableAwsHealthOrganizationEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_health_organization_event",
+		Description: "AWS Health Organization Event",
+		List: &plugin.ListConfig{
+			Hydrate: listHealthOrganizationEvents,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "event_type_category", Require: plugin.Optional},
+				{Name: "event_type_code", Require: plugin.Optional},
+				{Name: "service", Require: plugin.Optional},
+				{Name: "status_code", Require: plugin.Optional},
+				{Name: "event_region", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_region",
+				Description: "The Amazon Web Services Region name of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time that the event began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The date and time that the event ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "event_scope_code",
+				Description: "This parameter specifies if the Health event is a public Amazon Web Services service event or an account-specific event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_type_category",
+				Description: "A list of event type category codes. Possible values are issue, accountNotification, or scheduledChange.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_type_code",
+				Description: "The unique identifier for the event type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The most recent date and time that the event was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "service",
+				Description: "The Amazon Web Services service that is affected by the event. For example, EC2, RDS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_code",
+				Description: "The most recent status of the event. Possible values are open, closed, and upcoming.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "affected_accounts",
+				Description: "The 12-digit Amazon Web Services account numbers in the organization that are affected by the event.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listHealthOrganizationEventAffectedAccounts,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listHealthOrganizationEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := HealthClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_health_organization_event.listHealthOrganizationEvents", "client error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 10 {
+				maxLimit = 10
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &health.DescribeEventsForOrganizationInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	filter := &types.OrganizationEventFilter{}
+	if value := d.KeyColumnQualString("event_type_category"); value != "" {
+		filter.EventTypeCategories = []types.EventTypeCategory{types.EventTypeCategory(value)}
+	}
+	if value := d.KeyColumnQualString("event_type_code"); value != "" {
+		filter.EventTypeCodes = []string{value}
+	}
+	if value := d.KeyColumnQualString("service"); value != "" {
+		filter.Services = []string{value}
+	}
+	if value := d.KeyColumnQualString("status_code"); value != "" {
+		filter.EventStatusCodes = []types.EventStatusCode{types.EventStatusCode(value)}
+	}
+	if value := d.KeyColumnQualString("event_region"); value != "" {
+		filter.Regions = []string{value}
+	}
+	input.Filter = filter
+
+	paginator := health.NewDescribeEventsForOrganizationPaginator(svc, input, func(o *health.DescribeEventsForOrganizationPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_health_organization_event.listHealthOrganizationEvents", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Events {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listHealthOrganizationEventAffectedAccounts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	event := h.Item.(types.OrganizationEvent)
+
+	// Create Session
+	svc, err := HealthClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_health_organization_event.listHealthOrganizationEventAffectedAccounts", "client error", err)
+		return nil, err
+	}
+
+	input := &health.DescribeAffectedAccountsForOrganizationInput{
+		EventArn: event.Arn,
+	}
+
+	paginator := health.NewDescribeAffectedAccountsForOrganizationPaginator(svc, input, func(o *health.DescribeAffectedAccountsForOrganizationPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var accounts []string
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_health_organization_event.listHealthOrganizationEventAffectedAccounts", "api_error", err)
+			return nil, err
+		}
+		accounts = append(accounts, output.AffectedAccounts...)
+	}
+
+	return accounts, nil
+}
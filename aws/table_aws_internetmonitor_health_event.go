@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/internetmonitor"
+	"github.com/aws/aws-sdk-go-v2/service/internetmonitor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type internetMonitorHealthEventInfo = struct {
+	types.HealthEvent
+	MonitorName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsInternetMonitorHealthEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_internetmonitor_health_event",
+		Description: "AWS CloudWatch Internet Monitor Health Event",
+		List: &plugin.ListConfig{
+			ParentHydrate: listInternetMonitorMonitors,
+			Hydrate:       listInternetMonitorHealthEvents,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "monitor_name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "started_at", Operators: []string{">", ">=", "=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "event_id",
+				Description: "The internally generated identifier of the health event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_arn",
+				Description: "The Amazon Resource Name (ARN) of the health event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "monitor_name",
+				Description: "The name of the monitor that reported the health event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the health event, ACTIVE or RESOLVED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "impact_type",
+				Description: "The type of impairment for the health event, AVAILABILITY or PERFORMANCE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "percent_of_total_traffic_impacted",
+				Description: "The impact on total traffic that the health event has, in increased latency or reduced availability.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "started_at",
+				Description: "When the health event started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "ended_at",
+				Description: "The time when the health event ended. If the health event is still active, this is empty.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "created_at",
+				Description: "When the health event was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "When the health event was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "impacted_locations",
+				Description: "The locations impacted by the health event, including the network, the cause of the impairment and the availability and performance scores for each location.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listInternetMonitorHealthEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	monitor := h.Item.(types.Monitor)
+
+	// Minimize API calls when a specific monitor has been requested
+	if d.KeyColumnQuals["monitor_name"] != nil && d.KeyColumnQuals["monitor_name"].GetStringValue() != *monitor.MonitorName {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := InternetMonitorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_internetmonitor_health_event.listInternetMonitorHealthEvents", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &internetmonitor.ListHealthEventsInput{
+		MonitorName: monitor.MonitorName,
+	}
+	if d.KeyColumnQuals["status"] != nil {
+		input.EventStatus = types.HealthEventStatus(d.KeyColumnQuals["status"].GetStringValue())
+	}
+
+	quals := d.Quals
+	if quals["started_at"] != nil {
+		for _, q := range quals["started_at"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				input.StartTime = aws.Time(timestamp)
+				input.EndTime = aws.Time(timestamp)
+			case ">=", ">":
+				input.StartTime = aws.Time(timestamp)
+			case "<", "<=":
+				input.EndTime = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := internetmonitor.NewListHealthEventsPaginator(svc, input, func(o *internetmonitor.ListHealthEventsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_internetmonitor_health_event.listInternetMonitorHealthEvents", "api_error", err)
+			return nil, err
+		}
+
+		for _, event := range output.HealthEvents {
+			d.StreamLeafListItem(ctx, internetMonitorHealthEventInfo{event, monitor.MonitorName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
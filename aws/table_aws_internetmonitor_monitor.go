@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/internetmonitor"
+	"github.com/aws/aws-sdk-go-v2/service/internetmonitor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsInternetMonitorMonitor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_internetmonitor_monitor",
+		Description: "AWS CloudWatch Internet Monitor Monitor",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "NotFoundException", "ValidationException"}),
+			},
+			Hydrate: getInternetMonitorMonitor,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listInternetMonitorMonitors,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the monitor, e.g. PENDING, ACTIVE, INACTIVE or ERROR.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "processing_status",
+				Description: "The health of the data processing for the monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "processing_status_info",
+				Description: "Additional information about the health of the data processing for the monitor.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+			{
+				Name:        "created_at",
+				Description: "The time when the monitor was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+			{
+				Name:        "modified_at",
+				Description: "The last time that the monitor was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+			{
+				Name:        "max_city_networks_to_monitor",
+				Description: "The maximum number of city-networks to monitor for the resources.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+			{
+				Name:        "resources",
+				Description: "The resources that have been added for the monitor, listed by their ARNs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MonitorName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeInternetMonitorMonitor,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MonitorArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listInternetMonitorMonitors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := InternetMonitorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_internetmonitor_monitor.listInternetMonitorMonitors", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &internetmonitor.ListMonitorsInput{}
+	if d.KeyColumnQuals["status"] != nil {
+		input.MonitorStatus = aws.String(d.KeyColumnQuals["status"].GetStringValue())
+	}
+
+	paginator := internetmonitor.NewListMonitorsPaginator(svc, input, func(o *internetmonitor.ListMonitorsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_internetmonitor_monitor.listInternetMonitorMonitors", "api_error", err)
+			return nil, err
+		}
+
+		for _, monitor := range output.Monitors {
+			d.StreamListItem(ctx, monitor)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getInternetMonitorMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := getInternetMonitorMonitorDetails(ctx, d, name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.Monitor{
+		MonitorArn:       op.MonitorArn,
+		MonitorName:      op.MonitorName,
+		Status:           op.Status,
+		ProcessingStatus: op.ProcessingStatus,
+	}, nil
+}
+
+// describeInternetMonitorMonitor returns the resources and settings of the
+// monitor, which are not included in the list response
+func describeInternetMonitorMonitor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.Monitor).MonitorName
+
+	op, err := getInternetMonitorMonitorDetails(ctx, d, *name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getInternetMonitorMonitorDetails(ctx context.Context, d *plugin.QueryData, name string) (*internetmonitor.GetMonitorOutput, error) {
+	// Create Session
+	svc, err := InternetMonitorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_internetmonitor_monitor.getInternetMonitorMonitorDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &internetmonitor.GetMonitorInput{
+		MonitorName: aws.String(name),
+	}
+
+	op, err := svc.GetMonitor(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_internetmonitor_monitor.getInternetMonitorMonitorDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
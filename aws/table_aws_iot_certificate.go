@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIoTCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iot_certificate",
+		Description: "AWS IoT Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("certificate_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidRequestException"}),
+			},
+			Hydrate: getIoTCertificate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIoTCertificates,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "certificate_id",
+				Description: "The ID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateArn"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the certificate, such as ACTIVE, INACTIVE or REVOKED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "certificate_mode",
+				Description: "The mode of the certificate, either DEFAULT or SNI_ONLY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the certificate was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The date and time the certificate was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "validity_not_before",
+				Description: "The certificate is not valid before this date and time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeIoTCertificate,
+				Transform:   transform.FromField("Validity.NotBefore"),
+			},
+			{
+				Name:        "validity_not_after",
+				Description: "The certificate is not valid after this date and time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeIoTCertificate,
+				Transform:   transform.FromField("Validity.NotAfter"),
+			},
+			{
+				Name:        "ca_certificate_id",
+				Description: "The ID of the CA certificate that signed the certificate, if it was registered with a CA.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "owned_by",
+				Description: "The ID of the Amazon Web Services account that owns the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "previous_owned_by",
+				Description: "The ID of the Amazon Web Services account of the previous owner of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "customer_version",
+				Description: "The customer version of the certificate.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "generation_id",
+				Description: "The generation ID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "certificate_pem",
+				Description: "The certificate data, in PEM format.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTCertificate,
+			},
+			{
+				Name:        "transfer_data",
+				Description: "The transfer data of the certificate, if it has been transferred to another account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeIoTCertificate,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIoTCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_certificate.listIoTCertificates", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(250)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := iot.NewListCertificatesPaginator(svc, &iot.ListCertificatesInput{}, func(o *iot.ListCertificatesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_iot_certificate.listIoTCertificates", "api_error", err)
+			return nil, err
+		}
+
+		for _, certificate := range output.Certificates {
+			d.StreamListItem(ctx, certificate)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIoTCertificate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	certificateID := d.KeyColumnQuals["certificate_id"].GetStringValue()
+
+	// check if certificate id is empty
+	if certificateID == "" {
+		return nil, nil
+	}
+
+	certificate, err := describeIoTCertificateDescription(ctx, d, certificateID)
+	if err != nil || certificate == nil {
+		return nil, err
+	}
+
+	return types.Certificate{
+		CertificateArn:  certificate.CertificateArn,
+		CertificateId:   certificate.CertificateId,
+		CertificateMode: certificate.CertificateMode,
+		CreationDate:    certificate.CreationDate,
+		Status:          certificate.Status,
+	}, nil
+}
+
+// describeIoTCertificate returns the validity, ownership and PEM data of the
+// certificate, which are not included in the list response
+func describeIoTCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	certificateID := h.Item.(types.Certificate).CertificateId
+
+	certificate, err := describeIoTCertificateDescription(ctx, d, *certificateID)
+	if err != nil || certificate == nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+func describeIoTCertificateDescription(ctx context.Context, d *plugin.QueryData, certificateID string) (*types.CertificateDescription, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_certificate.describeIoTCertificateDescription", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &iot.DescribeCertificateInput{
+		CertificateId: aws.String(certificateID),
+	}
+
+	op, err := svc.DescribeCertificate(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_certificate.describeIoTCertificateDescription", "api_error", err)
+		return nil, err
+	}
+
+	return op.CertificateDescription, nil
+}
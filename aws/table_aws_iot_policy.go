@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIoTPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iot_policy",
+		Description: "AWS IoT Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("policy_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getIoTPolicy,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIoTPolicies,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "policy_name",
+				Description: "The name of the policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyArn"),
+			},
+			{
+				Name:        "default_version_id",
+				Description: "The ID of the default version of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIoTPolicyDocument,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getIoTPolicyDocument,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "The date and time the policy was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getIoTPolicyDocument,
+			},
+			{
+				Name:        "generation_id",
+				Description: "The generation ID of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getIoTPolicyDocument,
+			},
+			{
+				Name:        "policy",
+				Description: "The JSON document of the default version of the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTPolicyDocument,
+				Transform:   transform.FromField("PolicyDocument").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy document in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTPolicyDocument,
+				Transform:   transform.FromField("PolicyDocument").Transform(unescape).Transform(policyToCanonical),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTResourceTags,
+				Transform:   transform.FromValue().Transform(iotTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PolicyArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIoTPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_policy.listIoTPolicies", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(250)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := iot.NewListPoliciesPaginator(svc, &iot.ListPoliciesInput{}, func(o *iot.ListPoliciesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_iot_policy.listIoTPolicies", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range output.Policies {
+			d.StreamListItem(ctx, policy)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIoTPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	policyName := d.KeyColumnQuals["policy_name"].GetStringValue()
+
+	// check if policy name is empty
+	if policyName == "" {
+		return nil, nil
+	}
+
+	op, err := getIoTPolicyDetails(ctx, d, policyName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.Policy{
+		PolicyArn:  op.PolicyArn,
+		PolicyName: op.PolicyName,
+	}, nil
+}
+
+// getIoTPolicyDocument returns the document of the default version of the
+// policy, which is not included in the list response
+func getIoTPolicyDocument(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policyName := h.Item.(types.Policy).PolicyName
+
+	op, err := getIoTPolicyDetails(ctx, d, *policyName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getIoTPolicyDetails(ctx context.Context, d *plugin.QueryData, policyName string) (*iot.GetPolicyOutput, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_policy.getIoTPolicyDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &iot.GetPolicyInput{
+		PolicyName: aws.String(policyName),
+	}
+
+	op, err := svc.GetPolicy(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_policy.getIoTPolicyDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
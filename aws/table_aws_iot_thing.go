@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIoTThing(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iot_thing",
+		Description: "AWS IoT Thing",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("thing_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getIoTThing,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIoTThings,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "thing_type_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "thing_name",
+				Description: "The name of the thing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the thing.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ThingArn"),
+			},
+			{
+				Name:        "thing_id",
+				Description: "The ID of the thing.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThing,
+			},
+			{
+				Name:        "thing_type_name",
+				Description: "The name of the thing type, if the thing has been associated with a type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_group_name",
+				Description: "The name of the billing group the thing belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThing,
+			},
+			{
+				Name:        "default_client_id",
+				Description: "The default MQTT client ID of the thing.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThing,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the thing record in the registry.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "attributes",
+				Description: "A list of thing attributes, which are name-value pairs.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ThingName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ThingArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIoTThings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing.listIoTThings", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(250)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &iot.ListThingsInput{}
+	if d.KeyColumnQuals["thing_type_name"] != nil {
+		input.ThingTypeName = aws.String(d.KeyColumnQuals["thing_type_name"].GetStringValue())
+	}
+
+	paginator := iot.NewListThingsPaginator(svc, input, func(o *iot.ListThingsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_iot_thing.listIoTThings", "api_error", err)
+			return nil, err
+		}
+
+		for _, thing := range output.Things {
+			d.StreamListItem(ctx, thing)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIoTThing(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	thingName := d.KeyColumnQuals["thing_name"].GetStringValue()
+
+	// check if thing name is empty
+	if thingName == "" {
+		return nil, nil
+	}
+
+	op, err := describeThing(ctx, d, thingName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.ThingAttribute{
+		Attributes:    op.Attributes,
+		ThingArn:      op.ThingArn,
+		ThingName:     op.ThingName,
+		ThingTypeName: op.ThingTypeName,
+		Version:       op.Version,
+	}, nil
+}
+
+// describeIoTThing returns the details of the thing that are not included in
+// the list response
+func describeIoTThing(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	thingName := h.Item.(types.ThingAttribute).ThingName
+
+	op, err := describeThing(ctx, d, *thingName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func describeThing(ctx context.Context, d *plugin.QueryData, thingName string) (*iot.DescribeThingOutput, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing.describeThing", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &iot.DescribeThingInput{
+		ThingName: aws.String(thingName),
+	}
+
+	op, err := svc.DescribeThing(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing.describeThing", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIoTThingGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_iot_thing_group",
+		Description: "AWS IoT Thing Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("group_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getIoTThingGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIoTThingGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "parent_group_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "group_name",
+				Description: "The name of the thing group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the thing group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupArn"),
+			},
+			{
+				Name:        "thing_group_id",
+				Description: "The ID of the thing group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the thing group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+				Transform:   transform.FromField("ThingGroupProperties.ThingGroupDescription"),
+			},
+			{
+				Name:        "parent_group_name",
+				Description: "The name of the parent thing group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+				Transform:   transform.FromField("ThingGroupMetadata.ParentGroupName"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the thing group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeIoTThingGroup,
+				Transform:   transform.FromField("ThingGroupMetadata.CreationDate"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of a dynamic thing group, such as ACTIVE or BUILDING.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+			},
+			{
+				Name:        "query_string",
+				Description: "The fleet indexing query used to select the things of a dynamic thing group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+			},
+			{
+				Name:        "index_name",
+				Description: "The fleet index used by a dynamic thing group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIoTThingGroup,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the thing group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeIoTThingGroup,
+			},
+			{
+				Name:        "attributes",
+				Description: "The attributes of the thing group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeIoTThingGroup,
+				Transform:   transform.FromField("ThingGroupProperties.AttributePayload.Attributes"),
+			},
+			{
+				Name:        "root_to_parent_thing_groups",
+				Description: "The parent thing groups of the thing group, from the root group down.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeIoTThingGroup,
+				Transform:   transform.FromField("ThingGroupMetadata.RootToParentThingGroups"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the thing group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIoTResourceTags,
+				Transform:   transform.FromValue().Transform(iotTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GroupArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIoTThingGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing_group.listIoTThingGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(250)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &iot.ListThingGroupsInput{}
+	if d.KeyColumnQuals["parent_group_name"] != nil {
+		input.ParentGroup = aws.String(d.KeyColumnQuals["parent_group_name"].GetStringValue())
+	}
+
+	paginator := iot.NewListThingGroupsPaginator(svc, input, func(o *iot.ListThingGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_iot_thing_group.listIoTThingGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, group := range output.ThingGroups {
+			d.StreamListItem(ctx, group)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIoTThingGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	groupName := d.KeyColumnQuals["group_name"].GetStringValue()
+
+	// check if group name is empty
+	if groupName == "" {
+		return nil, nil
+	}
+
+	op, err := describeThingGroup(ctx, d, groupName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.GroupNameAndArn{
+		GroupArn:  op.ThingGroupArn,
+		GroupName: op.ThingGroupName,
+	}, nil
+}
+
+// describeIoTThingGroup returns the properties and metadata of the thing
+// group, which are not included in the list response
+func describeIoTThingGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	groupName := h.Item.(types.GroupNameAndArn).GroupName
+
+	op, err := describeThingGroup(ctx, d, *groupName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func describeThingGroup(ctx context.Context, d *plugin.QueryData, groupName string) (*iot.DescribeThingGroupOutput, error) {
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing_group.describeThingGroup", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &iot.DescribeThingGroupInput{
+		ThingGroupName: aws.String(groupName),
+	}
+
+	op, err := svc.DescribeThingGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot_thing_group.describeThingGroup", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// getIoTResourceTags returns the tags of an IoT thing group or policy
+func getIoTResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.GroupNameAndArn:
+		arn = item.GroupArn
+	case types.Policy:
+		arn = item.PolicyArn
+	}
+
+	// Create Session
+	svc, err := IoTClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_iot.getIoTResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &iot.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	var tags []types.Tag
+	paginator := iot.NewListTagsForResourcePaginator(svc, params, func(o *iot.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_iot.getIoTResourceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func iotTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.Tag)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivs"
+	"github.com/aws/aws-sdk-go-v2/service/ivs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIVSChannel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ivs_channel",
+		Description: "AWS IVS Channel",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getIVSChannel,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIVSChannels,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "recording_configuration_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latency_mode",
+				Description: "The channel latency mode, either NORMAL for standard latency or LOW for low latency.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authorized",
+				Description: "Whether the channel is private, i.e. playback requires a valid playback authorization token.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "recording_configuration_arn",
+				Description: "The ARN of the recording configuration that is used for the channel. Recording is disabled if this is empty.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The channel type, which determines the allowable resolution and bitrate, either STANDARD or BASIC.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIVSChannel,
+			},
+			{
+				Name:        "ingest_endpoint",
+				Description: "The channel ingest endpoint, part of the RTMPS URL used by broadcast software.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIVSChannel,
+			},
+			{
+				Name:        "playback_url",
+				Description: "The channel playback URL.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeIVSChannel,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIVSChannels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_channel.listIVSChannels", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ivs.ListChannelsInput{}
+	if d.KeyColumnQuals["recording_configuration_arn"] != nil {
+		input.FilterByRecordingConfigurationArn = aws.String(d.KeyColumnQuals["recording_configuration_arn"].GetStringValue())
+	}
+
+	paginator := ivs.NewListChannelsPaginator(svc, input, func(o *ivs.ListChannelsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ivs_channel.listIVSChannels", "api_error", err)
+			return nil, err
+		}
+
+		for _, channel := range output.Channels {
+			d.StreamListItem(ctx, channel)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIVSChannel(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	channel, err := getIVSChannelDetails(ctx, d, arn)
+	if err != nil || channel == nil {
+		return nil, err
+	}
+
+	return types.ChannelSummary{
+		Arn:                       channel.Arn,
+		Authorized:                channel.Authorized,
+		LatencyMode:               channel.LatencyMode,
+		Name:                      channel.Name,
+		RecordingConfigurationArn: channel.RecordingConfigurationArn,
+		Tags:                      channel.Tags,
+	}, nil
+}
+
+// describeIVSChannel returns the type and endpoints of the channel, which are
+// not included in the list response
+func describeIVSChannel(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := h.Item.(types.ChannelSummary).Arn
+
+	channel, err := getIVSChannelDetails(ctx, d, *arn)
+	if err != nil || channel == nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func getIVSChannelDetails(ctx context.Context, d *plugin.QueryData, arn string) (*types.Channel, error) {
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_channel.getIVSChannelDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &ivs.GetChannelInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.GetChannel(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_channel.getIVSChannelDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.Channel, nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivs"
+	"github.com/aws/aws-sdk-go-v2/service/ivs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIVSRecordingConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ivs_recording_configuration",
+		Description: "AWS IVS Recording Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getIVSRecordingConfiguration,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIVSRecordingConfigurations,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recording configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the recording configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Indicates the current state of the recording configuration. Possible values are CREATING, CREATE_FAILED and ACTIVE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_bucket_name",
+				Description: "The name of the S3 bucket where recorded video is stored.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DestinationConfiguration.S3.BucketName"),
+			},
+			{
+				Name:        "recording_reconnect_window_seconds",
+				Description: "If a broadcast disconnects and then reconnects within this window, the multiple streams are considered a single broadcast and merged together.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeIVSRecordingConfiguration,
+			},
+			{
+				Name:        "destination_configuration",
+				Description: "A complex type that contains information about where recorded video is stored.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "thumbnail_configuration",
+				Description: "A complex type that controls how thumbnails are recorded for the broadcast.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeIVSRecordingConfiguration,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "Arn"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIVSRecordingConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_recording_configuration.listIVSRecordingConfigurations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &ivs.ListRecordingConfigurationsInput{}
+
+	paginator := ivs.NewListRecordingConfigurationsPaginator(svc, input, func(o *ivs.ListRecordingConfigurationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ivs_recording_configuration.listIVSRecordingConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, config := range output.RecordingConfigurations {
+			d.StreamListItem(ctx, config)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIVSRecordingConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	config, err := getIVSRecordingConfigurationDetails(ctx, d, arn)
+	if err != nil || config == nil {
+		return nil, err
+	}
+
+	return types.RecordingConfigurationSummary{
+		Arn:                      config.Arn,
+		DestinationConfiguration: config.DestinationConfiguration,
+		Name:                     config.Name,
+		State:                    config.State,
+		Tags:                     config.Tags,
+	}, nil
+}
+
+func describeIVSRecordingConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := h.Item.(types.RecordingConfigurationSummary).Arn
+
+	config, err := getIVSRecordingConfigurationDetails(ctx, d, *arn)
+	if err != nil || config == nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func getIVSRecordingConfigurationDetails(ctx context.Context, d *plugin.QueryData, arn string) (*types.RecordingConfiguration, error) {
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_recording_configuration.getIVSRecordingConfigurationDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &ivs.GetRecordingConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.GetRecordingConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_recording_configuration.getIVSRecordingConfigurationDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.RecordingConfiguration, nil
+}
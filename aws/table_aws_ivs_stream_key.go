@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivs"
+	"github.com/aws/aws-sdk-go-v2/service/ivs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsIVSStreamKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ivs_stream_key",
+		Description: "AWS IVS Stream Key",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getIVSStreamKey,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listIVSChannels,
+			Hydrate:       listIVSStreamKeys,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "channel_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the stream key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "channel_arn",
+				Description: "The ARN of the channel associated with the stream key.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Arn"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIVSStreamKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	channel := h.Item.(types.ChannelSummary)
+
+	// Minimize API calls when a specific channel has been requested
+	if d.KeyColumnQuals["channel_arn"] != nil && d.KeyColumnQuals["channel_arn"].GetStringValue() != *channel.Arn {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_stream_key.listIVSStreamKeys", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &ivs.ListStreamKeysInput{
+		ChannelArn: channel.Arn,
+	}
+
+	paginator := ivs.NewListStreamKeysPaginator(svc, input, func(o *ivs.ListStreamKeysPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ivs_stream_key.listIVSStreamKeys", "api_error", err)
+			return nil, err
+		}
+
+		for _, streamKey := range output.StreamKeys {
+			d.StreamLeafListItem(ctx, streamKey)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIVSStreamKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := IVSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_stream_key.getIVSStreamKey", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &ivs.GetStreamKeyInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.GetStreamKey(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ivs_stream_key.getIVSStreamKey", "api_error", err)
+		return nil, err
+	}
+
+	if op.StreamKey == nil {
+		return nil, nil
+	}
+
+	// The stream key value is a secret, so only return the summary fields
+	return types.StreamKeySummary{
+		Arn:        op.StreamKey.Arn,
+		ChannelArn: op.StreamKey.ChannelArn,
+		Tags:       op.StreamKey.Tags,
+	}, nil
+}
@@ -91,6 +91,54 @@ func tableAwsKinesisAnalyticsV2Application(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getKinesisAnalyticsV2Application,
 			},
+			{
+				Name:        "application_mode",
+				Description: "Whether the application runs in STREAMING or INTERACTIVE mode.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKinesisAnalyticsV2Application,
+			},
+			{
+				Name:        "parallelism",
+				Description: "The current number of parallel tasks the Flink application can perform.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.FlinkApplicationConfigurationDescription.ParallelismConfigurationDescription.CurrentParallelism"),
+			},
+			{
+				Name:        "snapshots_enabled",
+				Description: "Indicates whether snapshots are enabled for the application.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.ApplicationSnapshotConfigurationDescription.SnapshotsEnabled"),
+			},
+			{
+				Name:        "parallelism_configuration",
+				Description: "Describes the parallelism of the Flink application, including whether automatic scaling is enabled.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.FlinkApplicationConfigurationDescription.ParallelismConfigurationDescription"),
+			},
+			{
+				Name:        "checkpoint_configuration",
+				Description: "Describes the checkpointing parameters of the Flink application.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.FlinkApplicationConfigurationDescription.CheckpointConfigurationDescription"),
+			},
+			{
+				Name:        "monitoring_configuration",
+				Description: "Describes the CloudWatch logging and metrics configuration of the Flink application.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.FlinkApplicationConfigurationDescription.MonitoringConfigurationDescription"),
+			},
+			{
+				Name:        "vpc_configuration_descriptions",
+				Description: "The VPC configurations of the application.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKinesisAnalyticsV2Application,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.VpcConfigurationDescriptions"),
+			},
 			{
 				Name:        "tags_src",
 				Description: "The key-value tags assigned to the application.",
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type kinesisAnalyticsV2ApplicationVersionInfo = struct {
+	types.ApplicationVersionSummary
+	ApplicationName *string
+	ApplicationARN  *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsKinesisAnalyticsV2ApplicationVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_kinesisanalyticsv2_application_version",
+		Description: "AWS Kinesis Analytics V2 Application Version",
+		List: &plugin.ListConfig{
+			ParentHydrate: listKinesisAnalyticsV2Applications,
+			Hydrate:       listKinesisAnalyticsV2ApplicationVersions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "application_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "application_name",
+				Description: "The name of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "application_arn",
+				Description: "The ARN of the application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationARN"),
+			},
+			{
+				Name:        "application_version_id",
+				Description: "The ID of the application version.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "application_status",
+				Description: "The status of the application when this version was active.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime_environment",
+				Description: "The runtime environment of the application version.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+			},
+			{
+				Name:        "application_version_updated_from",
+				Description: "The previous application version that this version was updated from.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+			},
+			{
+				Name:        "application_version_rolled_back_from",
+				Description: "The application version that was rolled back to create this version, if any.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+			},
+			{
+				Name:        "last_update_timestamp",
+				Description: "The time the application was updated to this version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+			},
+			{
+				Name:        "parallelism",
+				Description: "The number of parallel tasks the Flink application could perform in this version.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+				Transform:   transform.FromField("ApplicationConfigurationDescription.FlinkApplicationConfigurationDescription.ParallelismConfigurationDescription.Parallelism"),
+			},
+			{
+				Name:        "application_configuration_description",
+				Description: "The application's code, runtime properties and Flink configuration in this version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKinesisAnalyticsV2ApplicationVersion,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKinesisAnalyticsV2ApplicationVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	application := h.Item.(types.ApplicationSummary)
+
+	// Minimize API calls when a specific application has been requested
+	if d.KeyColumnQuals["application_name"] != nil && d.KeyColumnQuals["application_name"].GetStringValue() != *application.ApplicationName {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := KinesisAnalyticsV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kinesisanalyticsv2_application_version.listKinesisAnalyticsV2ApplicationVersions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// List call
+	pagesLeft := true
+	maxLimit := int32(50)
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(maxLimit) {
+			if *limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = int32(*limit)
+			}
+		}
+	}
+	params := &kinesisanalyticsv2.ListApplicationVersionsInput{
+		ApplicationName: application.ApplicationName,
+		Limit:           aws.Int32(maxLimit),
+	}
+
+	for pagesLeft {
+		result, err := svc.ListApplicationVersions(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_kinesisanalyticsv2_application_version.listKinesisAnalyticsV2ApplicationVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range result.ApplicationVersionSummaries {
+			d.StreamLeafListItem(ctx, kinesisAnalyticsV2ApplicationVersionInfo{version, application.ApplicationName, application.ApplicationARN})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKinesisAnalyticsV2ApplicationVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	version := h.Item.(kinesisAnalyticsV2ApplicationVersionInfo)
+
+	// Create Session
+	svc, err := KinesisAnalyticsV2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kinesisanalyticsv2_application_version.getKinesisAnalyticsV2ApplicationVersion", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &kinesisanalyticsv2.DescribeApplicationVersionInput{
+		ApplicationName:      version.ApplicationName,
+		ApplicationVersionId: version.ApplicationVersionId,
+	}
+
+	// Get call
+	data, err := svc.DescribeApplicationVersion(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_kinesisanalyticsv2_application_version.getKinesisAnalyticsV2ApplicationVersion", "api_error", err)
+		return nil, err
+	}
+
+	return data.ApplicationVersionDetail, nil
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLakeFormationLFTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lakeformation_lf_tag",
+		Description: "AWS Lake Formation LF-Tag",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("tag_key"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+			Hydrate: getLakeFormationLFTag,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLakeFormationLFTags,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "catalog_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "tag_key",
+				Description: "The key-name for the LF-tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "catalog_id",
+				Description: "The identifier for the Data Catalog where the LF-tag is defined.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tag_values",
+				Description: "A list of possible values an attribute can take.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TagKey"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLakeFormationLFTags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_lf_tag.listLakeFormationLFTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &lakeformation.ListLFTagsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["catalog_id"] != nil {
+		input.CatalogId = aws.String(d.KeyColumnQuals["catalog_id"].GetStringValue())
+	}
+
+	paginator := lakeformation.NewListLFTagsPaginator(svc, input, func(o *lakeformation.ListLFTagsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lakeformation_lf_tag.listLakeFormationLFTags", "api_error", err)
+			return nil, err
+		}
+
+		for _, tag := range output.LFTags {
+			d.StreamListItem(ctx, tag)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLakeFormationLFTag(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	tagKey := d.KeyColumnQuals["tag_key"].GetStringValue()
+
+	// Empty check
+	if tagKey == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_lf_tag.getLakeFormationLFTag", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &lakeformation.GetLFTagInput{
+		TagKey: aws.String(tagKey),
+	}
+
+	op, err := svc.GetLFTag(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_lf_tag.getLakeFormationLFTag", "api_error", err)
+		return nil, err
+	}
+
+	return types.LFTagPair{
+		CatalogId: op.CatalogId,
+		TagKey:    op.TagKey,
+		TagValues: op.TagValues,
+	}, nil
+}
@@ -0,0 +1,282 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLakeFormationPermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lakeformation_permission",
+		Description: "AWS Lake Formation Permission",
+		List: &plugin.ListConfig{
+			Hydrate: listLakeFormationPermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "principal_identifier", Require: plugin.Optional},
+				{Name: "resource_type", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "principal_identifier",
+				Description: "An identifier for the Lake Formation principal, such as an IAM user or role ARN, a SAML group or an account ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal.DataLakePrincipalIdentifier"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of resource the permission applies to, such as CATALOG, DATABASE, TABLE, DATA_LOCATION, LF_TAG or LF_TAG_POLICY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource").Transform(lakeFormationResourceType),
+			},
+			{
+				Name:        "catalog_id",
+				Description: "The identifier for the Data Catalog that contains the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource").Transform(lakeFormationResourceCatalogId),
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database the permission applies to, for database and table resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource").Transform(lakeFormationResourceDatabaseName),
+			},
+			{
+				Name:        "table_name",
+				Description: "The name of the table the permission applies to, for table resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource").Transform(lakeFormationResourceTableName),
+			},
+			{
+				Name:        "data_location_arn",
+				Description: "The Amazon Resource Name (ARN) of the data location the permission applies to, for data location resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.DataLocation.ResourceArn"),
+			},
+			{
+				Name:        "permissions",
+				Description: "The permissions granted to the principal on the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "permissions_with_grant_option",
+				Description: "The permissions that the principal can grant to other principals.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "grantable",
+				Description: "True if the principal can grant any of its permissions on the resource to other principals.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PermissionsWithGrantOption").Transform(lakeFormationPermissionGrantable),
+			},
+			{
+				Name:        "last_updated",
+				Description: "The date and time when the permission was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_by",
+				Description: "The user who updated the permission.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource the permission applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_details",
+				Description: "Additional details about a cross-account permission, such as the AWS RAM resource share.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "condition",
+				Description: "A Lake Formation condition, which applies to permissions and opt-ins that contain an expression.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal.DataLakePrincipalIdentifier"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLakeFormationPermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_permission.listLakeFormationPermissions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["principal_identifier"] != nil {
+		input.Principal = &types.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(equalQuals["principal_identifier"].GetStringValue()),
+		}
+	}
+	if equalQuals["resource_type"] != nil {
+		input.ResourceType = types.DataLakeResourceType(equalQuals["resource_type"].GetStringValue())
+	}
+
+	paginator := lakeformation.NewListPermissionsPaginator(svc, input, func(o *lakeformation.ListPermissionsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lakeformation_permission.listLakeFormationPermissions", "api_error", err)
+			return nil, err
+		}
+
+		for _, permission := range output.PrincipalResourcePermissions {
+			d.StreamListItem(ctx, permission)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func lakeFormationResourceType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource, ok := d.Value.(*types.Resource)
+	if !ok || resource == nil {
+		return nil, nil
+	}
+
+	switch {
+	case resource.Catalog != nil:
+		return "CATALOG", nil
+	case resource.Database != nil:
+		return "DATABASE", nil
+	case resource.Table != nil, resource.TableWithColumns != nil:
+		return "TABLE", nil
+	case resource.DataLocation != nil:
+		return "DATA_LOCATION", nil
+	case resource.DataCellsFilter != nil:
+		return "DATA_CELLS_FILTER", nil
+	case resource.LFTag != nil:
+		return "LF_TAG", nil
+	case resource.LFTagPolicy != nil:
+		return "LF_TAG_POLICY", nil
+	}
+	return nil, nil
+}
+
+func lakeFormationResourceCatalogId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource, ok := d.Value.(*types.Resource)
+	if !ok || resource == nil {
+		return nil, nil
+	}
+
+	switch {
+	case resource.Database != nil:
+		return resource.Database.CatalogId, nil
+	case resource.Table != nil:
+		return resource.Table.CatalogId, nil
+	case resource.TableWithColumns != nil:
+		return resource.TableWithColumns.CatalogId, nil
+	case resource.DataLocation != nil:
+		return resource.DataLocation.CatalogId, nil
+	case resource.DataCellsFilter != nil:
+		return resource.DataCellsFilter.TableCatalogId, nil
+	case resource.LFTag != nil:
+		return resource.LFTag.CatalogId, nil
+	case resource.LFTagPolicy != nil:
+		return resource.LFTagPolicy.CatalogId, nil
+	}
+	return nil, nil
+}
+
+func lakeFormationResourceDatabaseName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource, ok := d.Value.(*types.Resource)
+	if !ok || resource == nil {
+		return nil, nil
+	}
+
+	switch {
+	case resource.Database != nil:
+		return resource.Database.Name, nil
+	case resource.Table != nil:
+		return resource.Table.DatabaseName, nil
+	case resource.TableWithColumns != nil:
+		return resource.TableWithColumns.DatabaseName, nil
+	case resource.DataCellsFilter != nil:
+		return resource.DataCellsFilter.DatabaseName, nil
+	}
+	return nil, nil
+}
+
+func lakeFormationResourceTableName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resource, ok := d.Value.(*types.Resource)
+	if !ok || resource == nil {
+		return nil, nil
+	}
+
+	switch {
+	case resource.Table != nil:
+		return resource.Table.Name, nil
+	case resource.TableWithColumns != nil:
+		return resource.TableWithColumns.Name, nil
+	case resource.DataCellsFilter != nil:
+		return resource.DataCellsFilter.TableName, nil
+	}
+	return nil, nil
+}
+
+func lakeFormationPermissionGrantable(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissions, ok := d.Value.([]types.Permission)
+	if !ok {
+		return false, nil
+	}
+	return len(permissions) > 0, nil
+}
@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLakeFormationResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_lakeformation_resource",
+		Description: "AWS Lake Formation Resource",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("resource_arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"EntityNotFoundException", "InvalidInputException"}),
+			},
+			Hydrate: getLakeFormationResource,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLakeFormationResources,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the registered data location.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The IAM role that Lake Formation uses to access the registered location.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time the resource was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "with_federation",
+				Description: "Whether the data location is registered with Lake Formation for data federation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "hybrid_access_enabled",
+				Description: "Whether the data access of tables pointing to the location can be managed by both Lake Formation permissions as well as Amazon S3 bucket policies.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceArn"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLakeFormationResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_resource.listLakeFormationResources", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &lakeformation.ListResourcesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := lakeformation.NewListResourcesPaginator(svc, input, func(o *lakeformation.ListResourcesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_lakeformation_resource.listLakeFormationResources", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range output.ResourceInfoList {
+			d.StreamListItem(ctx, resource)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLakeFormationResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceArn := d.KeyColumnQuals["resource_arn"].GetStringValue()
+
+	// Empty check
+	if resourceArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := LakeFormationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_resource.getLakeFormationResource", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &lakeformation.DescribeResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	op, err := svc.DescribeResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_lakeformation_resource.getLakeFormationResource", "api_error", err)
+		return nil, err
+	}
+
+	if op.ResourceInfo == nil {
+		return nil, nil
+	}
+	return *op.ResourceInfo, nil
+}
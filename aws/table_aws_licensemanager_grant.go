@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type licenseManagerGrantInfo struct {
+	types.Grant
+	GrantDirection string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsLicenseManagerGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_licensemanager_grant",
+		Description: "AWS License Manager Grant",
+		List: &plugin.ListConfig{
+			Hydrate: listLicenseManagerGrants,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "grant_direction", Require: plugin.Optional},
+				{Name: "license_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "grant_name",
+				Description: "Grant name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "Amazon Resource Name (ARN) of the grant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GrantArn"),
+			},
+			{
+				Name:        "grant_direction",
+				Description: "Whether the grant was distributed by this account (DISTRIBUTED) or received by it (RECEIVED).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grant_status",
+				Description: "Grant status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_reason",
+				Description: "Grant status reason.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_arn",
+				Description: "License ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_arn",
+				Description: "Parent ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grantee_principal_arn",
+				Description: "The grantee principal ARN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "home_region",
+				Description: "Home Region of the grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "Grant version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "granted_operations",
+				Description: "Granted operations.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GrantName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLicenseManagerResourceTags,
+				Transform:   transform.FromField("Tags").Transform(licenseManagerTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GrantArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLicenseManagerGrants(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := LicenseManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_licensemanager_grant.listLicenseManagerGrants", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	var filters []types.Filter
+	if licenseArn := d.KeyColumnQualString("license_arn"); licenseArn != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("LicenseArn"),
+			Values: []string{licenseArn},
+		})
+	}
+
+	direction := d.KeyColumnQualString("grant_direction")
+
+	// Grants distributed by this account
+	if direction == "" || direction == "DISTRIBUTED" {
+		input := &licensemanager.ListDistributedGrantsInput{
+			MaxResults: aws.Int32(maxLimit),
+			Filters:    filters,
+		}
+
+		// API doesn't support aws-sdk-go-v2 paginator as of date.
+		pagesLeft := true
+
+		for pagesLeft {
+			result, err := svc.ListDistributedGrants(ctx, input)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_licensemanager_grant.listLicenseManagerGrants", "api_error", err)
+				return nil, err
+			}
+
+			for _, item := range result.Grants {
+				d.StreamListItem(ctx, licenseManagerGrantInfo{item, "DISTRIBUTED"})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if result.NextToken != nil {
+				input.NextToken = result.NextToken
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	// Grants received by this account
+	if direction == "" || direction == "RECEIVED" {
+		input := &licensemanager.ListReceivedGrantsInput{
+			MaxResults: aws.Int32(maxLimit),
+			Filters:    filters,
+		}
+
+		// API doesn't support aws-sdk-go-v2 paginator as of date.
+		pagesLeft := true
+
+		for pagesLeft {
+			result, err := svc.ListReceivedGrants(ctx, input)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_licensemanager_grant.listLicenseManagerGrants", "api_error", err)
+				return nil, err
+			}
+
+			for _, item := range result.Grants {
+				d.StreamListItem(ctx, licenseManagerGrantInfo{item, "RECEIVED"})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if result.NextToken != nil {
+				input.NextToken = result.NextToken
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLicenseManagerLicense(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_licensemanager_license",
+		Description: "AWS License Manager License",
+		List: &plugin.ListConfig{
+			Hydrate: listLicenseManagerLicenses,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "product_sku", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "license_name",
+				Description: "License name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "Amazon Resource Name (ARN) of the license.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LicenseArn"),
+			},
+			{
+				Name:        "product_name",
+				Description: "Product name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_sku",
+				Description: "Product SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProductSKU"),
+			},
+			{
+				Name:        "status",
+				Description: "License status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "beneficiary",
+				Description: "License beneficiary.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "home_region",
+				Description: "Home Region of the license.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuer_name",
+				Description: "Issuer name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Issuer.Name"),
+			},
+			{
+				Name:        "version",
+				Description: "License version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "License creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "validity_begin",
+				Description: "Start of the time range during which the license is valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Validity.Begin"),
+			},
+			{
+				Name:        "validity_end",
+				Description: "End of the time range during which the license is valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Validity.End"),
+			},
+			{
+				Name:        "consumption_configuration",
+				Description: "Configuration for consumption of the license.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "entitlements",
+				Description: "License entitlements.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issuer",
+				Description: "License issuer.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "license_metadata",
+				Description: "License metadata.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LicenseName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLicenseManagerResourceTags,
+				Transform:   transform.FromField("Tags").Transform(licenseManagerTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LicenseArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLicenseManagerLicenses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := LicenseManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_licensemanager_license.listLicenseManagerLicenses", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &licensemanager.ListLicensesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		input.LicenseArns = []string{arn}
+	}
+
+	var filters []types.Filter
+	if sku := d.KeyColumnQualString("product_sku"); sku != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("ProductSKU"),
+			Values: []string{sku},
+		})
+	}
+	if status := d.KeyColumnQualString("status"); status != "" {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("Status"),
+			Values: []string{status},
+		})
+	}
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListLicenses(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_licensemanager_license.listLicenseManagerLicenses", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Licenses {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsLicenseManagerLicenseConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_licensemanager_license_configuration",
+		Description: "AWS License Manager License Configuration",
+		List: &plugin.ListConfig{
+			Hydrate: listLicenseManagerLicenseConfigurations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "arn", Require: plugin.Optional},
+				{Name: "license_counting_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "Name of the license configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_configuration_id",
+				Description: "Unique ID of the license configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "Amazon Resource Name (ARN) of the license configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LicenseConfigurationArn"),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the license configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Status of the license configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_counting_type",
+				Description: "Dimension to use to track the license inventory. Possible values are vCPU, Instance, Core and Socket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_count",
+				Description: "Number of licenses managed by the license configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "license_count_hard_limit",
+				Description: "Number of available licenses as a hard limit.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "consumed_licenses",
+				Description: "Number of licenses consumed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "Account ID of the license configuration's owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disassociate_when_not_found",
+				Description: "When true, disassociates a resource when software is uninstalled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "automated_discovery_information",
+				Description: "Automated discovery information.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "consumed_license_summary_list",
+				Description: "Summaries for licenses consumed by various resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "license_rules",
+				Description: "License rules.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "managed_resource_summary_list",
+				Description: "Summaries for managed resources.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "product_information_list",
+				Description: "Product information.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the license configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLicenseManagerResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getLicenseManagerResourceTags,
+				Transform:   transform.FromField("Tags").Transform(licenseManagerTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LicenseConfigurationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLicenseManagerLicenseConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := LicenseManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_licensemanager_license_configuration.listLicenseManagerLicenseConfigurations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &licensemanager.ListLicenseConfigurationsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if arn := d.KeyColumnQualString("arn"); arn != "" {
+		input.LicenseConfigurationArns = []string{arn}
+	}
+	if countingType := d.KeyColumnQualString("license_counting_type"); countingType != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   aws.String("licenseCountingType"),
+				Values: []string{countingType},
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListLicenseConfigurations(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_licensemanager_license_configuration.listLicenseManagerLicenseConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.LicenseConfigurations {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getLicenseManagerResourceTags is shared by the License Manager tables
+func getLicenseManagerResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.LicenseConfiguration:
+		arn = item.LicenseConfigurationArn
+	case types.License:
+		arn = item.LicenseArn
+	case licenseManagerGrantInfo:
+		arn = item.GrantArn
+	}
+
+	// Create session
+	svc, err := LicenseManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_licensemanager.getLicenseManagerResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &licensemanager.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_licensemanager.getLicenseManagerResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func licenseManagerTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.Value.([]types.Tag)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tagList != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceagreement"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceagreement/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMarketplaceAgreement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_marketplace_agreement",
+		Description: "AWS Marketplace Agreement",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("agreement_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getMarketplaceAgreement,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMarketplaceAgreements,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "party_type", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "agreement_type", Require: plugin.Optional},
+				{Name: "offer_id", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "agreement_id",
+				Description: "The unique identifier of the agreement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "agreement_type",
+				Description: "The type of agreement, for example PurchaseAgreement or VendorInsightsAgreement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the agreement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "party_type",
+				Description: "The party type of the caller in the agreement, either Acceptor or Proposer. Defaults to Acceptor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("party_type"),
+			},
+			{
+				Name:        "acceptance_time",
+				Description: "The date and time that the agreement was accepted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time when the agreement starts.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The date and time when the agreement ends.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "acceptor_account_id",
+				Description: "The AWS account ID of the acceptor of the agreement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Acceptor.AccountId"),
+			},
+			{
+				Name:        "proposer_account_id",
+				Description: "The AWS account ID of the proposer of the agreement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Proposer.AccountId"),
+			},
+			{
+				Name:        "offer_id",
+				Description: "The unique identifier of the offer the agreement was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProposalSummary.OfferId"),
+			},
+			{
+				Name:        "resources",
+				Description: "The products that the agreement covers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProposalSummary.Resources"),
+			},
+			{
+				Name:        "estimated_charges",
+				Description: "The estimated cost of the agreement.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMarketplaceAgreement,
+			},
+			{
+				Name:        "accepted_terms",
+				Description: "The terms accepted as part of the agreement, such as the pricing dimensions, the payment schedule and the renewal terms.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMarketplaceAgreementTerms,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AgreementId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMarketplaceAgreements(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := MarketplaceAgreementClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_marketplace_agreement.listMarketplaceAgreements", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(50)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The PartyType filter is required, list the agreements accepted by the account by default
+	partyType := "Acceptor"
+	if d.KeyColumnQuals["party_type"] != nil {
+		partyType = d.KeyColumnQuals["party_type"].GetStringValue()
+	}
+
+	input := &marketplaceagreement.SearchAgreementsInput{
+		Catalog:    aws.String("AWSMarketplace"),
+		MaxResults: aws.Int32(maxLimit),
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("PartyType"),
+				Values: []string{partyType},
+			},
+		},
+	}
+
+	filterQuals := map[string]string{
+		"status":         "Status",
+		"agreement_type": "AgreementType",
+		"offer_id":       "OfferId",
+	}
+	for columnName, filterName := range filterQuals {
+		if d.KeyColumnQuals[columnName] != nil {
+			input.Filters = append(input.Filters, types.Filter{
+				Name:   aws.String(filterName),
+				Values: []string{d.KeyColumnQuals[columnName].GetStringValue()},
+			})
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		output, err := svc.SearchAgreements(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_marketplace_agreement.listMarketplaceAgreements", "api_error", err)
+			return nil, err
+		}
+
+		for _, agreement := range output.AgreementViewSummaries {
+			d.StreamListItem(ctx, agreement)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMarketplaceAgreement(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var agreementId string
+	if h.Item != nil {
+		agreementId = *h.Item.(types.AgreementViewSummary).AgreementId
+	} else {
+		agreementId = d.KeyColumnQuals["agreement_id"].GetStringValue()
+	}
+
+	// Empty check
+	if agreementId == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := MarketplaceAgreementClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_marketplace_agreement.getMarketplaceAgreement", "connection_error", err)
+		return nil, err
+	}
+
+	params := &marketplaceagreement.DescribeAgreementInput{
+		AgreementId: aws.String(agreementId),
+	}
+
+	op, err := svc.DescribeAgreement(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_marketplace_agreement.getMarketplaceAgreement", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getMarketplaceAgreementTerms(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var agreementId string
+	switch item := h.Item.(type) {
+	case types.AgreementViewSummary:
+		agreementId = *item.AgreementId
+	case *marketplaceagreement.DescribeAgreementOutput:
+		agreementId = *item.AgreementId
+	}
+
+	// Create session
+	svc, err := MarketplaceAgreementClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_marketplace_agreement.getMarketplaceAgreementTerms", "connection_error", err)
+		return nil, err
+	}
+
+	params := &marketplaceagreement.GetAgreementTermsInput{
+		AgreementId: aws.String(agreementId),
+		MaxResults:  aws.Int32(50),
+	}
+
+	paginator := marketplaceagreement.NewGetAgreementTermsPaginator(svc, params, func(o *marketplaceagreement.GetAgreementTermsPaginatorOptions) {
+		o.Limit = 50
+		o.StopOnDuplicateToken = true
+	})
+
+	var terms []types.AcceptedTerm
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_marketplace_agreement.getMarketplaceAgreementTerms", "api_error", err)
+			return nil, err
+		}
+		terms = append(terms, output.AcceptedTerms...)
+	}
+
+	return terms, nil
+}
@@ -0,0 +1,131 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceentitlementservice"
+	"github.com/aws/aws-sdk-go-v2/service/marketplaceentitlementservice/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMarketplaceEntitlement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_marketplace_entitlement",
+		Description: "AWS Marketplace Entitlement",
+		List: &plugin.ListConfig{
+			Hydrate: listMarketplaceEntitlements,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "product_code", Require: plugin.Required},
+				{Name: "customer_identifier", Require: plugin.Optional},
+				{Name: "dimension", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "product_code",
+				Description: "The product code for which the given entitlement applies. Product codes are provided by AWS Marketplace when the product listing is created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer_identifier",
+				Description: "The customer identifier is a handle to each unique customer in an application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimension",
+				Description: "The dimension for which the given entitlement applies. Dimensions represent categories of capacity in a product and are specified when the product is listed in AWS Marketplace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The expiration date represents the minimum date through which this entitlement is expected to remain valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "The EntitlementValue represents the amount of capacity that the customer is entitled to for the product.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Dimension"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMarketplaceEntitlements(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	productCode := d.KeyColumnQuals["product_code"].GetStringValue()
+
+	// Create session
+	svc, err := MarketplaceEntitlementClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_marketplace_entitlement.listMarketplaceEntitlements", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(25)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &marketplaceentitlementservice.GetEntitlementsInput{
+		ProductCode: aws.String(productCode),
+		MaxResults:  aws.Int32(maxLimit),
+	}
+
+	filter := map[string][]string{}
+	if customer := d.KeyColumnQualString("customer_identifier"); customer != "" {
+		filter[string(types.GetEntitlementFilterNameCustomerIdentifier)] = []string{customer}
+	}
+	if dimension := d.KeyColumnQualString("dimension"); dimension != "" {
+		filter[string(types.GetEntitlementFilterNameDimension)] = []string{dimension}
+	}
+	if len(filter) > 0 {
+		input.Filter = filter
+	}
+
+	paginator := marketplaceentitlementservice.NewGetEntitlementsPaginator(svc, input, func(o *marketplaceentitlementservice.GetEntitlementsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_marketplace_entitlement.listMarketplaceEntitlements", "api_error", err)
+			return nil, err
+		}
+
+		for _, entitlement := range output.Entitlements {
+			d.StreamListItem(ctx, entitlement)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaConvertJobTemplate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mediaconvert_job_template",
+		Description: "AWS MediaConvert Job Template",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getMediaConvertJobTemplate,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaConvertJobTemplates,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "category", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the job template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "A job template can be of two types: system or custom.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "An optional category you create to organize your job templates.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description you create for each job template.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "queue",
+				Description: "The queue that jobs created from this template are submitted to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "Relative priority on the job.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "status_update_interval",
+				Description: "Specify how often MediaConvert sends STATUS_UPDATE events to CloudWatch Events.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp in epoch seconds for job template creation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated",
+				Description: "The timestamp in epoch seconds when the job template was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "acceleration_settings",
+				Description: "Accelerated transcoding settings for jobs created from this template.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "hop_destinations",
+				Description: "Optional list of hop destinations.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "settings",
+				Description: "The transcoding settings for jobs created from this template, including output group destination and encryption settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMediaConvertResourceTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaConvertJobTemplates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaConvertClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_job_template.listMediaConvertJobTemplates", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(20)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &mediaconvert.ListJobTemplatesInput{}
+	if d.KeyColumnQuals["category"] != nil {
+		input.Category = aws.String(d.KeyColumnQuals["category"].GetStringValue())
+	}
+
+	paginator := mediaconvert.NewListJobTemplatesPaginator(svc, input, func(o *mediaconvert.ListJobTemplatesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mediaconvert_job_template.listMediaConvertJobTemplates", "api_error", err)
+			return nil, err
+		}
+
+		for _, template := range output.JobTemplates {
+			d.StreamListItem(ctx, template)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaConvertJobTemplate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MediaConvertClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_job_template.getMediaConvertJobTemplate", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mediaconvert.GetJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetJobTemplate(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_job_template.getMediaConvertJobTemplate", "api_error", err)
+		return nil, err
+	}
+
+	if op.JobTemplate == nil {
+		return nil, nil
+	}
+
+	return *op.JobTemplate, nil
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaConvertQueue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mediaconvert_queue",
+		Description: "AWS MediaConvert Queue",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getMediaConvertQueue,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaConvertQueues,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Queues can be ACTIVE or PAUSED. If you pause a queue, jobs in that queue won't begin.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies whether this on-demand queue is system or custom.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pricing_plan",
+				Description: "Specifies whether the pricing plan for the queue is on-demand or reserved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "An optional description of the queue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp in epoch seconds for when the queue was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated",
+				Description: "The timestamp in epoch seconds for when the queue was most recently updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "progressing_jobs_count",
+				Description: "The estimated number of jobs with a PROGRESSING status.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "submitted_jobs_count",
+				Description: "The estimated number of jobs with a SUBMITTED status.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "reservation_plan",
+				Description: "Details about the pricing plan for a reserved queue.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMediaConvertResourceTags,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaConvertQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaConvertClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_queue.listMediaConvertQueues", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(20)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &mediaconvert.ListQueuesInput{}
+
+	paginator := mediaconvert.NewListQueuesPaginator(svc, input, func(o *mediaconvert.ListQueuesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mediaconvert_queue.listMediaConvertQueues", "api_error", err)
+			return nil, err
+		}
+
+		for _, queue := range output.Queues {
+			d.StreamListItem(ctx, queue)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaConvertQueue(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MediaConvertClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_queue.getMediaConvertQueue", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mediaconvert.GetQueueInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetQueue(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediaconvert_queue.getMediaConvertQueue", "api_error", err)
+		return nil, err
+	}
+
+	if op.Queue == nil {
+		return nil, nil
+	}
+
+	return *op.Queue, nil
+}
+
+// getMediaConvertResourceTags is shared by the MediaConvert queue and job
+// template tables
+func getMediaConvertResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.Queue:
+		arn = *item.Arn
+	case types.JobTemplate:
+		arn = *item.Arn
+	}
+
+	// Create Session
+	svc, err := MediaConvertClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMediaConvertResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mediaconvert.ListTagsForResourceInput{
+		Arn: aws.String(arn),
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMediaConvertResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	if op.ResourceTags == nil {
+		return nil, nil
+	}
+
+	return op.ResourceTags.Tags, nil
+}
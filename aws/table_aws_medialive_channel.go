@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaLiveChannel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_medialive_channel",
+		Description: "AWS MediaLive Channel",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMediaLiveChannel,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaLiveChannels,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the channel, e.g. IDLE, RUNNING or DELETED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "channel_class",
+				Description: "The class for the channel, STANDARD for a channel with two pipelines or SINGLE_PIPELINE for a channel with one pipeline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "log_level",
+				Description: "The log level being written to CloudWatch Logs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pipelines_running_count",
+				Description: "The number of currently healthy pipelines.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the role assumed when running the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cdi_input_specification",
+				Description: "Specification of CDI inputs for the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "destinations",
+				Description: "A list of destinations of the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "egress_endpoints",
+				Description: "The endpoints where outgoing connections initiate from.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "encoder_settings",
+				Description: "The encoder settings for the channel, including output groups and their encryption settings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeMediaLiveChannel,
+			},
+			{
+				Name:        "input_attachments",
+				Description: "List of input attachments for the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "input_specification",
+				Description: "Specification of network and file inputs for the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pipeline_details",
+				Description: "Runtime details for the pipelines of a running channel.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeMediaLiveChannel,
+			},
+			{
+				Name:        "vpc",
+				Description: "Settings for any VPC outputs of the channel.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaLiveChannels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaLiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_channel.listMediaLiveChannels", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &medialive.ListChannelsInput{}
+
+	paginator := medialive.NewListChannelsPaginator(svc, input, func(o *medialive.ListChannelsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_medialive_channel.listMediaLiveChannels", "api_error", err)
+			return nil, err
+		}
+
+		for _, channel := range output.Channels {
+			d.StreamListItem(ctx, channel)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaLiveChannel(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	op, err := getMediaLiveChannelDetails(ctx, d, id)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return types.ChannelSummary{
+		Arn:                   op.Arn,
+		CdiInputSpecification: op.CdiInputSpecification,
+		ChannelClass:          op.ChannelClass,
+		Destinations:          op.Destinations,
+		EgressEndpoints:       op.EgressEndpoints,
+		Id:                    op.Id,
+		InputAttachments:      op.InputAttachments,
+		InputSpecification:    op.InputSpecification,
+		LogLevel:              op.LogLevel,
+		Name:                  op.Name,
+		PipelinesRunningCount: op.PipelinesRunningCount,
+		RoleArn:               op.RoleArn,
+		State:                 op.State,
+		Tags:                  op.Tags,
+		Vpc:                   op.Vpc,
+	}, nil
+}
+
+// describeMediaLiveChannel returns the encoder settings and pipeline details,
+// which are not included in the list response
+func describeMediaLiveChannel(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.ChannelSummary).Id
+
+	op, err := getMediaLiveChannelDetails(ctx, d, *id)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getMediaLiveChannelDetails(ctx context.Context, d *plugin.QueryData, id string) (*medialive.DescribeChannelOutput, error) {
+	// Create Session
+	svc, err := MediaLiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_channel.getMediaLiveChannelDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &medialive.DescribeChannelInput{
+		ChannelId: aws.String(id),
+	}
+
+	op, err := svc.DescribeChannel(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_channel.getMediaLiveChannelDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,234 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaLiveInput(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_medialive_input",
+		Description: "AWS MediaLive Input",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMediaLiveInput,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaLiveInputs,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The user-assigned name of the input.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The generated ID of the input.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the input.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the input, e.g. CREATING, DETACHED, ATTACHED or DELETED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the input, e.g. RTMP_PUSH, RTP_PUSH, URL_PULL, MP4_FILE or MEDIACONNECT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "input_class",
+				Description: "STANDARD if the input has two sources or SINGLE_PIPELINE if it has one.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "input_source_type",
+				Description: "Certain pull input sources can be dynamic, meaning that they can have their URLs swapped out as the channel runs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the role this input assumes during and after creation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "attached_channels",
+				Description: "A list of channel IDs that the input is attached to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_groups",
+				Description: "A list of IDs for the input security groups attached to the input.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "destinations",
+				Description: "A list of the destinations of the input (PUSH-type).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sources",
+				Description: "A list of the sources of the input (PULL-type).",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "input_devices",
+				Description: "Settings for the input devices.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "input_partner_ids",
+				Description: "A list of IDs for all inputs that are partners of this input.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "media_connect_flows",
+				Description: "A list of MediaConnect flows for the input.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name", "Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaLiveInputs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaLiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_input.listMediaLiveInputs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &medialive.ListInputsInput{}
+
+	paginator := medialive.NewListInputsPaginator(svc, input, func(o *medialive.ListInputsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_medialive_input.listMediaLiveInputs", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Inputs {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaLiveInput(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MediaLiveClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_input.getMediaLiveInput", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &medialive.DescribeInputInput{
+		InputId: aws.String(id),
+	}
+
+	op, err := svc.DescribeInput(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_medialive_input.getMediaLiveInput", "api_error", err)
+		return nil, err
+	}
+
+	return types.Input{
+		Arn:               op.Arn,
+		AttachedChannels:  op.AttachedChannels,
+		Destinations:      op.Destinations,
+		Id:                op.Id,
+		InputClass:        op.InputClass,
+		InputDevices:      op.InputDevices,
+		InputPartnerIds:   op.InputPartnerIds,
+		InputSourceType:   op.InputSourceType,
+		MediaConnectFlows: op.MediaConnectFlows,
+		Name:              op.Name,
+		RoleArn:           op.RoleArn,
+		SecurityGroups:    op.SecurityGroups,
+		Sources:           op.Sources,
+		State:             op.State,
+		Tags:              op.Tags,
+		Type:              op.Type,
+	}, nil
+}
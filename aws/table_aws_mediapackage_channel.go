@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaPackageChannel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mediapackage_channel",
+		Description: "AWS MediaPackage Channel",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getMediaPackageChannel,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaPackageChannels,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A short text description of the channel.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time the channel was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "egress_access_logs_group_name",
+				Description: "The name of the CloudWatch log group egress access logs are written to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EgressAccessLogs.LogGroupName"),
+			},
+			{
+				Name:        "ingress_access_logs_group_name",
+				Description: "The name of the CloudWatch log group ingress access logs are written to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IngressAccessLogs.LogGroupName"),
+			},
+			{
+				Name:        "hls_ingest_endpoints",
+				Description: "The ID and URL of each HLS ingest endpoint of the channel. Ingest credentials are not included.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HlsIngest").Transform(mediaPackageHlsIngestEndpoints),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaPackageChannels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaPackageClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_channel.listMediaPackageChannels", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &mediapackage.ListChannelsInput{}
+
+	paginator := mediapackage.NewListChannelsPaginator(svc, input, func(o *mediapackage.ListChannelsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mediapackage_channel.listMediaPackageChannels", "api_error", err)
+			return nil, err
+		}
+
+		for _, channel := range output.Channels {
+			d.StreamListItem(ctx, channel)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaPackageChannel(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MediaPackageClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_channel.getMediaPackageChannel", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mediapackage.DescribeChannelInput{
+		Id: aws.String(id),
+	}
+
+	op, err := svc.DescribeChannel(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_channel.getMediaPackageChannel", "api_error", err)
+		return nil, err
+	}
+
+	return types.Channel{
+		Arn:               op.Arn,
+		CreatedAt:         op.CreatedAt,
+		Description:       op.Description,
+		EgressAccessLogs:  op.EgressAccessLogs,
+		HlsIngest:         op.HlsIngest,
+		Id:                op.Id,
+		IngressAccessLogs: op.IngressAccessLogs,
+		Tags:              op.Tags,
+	}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// mediaPackageHlsIngestEndpoints drops the ingest username and password so
+// that credentials are never returned in query results
+func mediaPackageHlsIngestEndpoints(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	hlsIngest, ok := d.Value.(*types.HlsIngest)
+	if !ok || hlsIngest == nil {
+		return nil, nil
+	}
+
+	var endpoints []map[string]string
+	for _, endpoint := range hlsIngest.IngestEndpoints {
+		endpoints = append(endpoints, map[string]string{
+			"Id":  aws.ToString(endpoint.Id),
+			"Url": aws.ToString(endpoint.Url),
+		})
+	}
+
+	return endpoints, nil
+}
@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMediaPackageOriginEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mediapackage_origin_endpoint",
+		Description: "AWS MediaPackage Origin Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getMediaPackageOriginEndpoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaPackageOriginEndpoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "channel_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the origin endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the origin endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "channel_id",
+				Description: "The ID of the channel the origin endpoint is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A short text description of the origin endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "url",
+				Description: "The URL of the packaged origin endpoint for consumption.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "origination",
+				Description: "Control whether origination of video is allowed for this origin endpoint, ALLOW or DENY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "manifest_name",
+				Description: "A short string appended to the end of the origin endpoint URL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time the origin endpoint was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "startover_window_seconds",
+				Description: "Maximum duration (seconds) of content to retain for startover playback.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "time_delay_seconds",
+				Description: "Amount of delay (seconds) to enforce on the playback of live content.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "authorization",
+				Description: "CDN authorization settings for the origin endpoint. Empty if CDN authorization is not enabled.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "whitelist",
+				Description: "A list of source IP CIDR blocks that are allowed to access the origin endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cmaf_package",
+				Description: "A Common Media Application Format (CMAF) packaging configuration, including encryption settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "dash_package",
+				Description: "A Dynamic Adaptive Streaming over HTTP (DASH) packaging configuration, including encryption settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "hls_package",
+				Description: "An HTTP Live Streaming (HLS) packaging configuration, including encryption settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "mss_package",
+				Description: "A Microsoft Smooth Streaming (MSS) packaging configuration, including encryption settings.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaPackageOriginEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MediaPackageClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_origin_endpoint.listMediaPackageOriginEndpoints", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &mediapackage.ListOriginEndpointsInput{}
+	if d.KeyColumnQuals["channel_id"] != nil {
+		input.ChannelId = aws.String(d.KeyColumnQuals["channel_id"].GetStringValue())
+	}
+
+	paginator := mediapackage.NewListOriginEndpointsPaginator(svc, input, func(o *mediapackage.ListOriginEndpointsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mediapackage_origin_endpoint.listMediaPackageOriginEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, endpoint := range output.OriginEndpoints {
+			d.StreamListItem(ctx, endpoint)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaPackageOriginEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MediaPackageClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_origin_endpoint.getMediaPackageOriginEndpoint", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mediapackage.DescribeOriginEndpointInput{
+		Id: aws.String(id),
+	}
+
+	op, err := svc.DescribeOriginEndpoint(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mediapackage_origin_endpoint.getMediaPackageOriginEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	return types.OriginEndpoint{
+		Arn:                    op.Arn,
+		Authorization:          op.Authorization,
+		ChannelId:              op.ChannelId,
+		CmafPackage:            op.CmafPackage,
+		CreatedAt:              op.CreatedAt,
+		DashPackage:            op.DashPackage,
+		Description:            op.Description,
+		HlsPackage:             op.HlsPackage,
+		Id:                     op.Id,
+		ManifestName:           op.ManifestName,
+		MssPackage:             op.MssPackage,
+		Origination:            op.Origination,
+		StartoverWindowSeconds: op.StartoverWindowSeconds,
+		Tags:                   op.Tags,
+		TimeDelaySeconds:       op.TimeDelaySeconds,
+		Url:                    op.Url,
+		Whitelist:              op.Whitelist,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMemoryDBACL(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_memorydb_acl",
+		Description: "AWS MemoryDB Access Control List",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ACLNotFoundFault", "InvalidParameterValueException"}),
+			},
+			Hydrate: getMemoryDBACL,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMemoryDBACLs,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Access Control List.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Access Control List.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "status",
+				Description: "Indicates ACL status. Can be creating, active, modifying or deleting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "minimum_engine_version",
+				Description: "The minimum engine version supported for the ACL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_names",
+				Description: "The list of user names that belong to the ACL.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "clusters",
+				Description: "A list of clusters associated with the ACL.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_changes",
+				Description: "A list of updates being applied to the ACL, i.e. the user names being added or removed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the ACL.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.From(memoryDBTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMemoryDBACLs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_acl.listMemoryDBACLs", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &memorydb.DescribeACLsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// DescribeACLs has no paginator in the SDK, so page through the results manually
+	for {
+		output, err := svc.DescribeACLs(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_memorydb_acl.listMemoryDBACLs", "api_error", err)
+			return nil, err
+		}
+
+		for _, acl := range output.ACLs {
+			d.StreamListItem(ctx, acl)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMemoryDBACL(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_acl.getMemoryDBACL", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &memorydb.DescribeACLsInput{
+		ACLName: aws.String(name),
+	}
+
+	op, err := svc.DescribeACLs(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_acl.getMemoryDBACL", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.ACLs) > 0 {
+		return op.ACLs[0], nil
+	}
+	return nil, nil
+}
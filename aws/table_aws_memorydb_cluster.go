@@ -0,0 +1,352 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMemoryDBCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_memorydb_cluster",
+		Description: "AWS MemoryDB Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ClusterNotFoundFault", "InvalidParameterValueException"}),
+			},
+			Hydrate: getMemoryDBCluster,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMemoryDBClusters,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The user-supplied name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the cluster, e.g. available, updating or creating.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "node_type",
+				Description: "The cluster's node type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "number_of_shards",
+				Description: "The number of shards in the cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The Redis engine version used by the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_patch_version",
+				Description: "The Redis engine patch version used by the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_mode",
+				Description: "Indicates if the cluster has a Multi-AZ configuration (multiaz) or not (singleaz).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "acl_name",
+				Description: "The name of the Access Control List associated with this cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ACLName"),
+			},
+			{
+				Name:        "tls_enabled",
+				Description: "A flag to indicate if In-transit encryption is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("TLSEnabled"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the KMS key used to encrypt the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_minor_version_upgrade",
+				Description: "When set to true, the cluster will automatically receive minor engine version upgrades after launch.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "data_tiering",
+				Description: "Enables data tiering. Data tiering is only supported for clusters using the r6gd node type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_retention_limit",
+				Description: "The number of days for which MemoryDB retains automatic snapshots before deleting them.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "snapshot_window",
+				Description: "The daily time range (in UTC) during which MemoryDB begins taking a daily snapshot of your shard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maintenance_window",
+				Description: "Specifies the weekly time range during which maintenance on the cluster is performed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameter_group_name",
+				Description: "The name of the parameter group used by the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameter_group_status",
+				Description: "The status of the parameter group used by the cluster, for example 'active' or 'applying'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subnet_group_name",
+				Description: "The name of the subnet group used by the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sns_topic_arn",
+				Description: "The Amazon Resource Name (ARN) of the SNS notification topic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sns_topic_status",
+				Description: "The SNS topic must be in Active status to receive notifications.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_endpoint",
+				Description: "The cluster's configuration endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_updates",
+				Description: "A group of settings that are currently being applied.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "security_groups",
+				Description: "A list of security groups used by the cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "shards",
+				Description: "A list of shards that are members of the cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.From(memoryDBTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMemoryDBClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_cluster.listMemoryDBClusters", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &memorydb.DescribeClustersInput{
+		MaxResults:       aws.Int32(maxLimit),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	// DescribeClusters has no paginator in the SDK, so page through the results manually
+	for {
+		output, err := svc.DescribeClusters(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_memorydb_cluster.listMemoryDBClusters", "api_error", err)
+			return nil, err
+		}
+
+		for _, cluster := range output.Clusters {
+			d.StreamListItem(ctx, cluster)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMemoryDBCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_cluster.getMemoryDBCluster", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &memorydb.DescribeClustersInput{
+		ClusterName:      aws.String(name),
+		ShowShardDetails: aws.Bool(true),
+	}
+
+	op, err := svc.DescribeClusters(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_cluster.getMemoryDBCluster", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Clusters) > 0 {
+		return op.Clusters[0], nil
+	}
+	return nil, nil
+}
+
+// listMemoryDBResourceTags is shared by the MemoryDB cluster, user and ACL tables
+func listMemoryDBResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var resourceArn *string
+	switch item := h.Item.(type) {
+	case types.Cluster:
+		resourceArn = item.ARN
+	case types.User:
+		resourceArn = item.ARN
+	case types.ACL:
+		resourceArn = item.ARN
+	}
+
+	if resourceArn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb.listMemoryDBResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &memorydb.ListTagsInput{
+		ResourceArn: resourceArn,
+	}
+
+	op, err := svc.ListTags(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb.listMemoryDBResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func memoryDBTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	tags := d.HydrateItem.(*memorydb.ListTagsOutput)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if len(tags.TagList) > 0 {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
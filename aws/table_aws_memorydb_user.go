@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/memorydb"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMemoryDBUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_memorydb_user",
+		Description: "AWS MemoryDB User",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UserNotFoundFault", "InvalidParameterValueException"}),
+			},
+			Hydrate: getMemoryDBUser,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMemoryDBUsers,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ARN"),
+			},
+			{
+				Name:        "status",
+				Description: "Indicates the user status. Can be active, modifying or deleting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_string",
+				Description: "Access permissions string used for this user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "authentication_type",
+				Description: "Indicates whether the user requires a password to authenticate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Authentication.Type"),
+			},
+			{
+				Name:        "password_count",
+				Description: "The number of passwords belonging to the user. The maximum is two.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Authentication.PasswordCount"),
+			},
+			{
+				Name:        "minimum_engine_version",
+				Description: "The minimum engine version supported for the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "acl_names",
+				Description: "The names of the Access Control Lists to which the user belongs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ACLNames"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the user.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMemoryDBResourceTags,
+				Transform:   transform.From(memoryDBTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMemoryDBUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_user.listMemoryDBUsers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &memorydb.DescribeUsersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	// DescribeUsers has no paginator in the SDK, so page through the results manually
+	for {
+		output, err := svc.DescribeUsers(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_memorydb_user.listMemoryDBUsers", "api_error", err)
+			return nil, err
+		}
+
+		for _, user := range output.Users {
+			d.StreamListItem(ctx, user)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMemoryDBUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := MemoryDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_user.getMemoryDBUser", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &memorydb.DescribeUsersInput{
+		UserName: aws.String(name),
+	}
+
+	op, err := svc.DescribeUsers(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_memorydb_user.getMemoryDBUser", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.Users) > 0 {
+		return op.Users[0], nil
+	}
+	return nil, nil
+}
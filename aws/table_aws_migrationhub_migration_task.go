@@ -0,0 +1,285 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/migrationhub"
+	"github.com/aws/aws-sdk-go-v2/service/migrationhub/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMigrationHubMigrationTask(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_migrationhub_migration_task",
+		Description: "AWS Migration Hub Migration Task",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"progress_update_stream", "migration_task_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"HomeRegionNotSetException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getMigrationHubMigrationTask,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMigrationHubMigrationTasks,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"HomeRegionNotSetException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "migration_task_name",
+				Description: "Unique identifier that references the migration task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "progress_update_stream",
+				Description: "The name of the progress update stream, typically the name of the migration tool that reports progress.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Status of the task. Valid values are NOT_STARTED, IN_PROGRESS, FAILED and COMPLETED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status", "Task.Status"),
+			},
+			{
+				Name:        "status_detail",
+				Description: "Detail information of what is being done within the overall status state.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusDetail", "Task.StatusDetail"),
+			},
+			{
+				Name:        "progress_percent",
+				Description: "Indication of the percentage completion of the task.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ProgressPercent", "Task.ProgressPercent"),
+			},
+			{
+				Name:        "update_date_time",
+				Description: "The timestamp when the task was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "resource_attribute_list",
+				Description: "Information about the resource that is being migrated, such as its IP address, MAC address or FQDN.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMigrationHubMigrationTask,
+			},
+			{
+				Name:        "created_artifacts",
+				Description: "The AWS resources, identified by their ARN, created as a result of the migration task.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMigrationHubMigrationTaskCreatedArtifacts,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "discovered_resources",
+				Description: "The Application Discovery Service configuration items associated with the migration task.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMigrationHubMigrationTaskDiscoveredResources,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MigrationTaskName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMigrationHubMigrationTasks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MigrationHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTasks", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &migrationhub.ListMigrationTasksInput{}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListMigrationTasks(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTasks", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.MigrationTaskSummaryList {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMigrationHubMigrationTask(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var progressUpdateStream, migrationTaskName string
+	if h.Item != nil {
+		progressUpdateStream, migrationTaskName = migrationHubMigrationTaskKey(h.Item)
+	} else {
+		progressUpdateStream = d.KeyColumnQuals["progress_update_stream"].GetStringValue()
+		migrationTaskName = d.KeyColumnQuals["migration_task_name"].GetStringValue()
+	}
+
+	// Empty check
+	if progressUpdateStream == "" || migrationTaskName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MigrationHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_migrationhub_migration_task.getMigrationHubMigrationTask", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &migrationhub.DescribeMigrationTaskInput{
+		ProgressUpdateStream: aws.String(progressUpdateStream),
+		MigrationTaskName:    aws.String(migrationTaskName),
+	}
+
+	op, err := svc.DescribeMigrationTask(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_migrationhub_migration_task.getMigrationHubMigrationTask", "api_error", err)
+		return nil, err
+	}
+
+	return *op.MigrationTask, nil
+}
+
+func listMigrationHubMigrationTaskCreatedArtifacts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	progressUpdateStream, migrationTaskName := migrationHubMigrationTaskKey(h.Item)
+
+	// Create Session
+	svc, err := MigrationHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTaskCreatedArtifacts", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &migrationhub.ListCreatedArtifactsInput{
+		ProgressUpdateStream: aws.String(progressUpdateStream),
+		MigrationTaskName:    aws.String(migrationTaskName),
+	}
+
+	var artifacts []types.CreatedArtifact
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListCreatedArtifacts(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTaskCreatedArtifacts", "api_error", err)
+			return nil, err
+		}
+		artifacts = append(artifacts, result.CreatedArtifactList...)
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return artifacts, nil
+}
+
+func listMigrationHubMigrationTaskDiscoveredResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	progressUpdateStream, migrationTaskName := migrationHubMigrationTaskKey(h.Item)
+
+	// Create Session
+	svc, err := MigrationHubClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTaskDiscoveredResources", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &migrationhub.ListDiscoveredResourcesInput{
+		ProgressUpdateStream: aws.String(progressUpdateStream),
+		MigrationTaskName:    aws.String(migrationTaskName),
+	}
+
+	var resources []types.DiscoveredResource
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.ListDiscoveredResources(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_migrationhub_migration_task.listMigrationHubMigrationTaskDiscoveredResources", "api_error", err)
+			return nil, err
+		}
+		resources = append(resources, result.DiscoveredResourceList...)
+
+		if result.NextToken != nil {
+			pagesLeft = true
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return resources, nil
+}
+
+//// UTILITY FUNCTION
+
+// The list call returns task summaries while the get call returns full tasks
+func migrationHubMigrationTaskKey(item interface{}) (string, string) {
+	switch task := item.(type) {
+	case types.MigrationTaskSummary:
+		return aws.ToString(task.ProgressUpdateStream), aws.ToString(task.MigrationTaskName)
+	case types.MigrationTask:
+		return aws.ToString(task.ProgressUpdateStream), aws.ToString(task.MigrationTaskName)
+	}
+	return "", ""
+}
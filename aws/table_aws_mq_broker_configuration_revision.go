@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type mqConfigurationRevisionInfo = struct {
+	types.ConfigurationRevision
+	ConfigurationId   *string
+	ConfigurationArn  *string
+	ConfigurationName *string
+	EngineType        types.EngineType
+	EngineVersion     *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsMQBrokerConfigurationRevision(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mq_broker_configuration_revision",
+		Description: "AWS MQ Broker Configuration Revision",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"configuration_id", "revision"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getMQBrokerConfigurationRevision,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listMQBrokerConfigurations,
+			Hydrate:       listMQBrokerConfigurationRevisions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "configuration_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "configuration_id",
+				Description: "The unique ID that Amazon MQ generates for the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "configuration_name",
+				Description: "The name of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "configuration_arn",
+				Description: "The Amazon Resource Name (ARN) of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "revision",
+				Description: "The revision number of the configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the configuration revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The date and time the configuration revision was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "engine_type",
+				Description: "The broker engine type of the configuration, either ACTIVEMQ or RABBITMQ.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "The broker engine version of the configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data",
+				Description: "The decoded configuration data, in XML format for ActiveMQ and in Cuttlefish format for RabbitMQ.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMQBrokerConfigurationRevisionData,
+				Transform:   transform.FromField("Data").Transform(base64DecodedData),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(mqBrokerConfigurationRevisionTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listMQBrokerConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.listMQBrokerConfigurations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	pagesLeft := true
+	params := &mq.ListConfigurationsInput{
+		MaxResults: 100,
+	}
+
+	for pagesLeft {
+		result, err := svc.ListConfigurations(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.listMQBrokerConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range result.Configurations {
+			d.StreamListItem(ctx, configuration)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+func listMQBrokerConfigurationRevisions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configuration := h.Item.(types.Configuration)
+
+	// Minimize API calls when a specific configuration has been requested
+	if d.KeyColumnQuals["configuration_id"] != nil && d.KeyColumnQuals["configuration_id"].GetStringValue() != *configuration.Id {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.listMQBrokerConfigurationRevisions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	pagesLeft := true
+	params := &mq.ListConfigurationRevisionsInput{
+		ConfigurationId: configuration.Id,
+		MaxResults:      maxLimit,
+	}
+
+	for pagesLeft {
+		result, err := svc.ListConfigurationRevisions(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.listMQBrokerConfigurationRevisions", "api_error", err)
+			return nil, err
+		}
+
+		for _, revision := range result.Revisions {
+			d.StreamLeafListItem(ctx, mqConfigurationRevisionInfo{
+				ConfigurationRevision: revision,
+				ConfigurationId:       configuration.Id,
+				ConfigurationArn:      configuration.Arn,
+				ConfigurationName:     configuration.Name,
+				EngineType:            configuration.EngineType,
+				EngineVersion:         configuration.EngineVersion,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			params.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMQBrokerConfigurationRevision(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	configurationID := d.KeyColumnQuals["configuration_id"].GetStringValue()
+	revision := d.KeyColumnQuals["revision"].GetInt64Value()
+
+	// check if configuration id is empty
+	if configurationID == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.getMQBrokerConfigurationRevision", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	configuration, err := svc.DescribeConfiguration(ctx, &mq.DescribeConfigurationInput{
+		ConfigurationId: aws.String(configurationID),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.getMQBrokerConfigurationRevision", "api_error", err)
+		return nil, err
+	}
+
+	op, err := svc.DescribeConfigurationRevision(ctx, &mq.DescribeConfigurationRevisionInput{
+		ConfigurationId:       aws.String(configurationID),
+		ConfigurationRevision: aws.String(strconv.FormatInt(revision, 10)),
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.getMQBrokerConfigurationRevision", "api_error", err)
+		return nil, err
+	}
+
+	return mqConfigurationRevisionInfo{
+		ConfigurationRevision: types.ConfigurationRevision{
+			Created:     op.Created,
+			Description: op.Description,
+			Revision:    int32(revision),
+		},
+		ConfigurationId:   configuration.Id,
+		ConfigurationArn:  configuration.Arn,
+		ConfigurationName: configuration.Name,
+		EngineType:        configuration.EngineType,
+		EngineVersion:     configuration.EngineVersion,
+	}, nil
+}
+
+func getMQBrokerConfigurationRevisionData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	revision := h.Item.(mqConfigurationRevisionInfo)
+
+	// Create Session
+	svc, err := MQClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.getMQBrokerConfigurationRevisionData", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &mq.DescribeConfigurationRevisionInput{
+		ConfigurationId:       revision.ConfigurationId,
+		ConfigurationRevision: aws.String(strconv.Itoa(int(revision.Revision))),
+	}
+
+	op, err := svc.DescribeConfigurationRevision(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mq_broker_configuration_revision.getMQBrokerConfigurationRevisionData", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func mqBrokerConfigurationRevisionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	revision := d.HydrateItem.(mqConfigurationRevisionInfo)
+
+	return aws.ToString(revision.ConfigurationName) + ":" + strconv.Itoa(int(revision.Revision)), nil
+}
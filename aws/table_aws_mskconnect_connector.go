@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKConnectConnector(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mskconnect_connector",
+		Description: "AWS MSK Connect Connector",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMSKConnectConnector,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMSKConnectConnectors,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "connector_name",
+				Description: "The name of the connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the connector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectorArn"),
+			},
+			{
+				Name:        "connector_state",
+				Description: "The state of the connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "connector_description",
+				Description: "The description of the connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the connector was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "current_version",
+				Description: "The current version of the connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kafka_connect_version",
+				Description: "The version of Kafka Connect that the connector runs.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_execution_role_arn",
+				Description: "The ARN of the IAM role used by the connector to access AWS resources.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_description",
+				Description: "Details about the state of the connector, including the error message if it failed.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeMSKConnectConnector,
+			},
+			{
+				Name:        "capacity",
+				Description: "The capacity of the connector, either auto scaled or provisioned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kafka_cluster",
+				Description: "The Apache Kafka cluster that the connector is connected to, including its bootstrap servers and VPC.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kafka_cluster_client_authentication",
+				Description: "The type of client authentication used to connect to the Apache Kafka cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kafka_cluster_encryption_in_transit",
+				Description: "Details of encryption in transit to the Apache Kafka cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "log_delivery",
+				Description: "The settings for delivering connector logs to CloudWatch Logs, Firehose or S3.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "plugins",
+				Description: "The custom plugins used by the connector, including their revisions.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "worker_configuration",
+				Description: "The worker configuration used by the connector, including its revision.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectorName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectorArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMSKConnectConnectors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_connector.listMSKConnectConnectors", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := kafkaconnect.NewListConnectorsPaginator(svc, &kafkaconnect.ListConnectorsInput{}, func(o *kafkaconnect.ListConnectorsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mskconnect_connector.listMSKConnectConnectors", "api_error", err)
+			return nil, err
+		}
+
+		for _, connector := range output.Connectors {
+			d.StreamListItem(ctx, connector)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMSKConnectConnector(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	op, err := describeConnector(ctx, d, arn)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// describeMSKConnectConnector returns the connector details that are not
+// included in the list response
+func describeMSKConnectConnector(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *kafkaconnect.DescribeConnectorOutput:
+		return item, nil
+	case types.ConnectorSummary:
+		op, err := describeConnector(ctx, d, *item.ConnectorArn)
+		if err != nil || op == nil {
+			return nil, err
+		}
+		return op, nil
+	}
+	return nil, nil
+}
+
+func describeConnector(ctx context.Context, d *plugin.QueryData, arn string) (*kafkaconnect.DescribeConnectorOutput, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_connector.describeConnector", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafkaconnect.DescribeConnectorInput{
+		ConnectorArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeConnector(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_connector.describeConnector", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKConnectCustomPlugin(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mskconnect_custom_plugin",
+		Description: "AWS MSK Connect Custom Plugin",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMSKConnectCustomPlugin,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMSKConnectCustomPlugins,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the custom plugin.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the custom plugin.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomPluginArn"),
+			},
+			{
+				Name:        "custom_plugin_state",
+				Description: "The state of the custom plugin.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the custom plugin.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the custom plugin was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "latest_revision",
+				Description: "The latest revision number of the custom plugin.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LatestRevision.Revision"),
+			},
+			{
+				Name:        "latest_revision_content_type",
+				Description: "The format of the plugin file of the latest revision, either JAR or ZIP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestRevision.ContentType"),
+			},
+			{
+				Name:        "latest_revision_creation_time",
+				Description: "The time that the latest revision of the custom plugin was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LatestRevision.CreationTime"),
+			},
+			{
+				Name:        "latest_revision_file_description",
+				Description: "The size and MD5 checksum of the plugin file of the latest revision.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LatestRevision.FileDescription"),
+			},
+			{
+				Name:        "latest_revision_location",
+				Description: "The S3 location of the plugin file of the latest revision.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LatestRevision.Location"),
+			},
+			{
+				Name:        "state_description",
+				Description: "Details about the state of the custom plugin.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeMSKConnectCustomPlugin,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CustomPluginArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMSKConnectCustomPlugins(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_custom_plugin.listMSKConnectCustomPlugins", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := kafkaconnect.NewListCustomPluginsPaginator(svc, &kafkaconnect.ListCustomPluginsInput{}, func(o *kafkaconnect.ListCustomPluginsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mskconnect_custom_plugin.listMSKConnectCustomPlugins", "api_error", err)
+			return nil, err
+		}
+
+		for _, customPlugin := range output.CustomPlugins {
+			d.StreamListItem(ctx, customPlugin)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMSKConnectCustomPlugin(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	op, err := describeCustomPlugin(ctx, d, arn)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// describeMSKConnectCustomPlugin returns the custom plugin details that are not
+// included in the list response
+func describeMSKConnectCustomPlugin(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *kafkaconnect.DescribeCustomPluginOutput:
+		return item, nil
+	case types.CustomPluginSummary:
+		op, err := describeCustomPlugin(ctx, d, *item.CustomPluginArn)
+		if err != nil || op == nil {
+			return nil, err
+		}
+		return op, nil
+	}
+	return nil, nil
+}
+
+func describeCustomPlugin(ctx context.Context, d *plugin.QueryData, arn string) (*kafkaconnect.DescribeCustomPluginOutput, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_custom_plugin.describeCustomPlugin", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafkaconnect.DescribeCustomPluginInput{
+		CustomPluginArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeCustomPlugin(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_custom_plugin.describeCustomPlugin", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsMSKConnectWorkerConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_mskconnect_worker_configuration",
+		Description: "AWS MSK Connect Worker Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException", "BadRequestException"}),
+			},
+			Hydrate: getMSKConnectWorkerConfiguration,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMSKConnectWorkerConfigurations,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the worker configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the worker configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkerConfigurationArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the worker configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time that the worker configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "latest_revision",
+				Description: "The latest revision number of the worker configuration.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LatestRevision.Revision"),
+			},
+			{
+				Name:        "latest_revision_creation_time",
+				Description: "The time that the latest revision of the worker configuration was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LatestRevision.CreationTime"),
+			},
+			{
+				Name:        "latest_revision_description",
+				Description: "The description of the latest revision of the worker configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LatestRevision.Description"),
+			},
+			{
+				Name:        "properties_file_content",
+				Description: "The decoded contents of the worker configuration properties file of the latest revision.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeMSKConnectWorkerConfiguration,
+				Transform:   transform.FromField("LatestRevision.PropertiesFileContent").Transform(base64DecodedData),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WorkerConfigurationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMSKConnectWorkerConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_worker_configuration.listMSKConnectWorkerConfigurations", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	paginator := kafkaconnect.NewListWorkerConfigurationsPaginator(svc, &kafkaconnect.ListWorkerConfigurationsInput{}, func(o *kafkaconnect.ListWorkerConfigurationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_mskconnect_worker_configuration.listMSKConnectWorkerConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, workerConfiguration := range output.WorkerConfigurations {
+			d.StreamListItem(ctx, workerConfiguration)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMSKConnectWorkerConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	// check if arn is empty
+	if arn == "" {
+		return nil, nil
+	}
+
+	op, err := describeWorkerConfiguration(ctx, d, arn)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// describeMSKConnectWorkerConfiguration returns the worker configuration details that are not
+// included in the list response
+func describeMSKConnectWorkerConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *kafkaconnect.DescribeWorkerConfigurationOutput:
+		return item, nil
+	case types.WorkerConfigurationSummary:
+		op, err := describeWorkerConfiguration(ctx, d, *item.WorkerConfigurationArn)
+		if err != nil || op == nil {
+			return nil, err
+		}
+		return op, nil
+	}
+	return nil, nil
+}
+
+func describeWorkerConfiguration(ctx context.Context, d *plugin.QueryData, arn string) (*kafkaconnect.DescribeWorkerConfigurationOutput, error) {
+	// Create Session
+	svc, err := KafkaConnectClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_worker_configuration.describeWorkerConfiguration", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &kafkaconnect.DescribeWorkerConfigurationInput{
+		WorkerConfigurationArn: aws.String(arn),
+	}
+
+	op, err := svc.DescribeWorkerConfiguration(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_mskconnect_worker_configuration.describeWorkerConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
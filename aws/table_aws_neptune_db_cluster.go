@@ -211,6 +211,11 @@ func tableAwsNeptuneDBCluster(_ context.Context) *plugin.Table {
 				Description: "Contains one or more identifiers of the Read Replicas associated with this DB cluster.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "serverless_v2_scaling_configuration",
+				Description: "The scaling configuration of a Neptune Serverless DB cluster, i.e. the minimum and maximum Neptune Capacity Units (NCUs).",
+				Type:        proto.ColumnType_JSON,
+			},
 			{
 				Name:        "vpc_security_groups",
 				Description: "Provides a list of VPC security groups that the DB cluster belongs to.",
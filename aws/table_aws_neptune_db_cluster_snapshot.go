@@ -0,0 +1,328 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/aws/aws-sdk-go-v2/service/neptune/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNeptuneDBClusterSnapshot(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_neptune_db_cluster_snapshot",
+		Description: "AWS Neptune DB Cluster Snapshot",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_cluster_snapshot_identifier"),
+			Hydrate:    getNeptuneDBClusterSnapshot,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DBClusterSnapshotNotFoundFault"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNeptuneDBClusterSnapshots,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "db_cluster_identifier", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_cluster_snapshot_identifier",
+				Description: "Specifies the identifier for the DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterSnapshotIdentifier"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterSnapshotArn"),
+			},
+			{
+				Name:        "db_cluster_identifier",
+				Description: "Specifies the DB cluster identifier of the DB cluster that this DB cluster snapshot was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "type",
+				Description: "Provides the type of the DB cluster snapshot, which can be automated or manual.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotType"),
+			},
+			{
+				Name:        "status",
+				Description: "Specifies the status of this DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_create_time",
+				Description: "Provides the time when the snapshot was taken, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cluster_create_time",
+				Description: "Specifies the time when the DB cluster was created, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "allocated_storage",
+				Description: "Specifies the allocated storage size in gibibytes (GiB).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "engine",
+				Description: "Specifies the name of the database engine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "Provides the version of the database engine for this DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_database_authentication_enabled",
+				Description: "True if mapping of Amazon Identity and Access Management (IAM) accounts to database accounts is enabled, and otherwise false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IAMDatabaseAuthenticationEnabled"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "If storage_encrypted is true, the AWS KMS key identifier for the encrypted DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "license_model",
+				Description: "Provides the license model information for this DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "percent_progress",
+				Description: "Specifies the percentage of the estimated data that has been transferred.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "port",
+				Description: "Specifies the port that the DB cluster was listening on at the time of the snapshot.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "source_db_cluster_snapshot_arn",
+				Description: "If the DB cluster snapshot was copied from a source DB cluster snapshot, the Amazon Resource Name (ARN) for the source DB cluster snapshot, otherwise, a null value.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SourceDBClusterSnapshotArn"),
+			},
+			{
+				Name:        "storage_encrypted",
+				Description: "Specifies whether the DB cluster snapshot is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "Provides the VPC ID associated with the DB cluster snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_zones",
+				Description: "Provides the list of EC2 Availability Zones that instances in the DB cluster snapshot can be restored in.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "db_cluster_snapshot_attributes",
+				Description: "A list of DB cluster snapshot attribute names and values for a manual DB cluster snapshot.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNeptuneDBClusterSnapshotAttributes,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNeptuneDBClusterSnapshotTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterSnapshotIdentifier"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNeptuneDBClusterSnapshotTags,
+				Transform:   transform.From(neptuneDBClusterTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBClusterSnapshotArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNeptuneDBClusterSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.listNeptuneDBClusterSnapshots", "get_client_error", err)
+		return nil, err
+	}
+
+	input := &neptune.DescribeDBClusterSnapshotsInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["db_cluster_identifier"] != nil {
+		input.DBClusterIdentifier = aws.String(equalQuals["db_cluster_identifier"].GetStringValue())
+	}
+	if equalQuals["type"] != nil {
+		input.SnapshotType = aws.String(equalQuals["type"].GetStringValue())
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := neptune.NewDescribeDBClusterSnapshotsPaginator(svc, input, func(o *neptune.DescribeDBClusterSnapshotsPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.listNeptuneDBClusterSnapshots", "api_error", err)
+			return nil, err
+		}
+
+		for _, snapshot := range output.DBClusterSnapshots {
+			// The DescribeDBClusterSnapshots API returns non-Neptune snapshots as well,
+			// so only stream the Neptune ones
+			if aws.ToString(snapshot.Engine) == "neptune" {
+				d.StreamListItem(ctx, snapshot)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNeptuneDBClusterSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	identifier := d.KeyColumnQuals["db_cluster_snapshot_identifier"].GetStringValue()
+
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshot", "get_client_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &neptune.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(identifier),
+	}
+
+	// Get call
+	data, err := svc.DescribeDBClusterSnapshots(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshot", "api_error", err)
+		return nil, err
+	}
+	if len(data.DBClusterSnapshots) > 0 && aws.ToString(data.DBClusterSnapshots[0].Engine) == "neptune" {
+		return data.DBClusterSnapshots[0], nil
+	}
+	return nil, nil
+}
+
+func getNeptuneDBClusterSnapshotAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	snapshot := h.Item.(types.DBClusterSnapshot)
+
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshotAttributes", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &neptune.DescribeDBClusterSnapshotAttributesInput{
+		DBClusterSnapshotIdentifier: snapshot.DBClusterSnapshotIdentifier,
+	}
+
+	data, err := svc.DescribeDBClusterSnapshotAttributes(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshotAttributes", "api_error", err)
+		return nil, err
+	}
+
+	var attributes = make([]map[string]interface{}, 0)
+	if data.DBClusterSnapshotAttributesResult != nil {
+		for _, attribute := range data.DBClusterSnapshotAttributesResult.DBClusterSnapshotAttributes {
+			var result = make(map[string]interface{})
+
+			result["AttributeName"] = attribute.AttributeName
+			if len(attribute.AttributeValues) == 0 {
+				result["AttributeValues"] = nil
+			} else {
+				result["AttributeValues"] = attribute.AttributeValues
+			}
+
+			attributes = append(attributes, result)
+		}
+	}
+
+	return attributes, nil
+}
+
+func getNeptuneDBClusterSnapshotTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	snapshotArn := h.Item.(types.DBClusterSnapshot).DBClusterSnapshotArn
+
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshotTags", "get_client_error", err)
+		return nil, err
+	}
+
+	input := &neptune.ListTagsForResourceInput{
+		ResourceName: snapshotArn,
+	}
+
+	tags, err := svc.ListTagsForResource(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_cluster_snapshot.getNeptuneDBClusterSnapshotTags", "api_error", err)
+		return nil, err
+	}
+
+	return tags, nil
+}
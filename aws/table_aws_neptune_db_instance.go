@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/aws/aws-sdk-go-v2/service/neptune/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNeptuneDBInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_neptune_db_instance",
+		Description: "AWS Neptune DB Instance",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_instance_identifier"),
+			Hydrate:    getNeptuneDBInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DBInstanceNotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNeptuneDBInstances,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_instance_identifier",
+				Description: "The user-supplied database identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceIdentifier"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceArn"),
+			},
+			{
+				Name:        "db_cluster_identifier",
+				Description: "The name of the DB cluster that the DB instance is a member of.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "status",
+				Description: "Specifies the current state of this database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceStatus"),
+			},
+			{
+				Name:        "class",
+				Description: "Contains the name of the compute and memory capacity class of the DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceClass"),
+			},
+			{
+				Name:        "engine",
+				Description: "Provides the name of the database engine to be used for this DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "Indicates the database engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_create_time",
+				Description: "Provides the date and time the DB instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "Specifies the name of the Availability Zone the DB instance is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "auto_minor_version_upgrade",
+				Description: "Indicates that minor version patches are applied automatically.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ca_certificate_identifier",
+				Description: "The identifier of the CA certificate for this DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CACertificateIdentifier"),
+			},
+			{
+				Name:        "dbi_resource_id",
+				Description: "The AWS Region-unique, immutable identifier for the DB instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DbiResourceId"),
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "Indicates whether or not the DB instance has deletion protection enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "iam_database_authentication_enabled",
+				Description: "True if Amazon Identity and Access Management (IAM) authentication is enabled, and otherwise false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IAMDatabaseAuthenticationEnabled"),
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The AWS KMS key identifier for the encrypted DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "multi_az",
+				Description: "Specifies if the DB instance is a Multi-AZ deployment.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MultiAZ"),
+			},
+			{
+				Name:        "preferred_backup_window",
+				Description: "Specifies the daily time range during which automated backups are created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "preferred_maintenance_window",
+				Description: "Specifies the weekly time range during which system maintenance can occur, in Universal Coordinated Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "promotion_tier",
+				Description: "Specifies the order in which a Read Replica is promoted to the primary instance after a failure of the existing primary instance.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "Specifies the accessibility options for the DB instance.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "storage_encrypted",
+				Description: "Specifies whether the DB instance is encrypted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "storage_type",
+				Description: "Specifies the storage type associated with the DB instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_parameter_groups",
+				Description: "Provides the list of DB parameter groups applied to this DB instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBParameterGroups"),
+			},
+			{
+				Name:        "db_subnet_group",
+				Description: "Specifies information on the subnet group associated with the DB instance.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBSubnetGroup"),
+			},
+			{
+				Name:        "enabled_cloudwatch_logs_exports",
+				Description: "A list of log types that this DB instance is configured to export to CloudWatch Logs.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "endpoint",
+				Description: "Specifies the connection endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_modified_values",
+				Description: "Specifies that changes to the DB instance are pending.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status_infos",
+				Description: "The status of a Read Replica. If the instance is not a Read Replica, this is blank.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "vpc_security_groups",
+				Description: "Provides a list of VPC security group elements that the DB instance belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNeptuneDBInstanceTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBInstanceIdentifier"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNeptuneDBInstanceTags,
+				Transform:   transform.From(neptuneDBClusterTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DBInstanceArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNeptuneDBInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_instance.listNeptuneDBInstances", "get_client_error", err)
+		return nil, err
+	}
+
+	input := &neptune.DescribeDBInstancesInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < *input.MaxRecords {
+			if limit < 20 {
+				input.MaxRecords = aws.Int32(20)
+			} else {
+				input.MaxRecords = aws.Int32(limit)
+			}
+		}
+	}
+
+	paginator := neptune.NewDescribeDBInstancesPaginator(svc, input, func(o *neptune.DescribeDBInstancesPaginatorOptions) {
+		o.Limit = *input.MaxRecords
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_neptune_db_instance.listNeptuneDBInstances", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range output.DBInstances {
+			// The DescribeDBInstances API returns non-Neptune DB instances as well,
+			// so only stream the Neptune ones
+			if aws.ToString(instance.Engine) == "neptune" {
+				d.StreamListItem(ctx, instance)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNeptuneDBInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	identifier := d.KeyColumnQuals["db_instance_identifier"].GetStringValue()
+
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_instance.getNeptuneDBInstance", "get_client_error", err)
+		return nil, err
+	}
+
+	// Build the params
+	params := &neptune.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(identifier),
+	}
+
+	// Get call
+	data, err := svc.DescribeDBInstances(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_instance.getNeptuneDBInstance", "api_error", err)
+		return nil, err
+	}
+	if len(data.DBInstances) > 0 && aws.ToString(data.DBInstances[0].Engine) == "neptune" {
+		return data.DBInstances[0], nil
+	}
+	return nil, nil
+}
+
+func getNeptuneDBInstanceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instanceArn := h.Item.(types.DBInstance).DBInstanceArn
+
+	// Create session
+	svc, err := NeptuneClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_instance.getNeptuneDBInstanceTags", "get_client_error", err)
+		return nil, err
+	}
+
+	input := &neptune.ListTagsForResourceInput{
+		ResourceName: instanceArn,
+	}
+
+	tags, err := svc.ListTagsForResource(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_neptune_db_instance.getNeptuneDBInstanceTags", "api_error", err)
+		return nil, err
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerAttachment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_attachment",
+		Description: "AWS Network Manager Attachment",
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagerAttachments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "core_network_id", Require: plugin.Optional},
+				{Name: "attachment_type", Require: plugin.Optional},
+				{Name: "edge_location", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "attachment_id",
+				Description: "The ID of the attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the attachment.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getNetworkManagerAttachmentArn,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "attachment_type",
+				Description: "The type of attachment, such as VPC, CONNECT, SITE_TO_SITE_VPN or TRANSIT_GATEWAY_ROUTE_TABLE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the attachment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "core_network_id",
+				Description: "The ID of the core network the attachment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "core_network_arn",
+				Description: "The Amazon Resource Name (ARN) of the core network the attachment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "The ID of the attachment account owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "edge_location",
+				Description: "The Region where the edge is located.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the attached resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "segment_name",
+				Description: "The name of the segment the attachment is associated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "attachment_policy_rule_number",
+				Description: "The policy rule number associated with the attachment.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the attachment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The timestamp when the attachment was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "proposed_segment_change",
+				Description: "The segment change that is pending for the attachment, including the proposed segment name and tags.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the attachment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AttachmentId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerAttachmentArn,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerAttachments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_attachment.listNetworkManagerAttachments", "connection_error", err)
+		return nil, err
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &networkmanager.ListAttachmentsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["core_network_id"] != nil {
+		input.CoreNetworkId = aws.String(equalQuals["core_network_id"].GetStringValue())
+	}
+	if equalQuals["attachment_type"] != nil {
+		input.AttachmentType = types.AttachmentType(equalQuals["attachment_type"].GetStringValue())
+	}
+	if equalQuals["edge_location"] != nil {
+		input.EdgeLocation = aws.String(equalQuals["edge_location"].GetStringValue())
+	}
+	if equalQuals["state"] != nil {
+		input.State = types.AttachmentState(equalQuals["state"].GetStringValue())
+	}
+
+	paginator := networkmanager.NewListAttachmentsPaginator(svc, input, func(o *networkmanager.ListAttachmentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_attachment.listNetworkManagerAttachments", "api_error", err)
+			return nil, err
+		}
+
+		for _, attachment := range output.Attachments {
+			d.StreamListItem(ctx, attachment)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerAttachmentArn(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	attachment := h.Item.(types.Attachment)
+
+	// Get common columns
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_attachment.getNetworkManagerAttachmentArn", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+
+	// arn:aws:networkmanager::account-id:attachment/attachment-id
+	arn := "arn:" + commonColumnData.Partition + ":networkmanager::" + aws.ToString(attachment.OwnerAccountId) + ":attachment/" + aws.ToString(attachment.AttachmentId)
+
+	return arn, nil
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerCoreNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_core_network",
+		Description: "AWS Network Manager Core Network",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("core_network_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerCoreNetwork,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagerCoreNetworks,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "global_network_id", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "core_network_id",
+				Description: "The ID of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the core network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CoreNetworkArn"),
+			},
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network that the core network belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the core network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "The ID of the account that owns the core network. Only available when listing core networks.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp when the core network was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "edges",
+				Description: "The edges within the core network, including the edge location, ASN and inside CIDR blocks of each edge.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "segments",
+				Description: "The segments within the core network, including the edge locations and shared segments of each segment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetwork,
+			},
+			{
+				Name:        "policy_document",
+				Description: "The live policy document of the core network.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkManagerCoreNetworkPolicy,
+				Transform:   transform.FromField("PolicyDocument").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_versions",
+				Description: "The versions of the core network policy, including the alias and change set state of each version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listNetworkManagerCoreNetworkPolicyVersions,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the core network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CoreNetworkId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CoreNetworkArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerCoreNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworks", "connection_error", err)
+		return nil, err
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &networkmanager.ListCoreNetworksInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	globalNetworkId := d.KeyColumnQuals["global_network_id"].GetStringValue()
+
+	paginator := networkmanager.NewListCoreNetworksPaginator(svc, input, func(o *networkmanager.ListCoreNetworksPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworks", "api_error", err)
+			return nil, err
+		}
+
+		for _, coreNetwork := range output.CoreNetworks {
+			// The API does not support filtering by global network
+			if globalNetworkId != "" && aws.ToString(coreNetwork.GlobalNetworkId) != globalNetworkId {
+				continue
+			}
+
+			d.StreamListItem(ctx, coreNetwork)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerCoreNetwork(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var coreNetworkId string
+	if h.Item != nil {
+		coreNetworkId = networkManagerCoreNetworkId(h.Item)
+	} else {
+		coreNetworkId = d.KeyColumnQuals["core_network_id"].GetStringValue()
+	}
+
+	// Empty check
+	if coreNetworkId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetwork", "connection_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetCoreNetworkInput{
+		CoreNetworkId: aws.String(coreNetworkId),
+	}
+
+	op, err := svc.GetCoreNetwork(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetwork", "api_error", err)
+		return nil, err
+	}
+
+	if op.CoreNetwork == nil {
+		return nil, nil
+	}
+	return *op.CoreNetwork, nil
+}
+
+func getNetworkManagerCoreNetworkPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	coreNetworkId := networkManagerCoreNetworkId(h.Item)
+
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetworkPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId: aws.String(coreNetworkId),
+		Alias:         types.CoreNetworkPolicyAliasLive,
+	}
+
+	op, err := svc.GetCoreNetworkPolicy(ctx, params)
+	if err != nil {
+		// A core network that has not had a policy deployed has no live policy
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "ResourceNotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.getNetworkManagerCoreNetworkPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op.CoreNetworkPolicy, nil
+}
+
+func listNetworkManagerCoreNetworkPolicyVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	coreNetworkId := networkManagerCoreNetworkId(h.Item)
+
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworkPolicyVersions", "connection_error", err)
+		return nil, err
+	}
+
+	input := &networkmanager.ListCoreNetworkPolicyVersionsInput{
+		CoreNetworkId: aws.String(coreNetworkId),
+		MaxResults:    aws.Int32(500),
+	}
+
+	paginator := networkmanager.NewListCoreNetworkPolicyVersionsPaginator(svc, input, func(o *networkmanager.ListCoreNetworkPolicyVersionsPaginatorOptions) {
+		o.Limit = 500
+		o.StopOnDuplicateToken = true
+	})
+
+	var versions []types.CoreNetworkPolicyVersion
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_core_network.listNetworkManagerCoreNetworkPolicyVersions", "api_error", err)
+			return nil, err
+		}
+		versions = append(versions, output.CoreNetworkPolicyVersions...)
+	}
+
+	return versions, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func networkManagerCoreNetworkId(item interface{}) string {
+	switch item := item.(type) {
+	case types.CoreNetworkSummary:
+		return aws.ToString(item.CoreNetworkId)
+	case types.CoreNetwork:
+		return aws.ToString(item.CoreNetworkId)
+	}
+	return ""
+}
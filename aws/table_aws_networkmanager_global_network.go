@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsNetworkManagerGlobalNetwork(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_networkmanager_global_network",
+		Description: "AWS Network Manager Global Network",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("global_network_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getNetworkManagerGlobalNetwork,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagerGlobalNetworks,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "global_network_id",
+				Description: "The ID of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the global network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalNetworkArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the global network.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the global network was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the global network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalNetworkId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(networkManagerTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GlobalNetworkArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerGlobalNetworks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.listNetworkManagerGlobalNetworks", "connection_error", err)
+		return nil, err
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(500)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := networkmanager.NewDescribeGlobalNetworksPaginator(svc, input, func(o *networkmanager.DescribeGlobalNetworksPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_networkmanager_global_network.listNetworkManagerGlobalNetworks", "api_error", err)
+			return nil, err
+		}
+
+		for _, globalNetwork := range output.GlobalNetworks {
+			d.StreamListItem(ctx, globalNetwork)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerGlobalNetwork(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	globalNetworkId := d.KeyColumnQuals["global_network_id"].GetStringValue()
+
+	// Empty check
+	if globalNetworkId == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := NetworkManagerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.getNetworkManagerGlobalNetwork", "connection_error", err)
+		return nil, err
+	}
+
+	params := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: []string{globalNetworkId},
+	}
+
+	op, err := svc.DescribeGlobalNetworks(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_networkmanager_global_network.getNetworkManagerGlobalNetwork", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.GlobalNetworks) > 0 {
+		return op.GlobalNetworks[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// networkManagerTagsToTurbotTags is shared by the Network Manager tables
+func networkManagerTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsOrganizationsDelegatedAdministrator(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_organizations_delegated_administrator",
+		Description: "AWS Organizations Delegated Administrator",
+		List: &plugin.ListConfig{
+			Hydrate: listOrganizationsDelegatedAdministrators,
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name of the delegated administrator's account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier (account ID) of the delegated administrator's account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the delegated administrator's account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email",
+				Description: "The email address that is associated with the delegated administrator's Amazon Web Services account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the delegated administrator's account in the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "joined_method",
+				Description: "The method by which the delegated administrator's account joined the organization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "joined_timestamp",
+				Description: "The date when the delegated administrator's account became a part of the organization.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "delegation_enabled_date",
+				Description: "The date when the account was made a delegated administrator.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizationsDelegatedAdministrators(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get Client
+	svc, err := OrganizationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_organizations_delegated_administrator.listOrganizationsDelegatedAdministrators", "client_error", err)
+		return nil, err
+	}
+
+	maxItems := int32(20)
+	params := &organizations.ListDelegatedAdministratorsInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	params.MaxResults = &maxItems
+	paginator := organizations.NewListDelegatedAdministratorsPaginator(svc, params, func(o *organizations.ListDelegatedAdministratorsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_organizations_delegated_administrator.listOrganizationsDelegatedAdministrators", "api_error", err)
+			return nil, err
+		}
+
+		for _, administrator := range output.DelegatedAdministrators {
+			d.StreamListItem(ctx, administrator)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
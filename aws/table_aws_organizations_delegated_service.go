@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type organizationsDelegatedServiceInfo = struct {
+	types.DelegatedService
+	DelegatedAccountId *string
+}
+
+func tableAwsOrganizationsDelegatedService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_organizations_delegated_service",
+		Description: "AWS Organizations Delegated Service",
+		List: &plugin.ListConfig{
+			ParentHydrate: listOrganizationsDelegatedAdministrators,
+			Hydrate:       listOrganizationsDelegatedServices,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "delegated_account_id", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "delegated_account_id",
+				Description: "The account ID of the delegated administrator the service is delegated to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_principal",
+				Description: "The name of an Amazon Web Services service that can request an operation for the specified service, for example guardduty.amazonaws.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "delegation_enabled_date",
+				Description: "The date that the account became a delegated administrator for this service.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServicePrincipal"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listOrganizationsDelegatedServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	administrator := h.Item.(types.DelegatedAdministrator)
+
+	// Minimize API calls when a specific account has been requested
+	if d.KeyColumnQuals["delegated_account_id"] != nil && d.KeyColumnQuals["delegated_account_id"].GetStringValue() != *administrator.Id {
+		return nil, nil
+	}
+
+	// Get Client
+	svc, err := OrganizationClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_organizations_delegated_service.listOrganizationsDelegatedServices", "client_error", err)
+		return nil, err
+	}
+
+	params := &organizations.ListDelegatedServicesForAccountInput{
+		AccountId: administrator.Id,
+	}
+
+	paginator := organizations.NewListDelegatedServicesForAccountPaginator(svc, params, func(o *organizations.ListDelegatedServicesForAccountPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_organizations_delegated_service.listOrganizationsDelegatedServices", "api_error", err)
+			return nil, err
+		}
+
+		for _, service := range output.DelegatedServices {
+			d.StreamLeafListItem(ctx, organizationsDelegatedServiceInfo{service, administrator.Id})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
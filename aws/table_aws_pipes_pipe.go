@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsPipesPipe(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_pipes_pipe",
+		Description: "AWS EventBridge Pipes Pipe",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getPipesPipe,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPipesPipes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "current_state", Require: plugin.Optional},
+				{Name: "desired_state", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the pipe.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the pipe.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describePipesPipe,
+			},
+			{
+				Name:        "current_state",
+				Description: "The state the pipe is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "desired_state",
+				Description: "The state the pipe should be in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state_reason",
+				Description: "The reason the pipe is in its current state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The ARN of the source resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enrichment",
+				Description: "The ARN of the enrichment resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target",
+				Description: "The ARN of the target resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that the pipe uses to read from the source, call the enrichment and write to the target.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describePipesPipe,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time the pipe was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "When the pipe was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "filter_criteria",
+				Description: "The collection of event patterns used to filter events from the source.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describePipesPipe,
+				Transform:   transform.FromField("SourceParameters.FilterCriteria"),
+			},
+			{
+				Name:        "source_parameters",
+				Description: "The parameters required to set up the source for the pipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describePipesPipe,
+			},
+			{
+				Name:        "enrichment_parameters",
+				Description: "The parameters required to set up enrichment on the pipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describePipesPipe,
+			},
+			{
+				Name:        "target_parameters",
+				Description: "The parameters required to set up the target of the pipe.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describePipesPipe,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describePipesPipe,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPipesPipes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := PipesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_pipes_pipe.listPipesPipes", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &pipes.ListPipesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+
+	if d.KeyColumnQuals["current_state"] != nil {
+		input.CurrentState = types.PipeState(d.KeyColumnQuals["current_state"].GetStringValue())
+	}
+	if d.KeyColumnQuals["desired_state"] != nil {
+		input.DesiredState = types.RequestedPipeState(d.KeyColumnQuals["desired_state"].GetStringValue())
+	}
+
+	paginator := pipes.NewListPipesPaginator(svc, input, func(o *pipes.ListPipesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_pipes_pipe.listPipesPipes", "api_error", err)
+			return nil, err
+		}
+
+		for _, pipe := range output.Pipes {
+			d.StreamListItem(ctx, pipe)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPipesPipe(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	return describePipe(ctx, d, name)
+}
+
+// describePipesPipe returns the full pipe configuration, which is not
+// included in the list response
+func describePipesPipe(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *pipes.DescribePipeOutput:
+		return item, nil
+	case types.Pipe:
+		return describePipe(ctx, d, *item.Name)
+	}
+	return nil, nil
+}
+
+func describePipe(ctx context.Context, d *plugin.QueryData, name string) (*pipes.DescribePipeOutput, error) {
+	// Create Session
+	svc, err := PipesClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_pipes_pipe.describePipe", "connection_error", err)
+		return nil, err
+	}
+
+	params := &pipes.DescribePipeInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.DescribePipe(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_pipes_pipe.describePipe", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsQLDBJournalS3Export(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_qldb_journal_s3_export",
+		Description: "AWS QLDB Journal S3 Export",
+		List: &plugin.ListConfig{
+			Hydrate: listQLDBJournalS3Exports,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "ledger_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "export_id",
+				Description: "The UUID (represented in Base62-encoded text) of the journal export job.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ledger_name",
+				Description: "The name of the ledger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current state of the journal export job, which can be IN_PROGRESS, COMPLETED or CANCELLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "export_creation_time",
+				Description: "The date and time, in epoch time format, when the export job was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "inclusive_start_time",
+				Description: "The inclusive start date and time for the range of journal contents that was specified in the original export request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "exclusive_end_time",
+				Description: "The exclusive end date and time for the range of journal contents that was specified in the original export request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "output_format",
+				Description: "The output format of the exported journal data, which can be ION_BINARY, ION_TEXT or JSON.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role that grants QLDB permissions for a journal export job to write to the S3 bucket.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "s3_bucket",
+				Description: "The Amazon S3 bucket name in which the journal contents are exported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("S3ExportConfiguration.Bucket"),
+			},
+			{
+				Name:        "s3_prefix",
+				Description: "The prefix for the Amazon S3 bucket in which the journal contents are exported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("S3ExportConfiguration.Prefix"),
+			},
+			{
+				Name:        "object_encryption_type",
+				Description: "The Amazon S3 object encryption type, which can be SSE_KMS, SSE_S3 or NO_ENCRYPTION.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("S3ExportConfiguration.EncryptionConfiguration.ObjectEncryptionType"),
+			},
+			{
+				Name:        "kms_key_arn",
+				Description: "The Amazon Resource Name (ARN) of the symmetric key in KMS used to encrypt the exported objects, when the encryption type is SSE_KMS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("S3ExportConfiguration.EncryptionConfiguration.KmsKeyArn"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExportId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQLDBJournalS3Exports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QLDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_journal_s3_export.listQLDBJournalS3Exports", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// Exports of a single ledger have their own API
+	if d.KeyColumnQuals["ledger_name"] != nil {
+		input := &qldb.ListJournalS3ExportsForLedgerInput{
+			Name:       aws.String(d.KeyColumnQuals["ledger_name"].GetStringValue()),
+			MaxResults: aws.Int32(maxLimit),
+		}
+
+		paginator := qldb.NewListJournalS3ExportsForLedgerPaginator(svc, input, func(o *qldb.ListJournalS3ExportsForLedgerPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_qldb_journal_s3_export.listQLDBJournalS3Exports", "api_error", err)
+				return nil, err
+			}
+			if streamQLDBJournalS3Exports(ctx, d, output.JournalS3Exports) {
+				return nil, nil
+			}
+		}
+		return nil, nil
+	}
+
+	input := &qldb.ListJournalS3ExportsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := qldb.NewListJournalS3ExportsPaginator(svc, input, func(o *qldb.ListJournalS3ExportsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_qldb_journal_s3_export.listQLDBJournalS3Exports", "api_error", err)
+			return nil, err
+		}
+		if streamQLDBJournalS3Exports(ctx, d, output.JournalS3Exports) {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// streamQLDBJournalS3Exports streams the exports and reports whether the row limit has been hit
+func streamQLDBJournalS3Exports(ctx context.Context, d *plugin.QueryData, exports []types.JournalS3ExportDescription) bool {
+	for _, export := range exports {
+		d.StreamListItem(ctx, export)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsQLDBLedger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_qldb_ledger",
+		Description: "AWS QLDB Ledger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			},
+			Hydrate: getQLDBLedger,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQLDBLedgers,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getQLDBLedgerTags,
+				Depends: []plugin.HydrateFunc{getQLDBLedger},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the ledger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the ledger.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQLDBLedger,
+			},
+			{
+				Name:        "state",
+				Description: "The current status of the ledger, which can be CREATING, ACTIVE, DELETING or DELETED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date_time",
+				Description: "The date and time, in epoch time format, when the ledger was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "permissions_mode",
+				Description: "The permissions mode of the ledger, which can be ALLOW_ALL or STANDARD.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQLDBLedger,
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "Specifies whether the ledger is protected from being deleted by any user.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getQLDBLedger,
+			},
+			{
+				Name:        "encryption_status",
+				Description: "The current state of encryption at rest for the ledger, which can be ENABLED, UPDATING or KMS_KEY_INACCESSIBLE.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQLDBLedger,
+				Transform:   transform.FromField("EncryptionDescription.EncryptionStatus"),
+			},
+			{
+				Name:        "kms_key_arn",
+				Description: "The Amazon Resource Name (ARN) of the customer managed KMS key that the ledger uses for encryption at rest, or AWS_OWNED_KMS_KEY.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQLDBLedger,
+				Transform:   transform.FromField("EncryptionDescription.KmsKeyArn"),
+			},
+			{
+				Name:        "inaccessible_kms_key_date_time",
+				Description: "The date and time when the KMS key first became inaccessible, in the case of an error.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getQLDBLedger,
+				Transform:   transform.FromField("EncryptionDescription.InaccessibleKmsKeyDateTime"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQLDBLedgerTags,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQLDBLedger,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQLDBLedgers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QLDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_ledger.listQLDBLedgers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &qldb.ListLedgersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := qldb.NewListLedgersPaginator(svc, input, func(o *qldb.ListLedgersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_qldb_ledger.listQLDBLedgers", "api_error", err)
+			return nil, err
+		}
+
+		for _, ledger := range output.Ledgers {
+			d.StreamListItem(ctx, ledger)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQLDBLedger(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name string
+	if h.Item != nil {
+		name = *h.Item.(types.LedgerSummary).Name
+	} else {
+		name = d.KeyColumnQuals["name"].GetStringValue()
+	}
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := QLDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_ledger.getQLDBLedger", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &qldb.DescribeLedgerInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.DescribeLedger(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_ledger.getQLDBLedger", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getQLDBLedgerTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Ledger will be nil if getQLDBLedger returned an error but
+	// was ignored through ignore_error_codes config arg
+	if h.HydrateResults["getQLDBLedger"] == nil {
+		return nil, nil
+	}
+	ledgerArn := h.HydrateResults["getQLDBLedger"].(*qldb.DescribeLedgerOutput).Arn
+
+	// Create Session
+	svc, err := QLDBClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_ledger.getQLDBLedgerTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &qldb.ListTagsForResourceInput{
+		ResourceArn: ledgerArn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_qldb_ledger.getQLDBLedgerTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,354 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsQuickSightDashboard(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_quicksight_dashboard",
+		Description: "AWS QuickSight Dashboard",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("dashboard_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+			Hydrate: getQuickSightDashboard,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuickSightDashboards,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Returned when the account is not signed up for QuickSight
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The display name of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dashboard_id",
+				Description: "The ID of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "published_version_number",
+				Description: "The version number of the published version of the dashboard.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time that the dashboard was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_published_time",
+				Description: "The last time that the dashboard was published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that the dashboard was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "version_status",
+				Description: "The status of the current version of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeQuickSightDashboard,
+				Transform:   transform.FromField("Version.Status"),
+			},
+			{
+				Name:        "data_set_arns",
+				Description: "The Amazon Resource Names (ARNs) of the data sets used by the current version of the dashboard.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDashboard,
+				Transform:   transform.FromField("Version.DataSetArns"),
+			},
+			{
+				Name:        "version",
+				Description: "Details of the current version of the dashboard, including its source entity, sheets and errors.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDashboard,
+			},
+			{
+				Name:        "permissions",
+				Description: "The principals that have access to the dashboard and the actions they can perform.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightDashboardPermissions,
+				Transform:   transform.FromField("Permissions"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the dashboard.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags").Transform(quickSightTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuickSightDashboards(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.listQuickSightDashboards", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.listQuickSightDashboards", "common_data_error", err)
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &quicksight.ListDashboardsInput{
+		AwsAccountId: aws.String(accountID),
+	}
+
+	paginator := quicksight.NewListDashboardsPaginator(svc, input, func(o *quicksight.ListDashboardsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_quicksight_dashboard.listQuickSightDashboards", "api_error", err)
+			return nil, err
+		}
+
+		for _, dashboard := range output.DashboardSummaryList {
+			d.StreamListItem(ctx, dashboard)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuickSightDashboard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dashboardID := d.KeyColumnQuals["dashboard_id"].GetStringValue()
+
+	// check if dashboard id is empty
+	if dashboardID == "" {
+		return nil, nil
+	}
+
+	dashboard, err := describeDashboard(ctx, d, h, dashboardID)
+	if err != nil || dashboard == nil {
+		return nil, err
+	}
+
+	return types.DashboardSummary{
+		Arn:               dashboard.Arn,
+		CreatedTime:       dashboard.CreatedTime,
+		DashboardId:       dashboard.DashboardId,
+		LastPublishedTime: dashboard.LastPublishedTime,
+		LastUpdatedTime:   dashboard.LastUpdatedTime,
+		Name:              dashboard.Name,
+	}, nil
+}
+
+// describeQuickSightDashboard returns the details of the current version of
+// the dashboard, which are not included in the list response
+func describeQuickSightDashboard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dashboardID := h.Item.(types.DashboardSummary).DashboardId
+
+	dashboard, err := describeDashboard(ctx, d, h, *dashboardID)
+	if err != nil || dashboard == nil {
+		return nil, err
+	}
+
+	return dashboard, nil
+}
+
+func describeDashboard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, dashboardID string) (*types.Dashboard, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.describeDashboard", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.describeDashboard", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDashboardInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DashboardId:  aws.String(dashboardID),
+	}
+
+	op, err := svc.DescribeDashboard(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.describeDashboard", "api_error", err)
+		return nil, err
+	}
+
+	return op.Dashboard, nil
+}
+
+func getQuickSightDashboardPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dashboardID := h.Item.(types.DashboardSummary).DashboardId
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.getQuickSightDashboardPermissions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.getQuickSightDashboardPermissions", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDashboardPermissionsInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DashboardId:  dashboardID,
+	}
+
+	op, err := svc.DescribeDashboardPermissions(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_dashboard.getQuickSightDashboardPermissions", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// getQuickSightResourceTags returns the tags of a QuickSight dashboard, data
+// set or data source
+func getQuickSightResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.DashboardSummary:
+		arn = item.Arn
+	case types.DataSetSummary:
+		arn = item.Arn
+	case types.DataSource:
+		arn = item.Arn
+	}
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight.getQuickSightResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &quicksight.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight.getQuickSightResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func quickSightTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.Value.([]types.Tag)
+
+	if len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
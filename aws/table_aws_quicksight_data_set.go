@@ -0,0 +1,437 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsQuickSightDataSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_quicksight_data_set",
+		Description: "AWS QuickSight Data Set",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("data_set_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+			Hydrate: getQuickSightDataSet,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuickSightDataSets,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Returned when the account is not signed up for QuickSight
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The display name of the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_set_id",
+				Description: "The ID of the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the data set.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "import_mode",
+				Description: "Indicates whether the data set imports data into SPICE or queries the data source directly.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time that the data set was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that the data set was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "column_level_permission_rules_applied",
+				Description: "Indicates whether column-level permission rules are applied to the data set.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "row_level_permission_tag_configuration_applied",
+				Description: "Indicates whether tag-based row-level security is applied to the data set.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "row_level_permission_data_set",
+				Description: "The data set used for row-level security, including its ARN, format, policy and status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "row_level_permission_tag_configuration",
+				Description: "The tag-based row-level security configuration of the data set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "column_level_permission_rules",
+				Description: "The column-level permission rules of the data set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "consumed_spice_capacity_in_bytes",
+				Description: "The amount of SPICE capacity used by the data set, in bytes. This is 0 if the data set isn't imported into SPICE.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "latest_ingestion_id",
+				Description: "The ID of the most recent SPICE ingestion of the data set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQuickSightDataSetLatestIngestion,
+				Transform:   transform.FromField("IngestionId"),
+			},
+			{
+				Name:        "latest_ingestion_status",
+				Description: "The status of the most recent SPICE ingestion of the data set.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getQuickSightDataSetLatestIngestion,
+				Transform:   transform.FromField("IngestionStatus"),
+			},
+			{
+				Name:        "latest_ingestion_time",
+				Description: "The time that the most recent SPICE ingestion of the data set was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getQuickSightDataSetLatestIngestion,
+				Transform:   transform.FromField("CreatedTime"),
+			},
+			{
+				Name:        "latest_ingestion",
+				Description: "Details of the most recent SPICE ingestion of the data set, including errors and row counts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightDataSetLatestIngestion,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "physical_table_map",
+				Description: "The physical tables of the data set, declared from the underlying data sources.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "logical_table_map",
+				Description: "The logical tables of the data set, including their transforms and joins.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "output_columns",
+				Description: "The columns of the data set that are exposed to analyses and dashboards.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "data_set_usage_configuration",
+				Description: "Indicates whether the data set can be used as a source for other data sets.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeQuickSightDataSet,
+			},
+			{
+				Name:        "permissions",
+				Description: "The principals that have access to the data set and the actions they can perform.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightDataSetPermissions,
+				Transform:   transform.FromField("Permissions"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the data set.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags").Transform(quickSightTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuickSightDataSets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.listQuickSightDataSets", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.listQuickSightDataSets", "common_data_error", err)
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &quicksight.ListDataSetsInput{
+		AwsAccountId: aws.String(accountID),
+	}
+
+	paginator := quicksight.NewListDataSetsPaginator(svc, input, func(o *quicksight.ListDataSetsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_quicksight_data_set.listQuickSightDataSets", "api_error", err)
+			return nil, err
+		}
+
+		for _, dataSet := range output.DataSetSummaries {
+			d.StreamListItem(ctx, dataSet)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuickSightDataSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSetID := d.KeyColumnQuals["data_set_id"].GetStringValue()
+
+	// check if data set id is empty
+	if dataSetID == "" {
+		return nil, nil
+	}
+
+	dataSet, err := describeDataSet(ctx, d, h, dataSetID)
+	if err != nil || dataSet == nil {
+		return nil, err
+	}
+
+	return types.DataSetSummary{
+		Arn:                               dataSet.Arn,
+		ColumnLevelPermissionRulesApplied: len(dataSet.ColumnLevelPermissionRules) > 0,
+		CreatedTime:                       dataSet.CreatedTime,
+		DataSetId:                         dataSet.DataSetId,
+		ImportMode:                        dataSet.ImportMode,
+		LastUpdatedTime:                   dataSet.LastUpdatedTime,
+		Name:                              dataSet.Name,
+		RowLevelPermissionDataSet:         dataSet.RowLevelPermissionDataSet,
+		RowLevelPermissionTagConfigurationApplied: dataSet.RowLevelPermissionTagConfiguration != nil &&
+			dataSet.RowLevelPermissionTagConfiguration.Status != types.StatusDisabled,
+	}, nil
+}
+
+// describeQuickSightDataSet returns the full data set definition, which is not
+// included in the list response
+func describeQuickSightDataSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSetID := h.Item.(types.DataSetSummary).DataSetId
+
+	dataSet, err := describeDataSet(ctx, d, h, *dataSetID)
+	if err != nil || dataSet == nil {
+		return nil, err
+	}
+
+	return dataSet, nil
+}
+
+func describeDataSet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, dataSetID string) (*types.DataSet, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.describeDataSet", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.describeDataSet", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	op, err := svc.DescribeDataSet(ctx, params)
+	if err != nil {
+		// Data sets created from uploaded files can't be described through the
+		// API and return an InvalidParameterValueException
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "InvalidParameterValueException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.describeDataSet", "api_error", err)
+		return nil, err
+	}
+
+	return op.DataSet, nil
+}
+
+// getQuickSightDataSetLatestIngestion returns the most recent SPICE ingestion
+// of the data set, which reflects the status of its last refresh
+func getQuickSightDataSetLatestIngestion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSet := h.Item.(types.DataSetSummary)
+
+	// Only data sets imported into SPICE have ingestions
+	if dataSet.ImportMode != types.DataSetImportModeSpice {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetLatestIngestion", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetLatestIngestion", "common_data_error", err)
+		return nil, err
+	}
+
+	input := &quicksight.ListIngestionsInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DataSetId:    dataSet.DataSetId,
+	}
+
+	paginator := quicksight.NewListIngestionsPaginator(svc, input, func(o *quicksight.ListIngestionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	// The API does not guarantee any ordering, so keep the most recently
+	// created ingestion
+	var latest *types.Ingestion
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetLatestIngestion", "api_error", err)
+			return nil, err
+		}
+
+		for i, ingestion := range output.Ingestions {
+			if ingestion.CreatedTime == nil {
+				continue
+			}
+			if latest == nil || ingestion.CreatedTime.After(*latest.CreatedTime) {
+				latest = &output.Ingestions[i]
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, nil
+	}
+
+	return latest, nil
+}
+
+func getQuickSightDataSetPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSetID := h.Item.(types.DataSetSummary).DataSetId
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetPermissions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetPermissions", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DataSetId:    dataSetID,
+	}
+
+	op, err := svc.DescribeDataSetPermissions(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_set.getQuickSightDataSetPermissions", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsQuickSightDataSource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_quicksight_data_source",
+		Description: "AWS QuickSight Data Source",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("data_source_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+			Hydrate: getQuickSightDataSource,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuickSightDataSources,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Returned when the account is not signed up for QuickSight
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The display name of the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "data_source_id",
+				Description: "The ID of the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the data source.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the data source, such as ATHENA, REDSHIFT or S3.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the data source, such as CREATION_SUCCESSFUL or UPDATE_FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time that the data source was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that the data source was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "ssl_disabled",
+				Description: "Indicates whether SSL is disabled for connections to the data source.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SslProperties.DisableSsl"),
+			},
+			{
+				Name:        "vpc_connection_arn",
+				Description: "The Amazon Resource Name (ARN) of the VPC connection used to connect to the data source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VpcConnectionProperties.VpcConnectionArn"),
+			},
+			{
+				Name:        "data_source_parameters",
+				Description: "The parameters that QuickSight uses to connect to the underlying source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alternate_data_source_parameters",
+				Description: "A set of alternate data source parameters that QuickSight can use for the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "error_info",
+				Description: "Error information from the last update or the creation of the data source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "permissions",
+				Description: "The principals that have access to the data source and the actions they can perform.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightDataSourcePermissions,
+				Transform:   transform.FromField("Permissions"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the data source.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getQuickSightResourceTags,
+				Transform:   transform.FromField("Tags").Transform(quickSightTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuickSightDataSources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.listQuickSightDataSources", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.listQuickSightDataSources", "common_data_error", err)
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &quicksight.ListDataSourcesInput{
+		AwsAccountId: aws.String(accountID),
+	}
+
+	paginator := quicksight.NewListDataSourcesPaginator(svc, input, func(o *quicksight.ListDataSourcesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_quicksight_data_source.listQuickSightDataSources", "api_error", err)
+			return nil, err
+		}
+
+		for _, dataSource := range output.DataSources {
+			d.StreamListItem(ctx, dataSource)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuickSightDataSource(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSourceID := d.KeyColumnQuals["data_source_id"].GetStringValue()
+
+	// check if data source id is empty
+	if dataSourceID == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSource", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSource", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDataSourceInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DataSourceId: aws.String(dataSourceID),
+	}
+
+	op, err := svc.DescribeDataSource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSource", "api_error", err)
+		return nil, err
+	}
+
+	if op.DataSource == nil {
+		return nil, nil
+	}
+
+	return *op.DataSource, nil
+}
+
+func getQuickSightDataSourcePermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dataSourceID := h.Item.(types.DataSource).DataSourceId
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSourcePermissions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSourcePermissions", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeDataSourcePermissionsInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		DataSourceId: dataSourceID,
+	}
+
+	op, err := svc.DescribeDataSourcePermissions(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_data_source.getQuickSightDataSourcePermissions", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type quickSightUserInfo = struct {
+	types.User
+	Namespace *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsQuickSightUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_quicksight_user",
+		Description: "AWS QuickSight User",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"user_name", "namespace"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException", "AccessDeniedException"}),
+			},
+			Hydrate: getQuickSightUser,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listQuickSightUsers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Users can only be managed from the identity region of the account,
+				// other regions return an AccessDeniedException. A
+				// ResourceNotFoundException is returned when the account is not
+				// signed up for QuickSight.
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "UnsupportedUserEditionException", "AccessDeniedException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "user_name",
+				Description: "The user's user name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The namespace of the user. Defaults to default.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email",
+				Description: "The user's email address.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The QuickSight role of the user, such as ADMIN, AUTHOR or READER.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of identity authentication used by the user, either IAM or QUICKSIGHT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Indicates whether the user is active. An inactive user has been created but has not yet accepted the invitation.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The principal ID of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "custom_permissions_name",
+				Description: "The custom permissions profile associated with the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_login_federation_provider_type",
+				Description: "The type of supported external login provider that provides identity to let the user federate into QuickSight.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_login_federation_provider_url",
+				Description: "The URL of the external login provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_login_id",
+				Description: "The identity ID for the user in the external login provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listQuickSightUsers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_user.listQuickSightUsers", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_user.listQuickSightUsers", "common_data_error", err)
+		return nil, err
+	}
+	accountID := commonData.(*awsCommonColumnData).AccountId
+
+	namespace := "default"
+	if d.KeyColumnQuals["namespace"] != nil {
+		namespace = d.KeyColumnQuals["namespace"].GetStringValue()
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &quicksight.ListUsersInput{
+		AwsAccountId: aws.String(accountID),
+		Namespace:    aws.String(namespace),
+	}
+
+	paginator := quicksight.NewListUsersPaginator(svc, input, func(o *quicksight.ListUsersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_quicksight_user.listQuickSightUsers", "api_error", err)
+			return nil, err
+		}
+
+		for _, user := range output.UserList {
+			d.StreamListItem(ctx, quickSightUserInfo{user, aws.String(namespace)})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getQuickSightUser(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	userName := d.KeyColumnQuals["user_name"].GetStringValue()
+	namespace := d.KeyColumnQuals["namespace"].GetStringValue()
+
+	// check if user name or namespace is empty
+	if userName == "" || namespace == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := QuickSightClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_user.getQuickSightUser", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_user.getQuickSightUser", "common_data_error", err)
+		return nil, err
+	}
+
+	params := &quicksight.DescribeUserInput{
+		AwsAccountId: aws.String(commonData.(*awsCommonColumnData).AccountId),
+		Namespace:    aws.String(namespace),
+		UserName:     aws.String(userName),
+	}
+
+	op, err := svc.DescribeUser(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_quicksight_user.getQuickSightUser", "api_error", err)
+		return nil, err
+	}
+
+	if op.User == nil {
+		return nil, nil
+	}
+
+	return quickSightUserInfo{*op.User, aws.String(namespace)}, nil
+}
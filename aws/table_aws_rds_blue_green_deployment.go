@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSBlueGreenDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_blue_green_deployment",
+		Description: "AWS RDS Blue/Green Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("blue_green_deployment_identifier"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"BlueGreenDeploymentNotFoundFault"}),
+			},
+			Hydrate: getRDSBlueGreenDeployment,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSBlueGreenDeployments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "blue_green_deployment_name", Require: plugin.Optional},
+				{Name: "source", Require: plugin.Optional},
+				{Name: "target", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "blue_green_deployment_name",
+				Description: "The user-supplied name of the blue/green deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "blue_green_deployment_identifier",
+				Description: "The system-generated identifier of the blue/green deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the blue/green deployment, which can be PROVISIONING, AVAILABLE, SWITCHOVER_IN_PROGRESS, SWITCHOVER_COMPLETED, INVALID_CONFIGURATION, SWITCHOVER_FAILED or DELETING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_details",
+				Description: "Additional information about the status of the blue/green deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The source database for the blue/green deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target",
+				Description: "The target database for the blue/green deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time when the blue/green deployment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "delete_time",
+				Description: "The time when the blue/green deployment was deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "switchover_details",
+				Description: "The details about each source and target resource in the blue/green deployment, including their switchover status.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tasks",
+				Description: "Either tasks to be performed or tasks that have been completed on the target database before switchover.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the blue/green deployment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BlueGreenDeploymentName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getRDSBlueGreenDeploymentTurbotTags),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSBlueGreenDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_blue_green_deployment.listRDSBlueGreenDeployments", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rds.DescribeBlueGreenDeploymentsInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+
+	filters := buildRdsBlueGreenDeploymentFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := rds.NewDescribeBlueGreenDeploymentsPaginator(svc, input, func(o *rds.DescribeBlueGreenDeploymentsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_blue_green_deployment.listRDSBlueGreenDeployments", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.BlueGreenDeployments {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSBlueGreenDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	identifier := d.KeyColumnQuals["blue_green_deployment_identifier"].GetStringValue()
+
+	// Empty check
+	if identifier == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_blue_green_deployment.getRDSBlueGreenDeployment", "connection_error", err)
+		return nil, err
+	}
+
+	params := &rds.DescribeBlueGreenDeploymentsInput{
+		BlueGreenDeploymentIdentifier: aws.String(identifier),
+	}
+
+	op, err := svc.DescribeBlueGreenDeployments(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_blue_green_deployment.getRDSBlueGreenDeployment", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.BlueGreenDeployments) > 0 {
+		return op.BlueGreenDeployments[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSBlueGreenDeploymentTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	deployment := d.HydrateItem.(types.BlueGreenDeployment)
+
+	if deployment.TagList != nil {
+		turbotTagsMap := map[string]string{}
+		for _, i := range deployment.TagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+		return turbotTagsMap, nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build blue/green deployment list call input filter
+func buildRdsBlueGreenDeploymentFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+	filterQuals := map[string]string{
+		"blue_green_deployment_name": "blue-green-deployment-name",
+		"source":                     "source",
+		"target":                     "target",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBClusterEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_cluster_endpoint",
+		Description: "AWS RDS DB Cluster Endpoint",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("db_cluster_endpoint_identifier"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DBClusterNotFoundFault", "InvalidParameterValue"}),
+			},
+			Hydrate: getRDSDBClusterEndpoint,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBClusterEndpoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "db_cluster_identifier", Require: plugin.Optional},
+				{Name: "endpoint_type", Require: plugin.Optional},
+				{Name: "custom_endpoint_type", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_cluster_endpoint_identifier",
+				Description: "The identifier associated with the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterEndpointIdentifier"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterEndpointArn"),
+			},
+			{
+				Name:        "db_cluster_identifier",
+				Description: "The DB cluster identifier of the DB cluster associated with the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterIdentifier"),
+			},
+			{
+				Name:        "db_cluster_endpoint_resource_identifier",
+				Description: "A unique system-generated identifier for an endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterEndpointResourceIdentifier"),
+			},
+			{
+				Name:        "endpoint",
+				Description: "The DNS address of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "The type of the endpoint, which can be READER, WRITER or CUSTOM.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "custom_endpoint_type",
+				Description: "The type associated with a custom endpoint, which can be READER or ANY.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the endpoint, which can be available, creating, deleting, inactive or modifying.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "static_members",
+				Description: "List of DB instance identifiers that are part of the custom endpoint group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "excluded_members",
+				Description: "List of DB instance identifiers that aren't part of the custom endpoint group. All other eligible instances are reachable through the custom endpoint.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBClusterEndpointIdentifier"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getRDSDBClusterEndpointAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBClusterEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_cluster_endpoint.listRDSDBClusterEndpoints", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rds.DescribeDBClusterEndpointsInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+
+	filters := buildRdsDbClusterEndpointFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	paginator := rds.NewDescribeDBClusterEndpointsPaginator(svc, input, func(o *rds.DescribeDBClusterEndpointsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_db_cluster_endpoint.listRDSDBClusterEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.DBClusterEndpoints {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBClusterEndpoint(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	identifier := d.KeyColumnQuals["db_cluster_endpoint_identifier"].GetStringValue()
+
+	// Empty check
+	if identifier == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_cluster_endpoint.getRDSDBClusterEndpoint", "connection_error", err)
+		return nil, err
+	}
+
+	params := &rds.DescribeDBClusterEndpointsInput{
+		DBClusterEndpointIdentifier: aws.String(identifier),
+	}
+
+	op, err := svc.DescribeDBClusterEndpoints(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_cluster_endpoint.getRDSDBClusterEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.DBClusterEndpoints) > 0 {
+		return op.DBClusterEndpoints[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The writer and reader endpoints managed by RDS do not have an ARN, so akas falls back to the endpoint address
+func getRDSDBClusterEndpointAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	endpoint := d.HydrateItem.(types.DBClusterEndpoint)
+
+	if endpoint.DBClusterEndpointArn != nil {
+		return []string{*endpoint.DBClusterEndpointArn}, nil
+	}
+	if endpoint.Endpoint != nil {
+		return []string{*endpoint.Endpoint}, nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build DB cluster endpoint list call input filter
+func buildRdsDbClusterEndpointFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+	filterQuals := map[string]string{
+		"db_cluster_identifier": "db-cluster-id",
+		"endpoint_type":         "db-cluster-endpoint-type",
+		"custom_endpoint_type":  "db-cluster-endpoint-custom-type",
+		"status":                "db-cluster-endpoint-status",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBGlobalCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_global_cluster",
+		Description: "AWS RDS DB Global Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("global_cluster_identifier"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"GlobalClusterNotFoundFault"}),
+			},
+			Hydrate: getRDSDBGlobalCluster,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRDSDBGlobalClusters,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "global_cluster_identifier",
+				Description: "The user-supplied identifier of the global database cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the global database cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalClusterArn"),
+			},
+			{
+				Name:        "global_cluster_resource_id",
+				Description: "The Amazon Web Services Region-unique, immutable identifier for the global database cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Specifies the current state of this global database cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine",
+				Description: "The Aurora database engine used by the global database cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "engine_version",
+				Description: "Indicates the database engine version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_name",
+				Description: "The default database name within the new global database cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deletion_protection",
+				Description: "The deletion protection setting for the global database cluster.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "storage_encrypted",
+				Description: "The storage encryption setting for the global database cluster.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "writer_cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the primary DB cluster of the global database cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getRDSDBGlobalClusterWriterArn),
+			},
+			{
+				Name:        "failover_state",
+				Description: "A data object containing all properties for the current state of an in-process or pending switchover or failover process for this global cluster.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "global_cluster_members",
+				Description: "The list of primary and secondary clusters within the global database cluster, with the writer flag, readers and write forwarding status of each member.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalClusterIdentifier"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GlobalClusterArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBGlobalClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// Create Session
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_global_cluster.listRDSDBGlobalClusters", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rds.DescribeGlobalClustersInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+
+	paginator := rds.NewDescribeGlobalClustersPaginator(svc, input, func(o *rds.DescribeGlobalClustersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_db_global_cluster.listRDSDBGlobalClusters", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.GlobalClusters {
+			// Global clusters are returned by every region, so only report each one
+			// from the region hosting its primary cluster
+			if !isRDSDBGlobalClusterHomeRegion(item, region) {
+				continue
+			}
+
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRDSDBGlobalCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	identifier := d.KeyColumnQuals["global_cluster_identifier"].GetStringValue()
+
+	// Empty check
+	if identifier == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_global_cluster.getRDSDBGlobalCluster", "connection_error", err)
+		return nil, err
+	}
+
+	params := &rds.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(identifier),
+	}
+
+	op, err := svc.DescribeGlobalClusters(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_global_cluster.getRDSDBGlobalCluster", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.GlobalClusters) > 0 && isRDSDBGlobalClusterHomeRegion(op.GlobalClusters[0], region) {
+		return op.GlobalClusters[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getRDSDBGlobalClusterWriterArn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	globalCluster := d.HydrateItem.(types.GlobalCluster)
+
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(member.IsWriter) {
+			return member.DBClusterArn, nil
+		}
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// A global cluster belongs to the region of its writer member. Global clusters
+// without a writer (e.g. with no attached clusters) are reported in every region.
+func isRDSDBGlobalClusterHomeRegion(globalCluster types.GlobalCluster, region string) bool {
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(member.IsWriter) && member.DBClusterArn != nil {
+			arnData, err := arn.Parse(*member.DBClusterArn)
+			if err != nil {
+				return true
+			}
+			return arnData.Region == region
+		}
+	}
+	return true
+}
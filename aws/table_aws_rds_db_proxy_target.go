@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type rdsDBProxyTargetInfo struct {
+	DBProxyName     *string
+	TargetGroupName *string
+	types.DBProxyTarget
+}
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBProxyTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_proxy_target",
+		Description: "AWS RDS DB Proxy Target",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRDSDBProxies,
+			Hydrate:       listRDSDBProxyTargets,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DBProxyNotFoundFault", "DBProxyTargetGroupNotFoundFault"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "db_proxy_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "db_proxy_name",
+				Description: "The identifier for the RDS proxy the target belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "target_group_name",
+				Description: "The identifier for the target group the target belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_arn",
+				Description: "The Amazon Resource Name (ARN) for the RDS DB instance or Aurora DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rds_resource_id",
+				Description: "The identifier representing the target. It can be the instance identifier for an RDS DB instance, or the cluster identifier for an Aurora DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Specifies the kind of database, such as an RDS DB instance or an Aurora DB cluster, that acts as a target for the proxy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "A value that indicates whether the target of the proxy can be used for read/write or read-only operations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "endpoint",
+				Description: "The writer endpoint for the RDS DB instance or Aurora DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "port",
+				Description: "The port that the RDS Proxy uses to connect to the target RDS DB instance or Aurora DB cluster.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tracked_cluster_id",
+				Description: "The DB cluster identifier when the target represents an Aurora DB cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_health_state",
+				Description: "The current state of the connection, which can be REGISTERING, AVAILABLE, UNAVAILABLE or UNUSED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetHealth.State"),
+			},
+			{
+				Name:        "target_health_reason",
+				Description: "The reason for the current health state of the RDS Proxy target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetHealth.Reason"),
+			},
+			{
+				Name:        "target_health_description",
+				Description: "A description of the health of the RDS Proxy target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetHealth.Description"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RdsResourceId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TargetArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBProxyTargets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	proxy := h.Item.(types.DBProxy)
+
+	// Minimize the API call with the given proxy name
+	if d.KeyColumnQuals["db_proxy_name"] != nil {
+		if d.KeyColumnQuals["db_proxy_name"].GetStringValue() != *proxy.DBProxyName {
+			return nil, nil
+		}
+	}
+
+	// Create Session
+	svc, err := RDSDBProxyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_proxy_target.listRDSDBProxyTargets", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Targets do not carry the name of their target group, so they are listed per target group
+	groupPaginator := rds.NewDescribeDBProxyTargetGroupsPaginator(svc, &rds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName: proxy.DBProxyName,
+		MaxRecords:  aws.Int32(100),
+	}, func(o *rds.DescribeDBProxyTargetGroupsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for groupPaginator.HasMorePages() {
+		groups, err := groupPaginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_db_proxy_target.listRDSDBProxyTargets", "api_error", err)
+			return nil, err
+		}
+
+		for _, group := range groups.TargetGroups {
+			input := &rds.DescribeDBProxyTargetsInput{
+				DBProxyName:     proxy.DBProxyName,
+				TargetGroupName: group.TargetGroupName,
+				MaxRecords:      aws.Int32(100),
+			}
+
+			paginator := rds.NewDescribeDBProxyTargetsPaginator(svc, input, func(o *rds.DescribeDBProxyTargetsPaginatorOptions) {
+				o.StopOnDuplicateToken = true
+			})
+
+			for paginator.HasMorePages() {
+				output, err := paginator.NextPage(ctx)
+				if err != nil {
+					plugin.Logger(ctx).Error("aws_rds_db_proxy_target.listRDSDBProxyTargets", "api_error", err)
+					return nil, err
+				}
+
+				for _, target := range output.Targets {
+					d.StreamListItem(ctx, rdsDBProxyTargetInfo{
+						DBProxyName:     proxy.DBProxyName,
+						TargetGroupName: group.TargetGroupName,
+						DBProxyTarget:   target,
+					})
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
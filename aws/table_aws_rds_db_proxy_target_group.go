@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSDBProxyTargetGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_db_proxy_target_group",
+		Description: "AWS RDS DB Proxy Target Group",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRDSDBProxies,
+			Hydrate:       listRDSDBProxyTargetGroups,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"DBProxyNotFoundFault", "DBProxyTargetGroupNotFoundFault"}),
+			},
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "db_proxy_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "target_group_name",
+				Description: "The identifier for the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_group_arn",
+				Description: "The Amazon Resource Name (ARN) representing the target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_proxy_name",
+				Description: "The identifier for the RDS proxy associated with this target group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DBProxyName"),
+			},
+			{
+				Name:        "is_default",
+				Description: "Whether this target group is the first one used for connection requests by the associated proxy.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of this target group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date and time when the target group was first created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_date",
+				Description: "The date and time when the target group was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "max_connections_percent",
+				Description: "The maximum size of the connection pool for each target in a target group, expressed as a percentage of the max_connections setting of the database.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConnectionPoolConfig.MaxConnectionsPercent"),
+			},
+			{
+				Name:        "max_idle_connections_percent",
+				Description: "Controls how actively the proxy closes idle database connections in the connection pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConnectionPoolConfig.MaxIdleConnectionsPercent"),
+			},
+			{
+				Name:        "connection_borrow_timeout",
+				Description: "The number of seconds for a proxy to wait for a connection to become available in the connection pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConnectionPoolConfig.ConnectionBorrowTimeout"),
+			},
+			{
+				Name:        "connection_pool_config",
+				Description: "The settings that determine the size and behavior of the connection pool for the target group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TargetGroupName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TargetGroupArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSDBProxyTargetGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	proxy := h.Item.(types.DBProxy)
+
+	// Minimize the API call with the given proxy name
+	if d.KeyColumnQuals["db_proxy_name"] != nil {
+		if d.KeyColumnQuals["db_proxy_name"].GetStringValue() != *proxy.DBProxyName {
+			return nil, nil
+		}
+	}
+
+	// Create Session
+	svc, err := RDSDBProxyClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_db_proxy_target_group.listRDSDBProxyTargetGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName: proxy.DBProxyName,
+		MaxRecords:  aws.Int32(maxLimit),
+	}
+
+	paginator := rds.NewDescribeDBProxyTargetGroupsPaginator(svc, input, func(o *rds.DescribeDBProxyTargetGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_db_proxy_target_group.listRDSDBProxyTargetGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.TargetGroups {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
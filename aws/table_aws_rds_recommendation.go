@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRDSRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rds_recommendation",
+		Description: "AWS RDS Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listRDSRecommendations,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "recommendation_id", Require: plugin.Optional},
+				{Name: "type_id", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "updated_time", Operators: []string{">", ">=", "<", "<="}, Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "recommendation_id",
+				Description: "The unique identifier of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_id",
+				Description: "A value that indicates the type of recommendation, e.g. config_recommendation::old_engine_version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity level of the recommendation, which can be high, medium, low or informational.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the recommendation, which can be active, dismissed, pending or resolved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the recommendation, e.g. performance efficiency, security, reliability or cost optimization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) of the RDS resource associated with the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time when the recommendation was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_time",
+				Description: "The time when the recommendation was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "A detailed description of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "detection",
+				Description: "A short description of the issue identified for this recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation",
+				Description: "A short description of the recommendation to resolve an issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "The reason why this recommendation was created.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "impact",
+				Description: "A short description that explains the possible impact of an issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The Amazon Web Services service that generated the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_detection",
+				Description: "A short description of the recommendation type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type_recommendation",
+				Description: "A short description that summarizes the recommendation to fix all the issues of the recommendation type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "additional_info",
+				Description: "Additional information about the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommended_actions",
+				Description: "A list of recommended actions.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "issue_details",
+				Description: "Details of the issue that caused the recommendation.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "links",
+				Description: "A link to documentation that provides additional information about the recommendation.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRDSRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RDSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rds_recommendation.listRDSRecommendations", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 20 {
+				maxLimit = 20
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rds.DescribeDBRecommendationsInput{
+		MaxRecords: aws.Int32(maxLimit),
+	}
+
+	filters := buildRdsRecommendationFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	if d.Quals["updated_time"] != nil {
+		for _, q := range d.Quals["updated_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">=", ">":
+				input.LastUpdatedAfter = aws.Time(timestamp)
+			case "<", "<=":
+				input.LastUpdatedBefore = aws.Time(timestamp)
+			}
+		}
+	}
+
+	paginator := rds.NewDescribeDBRecommendationsPaginator(svc, input, func(o *rds.DescribeDBRecommendationsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rds_recommendation.listRDSRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.DBRecommendations {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Build recommendation list call input filter
+func buildRdsRecommendationFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+	filterQuals := map[string]string{
+		"recommendation_id": "recommendation-id",
+		"type_id":           "type-id",
+		"severity":          "severity",
+		"status":            "status",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,300 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+	"github.com/aws/smithy-go"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type redshiftDataStatementRow struct {
+	StatementId       *string
+	ClusterIdentifier *string
+	WorkgroupName     *string
+	Database          *string
+	DbUser            *string
+	SecretArn         *string
+	Sql               *string
+	Timeout           int64
+	Region            string
+	RowNumber         int
+	Result            map[string]interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableAwsRedshiftDataStatement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_redshift_data_statement",
+		Description: "AWS Redshift Data Statement",
+		List: &plugin.ListConfig{
+			Hydrate: listRedshiftDataStatementResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "sql", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "database", Require: plugin.Required},
+				{Name: "cluster_identifier", Require: plugin.Optional},
+				{Name: "workgroup_name", Require: plugin.Optional},
+				{Name: "db_user", Require: plugin.Optional},
+				{Name: "secret_arn", Require: plugin.Optional},
+				{Name: "timeout", Require: plugin.Optional},
+				// The statement is run once, in the region of the cluster or workgroup
+				{Name: "region", Require: plugin.Required},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreRedshiftDataTargetNotFoundError,
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "sql",
+				Description: "The SQL statement to run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database",
+				Description: "The name of the database to run the statement against.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_identifier",
+				Description: "The cluster identifier. Required when connecting to a provisioned cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workgroup_name",
+				Description: "The serverless workgroup name. Required when connecting to a serverless workgroup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "db_user",
+				Description: "The database user name, used to retrieve temporary credentials when connecting to a provisioned cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "secret_arn",
+				Description: "The name or ARN of the secret that enables access to the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timeout",
+				Description: "The maximum number of seconds to wait for the statement to complete before it is cancelled. Defaults to 300.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the cluster or workgroup the statement runs on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement_id",
+				Description: "The identifier of the SQL statement, generated by Amazon Redshift Data API.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "row_number",
+				Description: "The position of the row in the result set, starting at 1.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "result",
+				Description: "The row returned by the statement, as a JSON object keyed by column name.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatementId"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedshiftDataStatementResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	equalQuals := d.KeyColumnQuals
+	clusterIdentifier := equalQuals["cluster_identifier"].GetStringValue()
+	workgroupName := equalQuals["workgroup_name"].GetStringValue()
+
+	// The statement must target either a provisioned cluster or a serverless workgroup
+	if clusterIdentifier == "" && workgroupName == "" {
+		return nil, nil
+	}
+
+	region := equalQuals["region"].GetStringValue()
+
+	timeout := int64(300)
+	if equalQuals["timeout"] != nil {
+		timeout = equalQuals["timeout"].GetInt64Value()
+	}
+
+	// Create Session
+	svc, err := RedshiftDataClient(ctx, d, region)
+	if err != nil {
+		logger.Error("aws_redshift_data_statement.listRedshiftDataStatementResults", "service_creation_error", err)
+		return nil, err
+	}
+
+	input := &redshiftdata.ExecuteStatementInput{
+		Database: aws.String(equalQuals["database"].GetStringValue()),
+		Sql:      aws.String(equalQuals["sql"].GetStringValue()),
+	}
+	if clusterIdentifier != "" {
+		input.ClusterIdentifier = aws.String(clusterIdentifier)
+	}
+	if workgroupName != "" {
+		input.WorkgroupName = aws.String(workgroupName)
+	}
+	if equalQuals["db_user"] != nil {
+		input.DbUser = aws.String(equalQuals["db_user"].GetStringValue())
+	}
+	if equalQuals["secret_arn"] != nil {
+		input.SecretArn = aws.String(equalQuals["secret_arn"].GetStringValue())
+	}
+
+	execOutput, err := svc.ExecuteStatement(ctx, input)
+	if err != nil {
+		logger.Error("aws_redshift_data_statement.listRedshiftDataStatementResults", "api_error", err)
+		return nil, err
+	}
+
+	statement, err := waitForRedshiftDataStatement(ctx, svc, execOutput.Id, time.Duration(timeout)*time.Second)
+	if err != nil {
+		logger.Error("aws_redshift_data_statement.listRedshiftDataStatementResults", "wait_error", err)
+		return nil, err
+	}
+
+	row := redshiftDataStatementRow{
+		StatementId:       execOutput.Id,
+		ClusterIdentifier: input.ClusterIdentifier,
+		WorkgroupName:     input.WorkgroupName,
+		Database:          input.Database,
+		DbUser:            input.DbUser,
+		SecretArn:         input.SecretArn,
+		Sql:               input.Sql,
+		Timeout:           timeout,
+		Region:            region,
+	}
+
+	// Statements such as DDL or DML do not return a result set, so stream a
+	// single row to surface the statement ID
+	if !aws.ToBool(statement.HasResultSet) {
+		d.StreamListItem(ctx, row)
+		return nil, nil
+	}
+
+	paginator := redshiftdata.NewGetStatementResultPaginator(svc, &redshiftdata.GetStatementResultInput{Id: execOutput.Id}, func(o *redshiftdata.GetStatementResultPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	rowNumber := 0
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Error("aws_redshift_data_statement.listRedshiftDataStatementResults", "api_error", err)
+			return nil, err
+		}
+
+		for _, record := range output.Records {
+			rowNumber++
+			result := map[string]interface{}{}
+			for i, field := range record {
+				name := fmt.Sprintf("column_%d", i+1)
+				if i < len(output.ColumnMetadata) && output.ColumnMetadata[i].Name != nil {
+					name = *output.ColumnMetadata[i].Name
+				}
+				result[name] = redshiftDataFieldValue(field)
+			}
+
+			item := row
+			item.RowNumber = rowNumber
+			item.Result = result
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// waitForRedshiftDataStatement polls DescribeStatement until the statement
+// reaches a terminal state, backing off between calls. The statement is
+// cancelled if it does not complete within the given timeout.
+func waitForRedshiftDataStatement(ctx context.Context, svc *redshiftdata.Client, id *string, timeout time.Duration) (*redshiftdata.DescribeStatementOutput, error) {
+	var output *redshiftdata.DescribeStatementOutput
+	describe := func() (bool, error) {
+		var err error
+		output, err = svc.DescribeStatement(ctx, &redshiftdata.DescribeStatementInput{Id: id})
+		if err != nil {
+			return false, err
+		}
+
+		switch output.Status {
+		case types.StatusStringFinished:
+			return true, nil
+		case types.StatusStringFailed, types.StatusStringAborted:
+			return false, fmt.Errorf("%s: %s", output.Status, aws.ToString(output.Error))
+		}
+		return false, nil
+	}
+	// Cancel the statement so it does not keep running on the cluster
+	cancel := func() {
+		_, _ = svc.CancelStatement(context.Background(), &redshiftdata.CancelStatementInput{Id: id})
+	}
+
+	if err := pollUntilTerminal(ctx, timeout, describe, cancel); err != nil {
+		return nil, fmt.Errorf("statement %s %v", aws.ToString(id), err)
+	}
+	return output, nil
+}
+
+// ExecuteStatement reports a cluster or workgroup that does not exist as a
+// ValidationException, so only ignore the validation errors for that case
+func shouldIgnoreRedshiftDataTargetNotFoundError(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+	var ae smithy.APIError
+	if errors.As(err, &ae) && ae.ErrorCode() == "ValidationException" {
+		message := strings.ToLower(ae.ErrorMessage())
+		if strings.Contains(message, "not found") || strings.Contains(message, "doesn't exist") || strings.Contains(message, "does not exist") {
+			return true
+		}
+	}
+	return shouldIgnoreErrors([]string{})(ctx, d, h, err)
+}
+
+func redshiftDataFieldValue(field types.Field) interface{} {
+	switch v := field.(type) {
+	case *types.FieldMemberIsNull:
+		return nil
+	case *types.FieldMemberBooleanValue:
+		return v.Value
+	case *types.FieldMemberLongValue:
+		return v.Value
+	case *types.FieldMemberDoubleValue:
+		return v.Value
+	case *types.FieldMemberStringValue:
+		return v.Value
+	case *types.FieldMemberBlobValue:
+		return v.Value
+	}
+	return nil
+}
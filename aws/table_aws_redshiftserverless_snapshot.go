@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRedshiftServerlessSnapshot(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_redshiftserverless_snapshot",
+		Description: "AWS Redshift Serverless Snapshot",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("snapshot_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRedshiftServerlessSnapshot,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedshiftServerlessSnapshots,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace_name", Require: plugin.Optional},
+				{Name: "owner_account", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "snapshot_name",
+				Description: "The name of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_arn",
+				Description: "The Amazon Resource Name (ARN) of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the namespace the snapshot was created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace_arn",
+				Description: "The Amazon Resource Name (ARN) of the namespace the snapshot was created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_create_time",
+				Description: "The timestamp of when the snapshot was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The owner Amazon Web Services account of the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "admin_username",
+				Description: "The username of the database within a snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The unique identifier of the KMS key used to encrypt the snapshot.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_retention_period",
+				Description: "The period of time, in days, of how long the snapshot is retained.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "snapshot_remaining_days",
+				Description: "The amount of days until the snapshot is deleted.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "snapshot_retention_start_time",
+				Description: "The timestamp of when data within the snapshot started getting retained.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "total_backup_size_in_mega_bytes",
+				Description: "The total size, in megabytes, of how big the snapshot is.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "actual_incremental_backup_size_in_mega_bytes",
+				Description: "The size in megabytes of the incremental data backed up in the snapshot.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "elapsed_time_in_seconds",
+				Description: "The amount of time it took to back up data into a snapshot.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "accounts_with_restore_access",
+				Description: "All of the Amazon Web Services accounts that have access to restore a snapshot to a namespace.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotName"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SnapshotArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedshiftServerlessSnapshots(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Session
+	svc, err := RedshiftServerlessClient(ctx, d)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_snapshot.listRedshiftServerlessSnapshots", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &redshiftserverless.ListSnapshotsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["namespace_name"] != nil {
+		input.NamespaceName = aws.String(equalQuals["namespace_name"].GetStringValue())
+	}
+	if equalQuals["owner_account"] != nil {
+		input.OwnerAccount = aws.String(equalQuals["owner_account"].GetStringValue())
+	}
+
+	paginator := redshiftserverless.NewListSnapshotsPaginator(svc, input, func(o *redshiftserverless.ListSnapshotsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Error("aws_redshiftserverless_snapshot.listRedshiftServerlessSnapshots", "api_error", err)
+			return nil, err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			d.StreamListItem(ctx, snapshot)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedshiftServerlessSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	name := d.KeyColumnQuals["snapshot_name"].GetStringValue()
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RedshiftServerlessClient(ctx, d)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_snapshot.getRedshiftServerlessSnapshot", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build params
+	params := &redshiftserverless.GetSnapshotInput{
+		SnapshotName: aws.String(name),
+	}
+
+	op, err := svc.GetSnapshot(ctx, params)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_snapshot.getRedshiftServerlessSnapshot", "api_error", err)
+		return nil, err
+	}
+	return *op.Snapshot, nil
+}
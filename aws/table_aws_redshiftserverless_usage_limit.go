@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRedshiftServerlessUsageLimit(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_redshiftserverless_usage_limit",
+		Description: "AWS Redshift Serverless Usage Limit",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("usage_limit_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getRedshiftServerlessUsageLimit,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedshiftServerlessUsageLimits,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "resource_arn", Require: plugin.Optional},
+				{Name: "usage_type", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "usage_limit_id",
+				Description: "The identifier of the usage limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_limit_arn",
+				Description: "The Amazon Resource Name (ARN) of the resource associated with the usage limit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_arn",
+				Description: "The Amazon Resource Name (ARN) that identifies the Amazon Redshift Serverless resource the usage limit applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "usage_type",
+				Description: "The Amazon Redshift Serverless feature to limit, which can be serverless-compute or cross-region-datasharing.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "amount",
+				Description: "The limit amount. If time-based, this amount is in RPUs consumed per hour. If data-based, this amount is in terabytes (TB).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "period",
+				Description: "The time period that the amount applies to, which can be daily, weekly or monthly.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "breach_action",
+				Description: "The action that Amazon Redshift Serverless takes when the limit is reached, which can be log, emit-metric or deactivate.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UsageLimitId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UsageLimitArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedshiftServerlessUsageLimits(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Session
+	svc, err := RedshiftServerlessClient(ctx, d)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_usage_limit.listRedshiftServerlessUsageLimits", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &redshiftserverless.ListUsageLimitsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	equalQuals := d.KeyColumnQuals
+	if equalQuals["resource_arn"] != nil {
+		input.ResourceArn = aws.String(equalQuals["resource_arn"].GetStringValue())
+	}
+	if equalQuals["usage_type"] != nil {
+		input.UsageType = types.UsageLimitUsageType(equalQuals["usage_type"].GetStringValue())
+	}
+
+	paginator := redshiftserverless.NewListUsageLimitsPaginator(svc, input, func(o *redshiftserverless.ListUsageLimitsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			logger.Error("aws_redshiftserverless_usage_limit.listRedshiftServerlessUsageLimits", "api_error", err)
+			return nil, err
+		}
+
+		for _, usageLimit := range output.UsageLimits {
+			d.StreamListItem(ctx, usageLimit)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedshiftServerlessUsageLimit(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	id := d.KeyColumnQuals["usage_limit_id"].GetStringValue()
+	// Return nil, if no input provided
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create service
+	svc, err := RedshiftServerlessClient(ctx, d)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_usage_limit.getRedshiftServerlessUsageLimit", "service_creation_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build params
+	params := &redshiftserverless.GetUsageLimitInput{
+		UsageLimitId: aws.String(id),
+	}
+
+	op, err := svc.GetUsageLimit(ctx, params)
+	if err != nil {
+		logger.Error("aws_redshiftserverless_usage_limit.getRedshiftServerlessUsageLimit", "api_error", err)
+		return nil, err
+	}
+	return *op.UsageLimit, nil
+}
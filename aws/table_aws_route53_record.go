@@ -153,12 +153,18 @@ func listRoute53Records(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		return nil, nil
 	}
 
-	maxItems := int32(100)
+	// ListResourceRecordSets returns at most 300 records per page
+	maxItems := int32(300)
+
 	// Reduce the basic request limit down if the user has only requested a small number of rows
 	if d.QueryContext.Limit != nil {
 		limit := int32(*d.QueryContext.Limit)
 		if limit < maxItems {
-			maxItems = int32(limit)
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
 		}
 	}
 
@@ -167,25 +173,35 @@ func listRoute53Records(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		MaxItems:     aws.Int32(maxItems),
 	}
 
+	// StartRecordName and StartRecordType only set the position that the listing
+	// starts from, so the records that follow are checked below to stop paging
+	// as soon as the requested name and type have been passed
+	var recordName, recordType, setIdentifier string
 	equalQuals := d.KeyColumnQuals
 	if equalQuals["name"] != nil {
-		input.StartRecordName = aws.String(equalQuals["name"].GetStringValue())
+		recordName = normalizeRoute53RecordName(equalQuals["name"].GetStringValue())
+		input.StartRecordName = aws.String(recordName)
 
 		// Specifying record type without specifying record name returns an
 		// InvalidInput error
 		if equalQuals["type"] != nil {
-			input.StartRecordType = route53Types.RRType(equalQuals["type"].GetStringValue())
+			recordType = equalQuals["type"].GetStringValue()
+			input.StartRecordType = route53Types.RRType(recordType)
 
 			// Specifying record identifier without specifying record name and type
 			// returns an InvalidInput error
 			if equalQuals["set_identifier"] != nil {
-				input.StartRecordIdentifier = aws.String(equalQuals["set_identifier"].GetStringValue())
+				setIdentifier = equalQuals["set_identifier"].GetStringValue()
+				input.StartRecordIdentifier = aws.String(setIdentifier)
 			}
 		}
 	}
 
 	// Paginator is not supported in AWS SDK v2 as of 2022/11/04
 	// So we use generic pagination handling instead
+	// Each page starts at the record after the previous page, so pages are
+	// fetched in order. Zones given with an in list or a join are listed in
+	// parallel by the SDK, with one call of this function per zone_id
 	for {
 		op, err := svc.ListResourceRecordSets(ctx, input)
 		if err != nil {
@@ -194,6 +210,18 @@ func listRoute53Records(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		}
 
 		for _, record := range op.ResourceRecordSets {
+			// Records are returned in sorted order, so once a record with a
+			// different name or type is seen there are no more matching records
+			if recordName != "" && normalizeRoute53RecordName(aws.ToString(record.Name)) != recordName {
+				return nil, nil
+			}
+			if recordType != "" && string(record.Type) != recordType {
+				return nil, nil
+			}
+			if setIdentifier != "" && aws.ToString(record.SetIdentifier) != setIdentifier {
+				continue
+			}
+
 			d.StreamListItem(ctx, &recordInfo{aws.String(hostedZoneID), record})
 
 			// Context may get cancelled due to manual cancellation or if the limit has been reached
@@ -215,12 +243,27 @@ func listRoute53Records(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		}
 		if op.NextRecordIdentifier != nil {
 			input.StartRecordIdentifier = op.NextRecordIdentifier
+		} else {
+			input.StartRecordIdentifier = nil
 		}
 	}
 
 	return nil, nil
 }
 
+//// UTILITY FUNCTION
+
+// normalizeRoute53RecordName converts a record name to the form returned by
+// Route 53, which is lower case, fully qualified and has the asterisk of
+// wildcard records escaped in octal
+func normalizeRoute53RecordName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name != "" && !strings.HasSuffix(name, ".") {
+		name = name + "."
+	}
+	return strings.ReplaceAll(name, "*", "\\052")
+}
+
 //// TRANSFORM FUNCTION
 
 func flattenResourceRecords(_ context.Context, d *transform.TransformData) (interface{}, error) {
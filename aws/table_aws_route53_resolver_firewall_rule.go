@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_rule",
+		Description: "AWS Route53 Resolver DNS Firewall Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAwsRoute53ResolverFirewallRuleGroups,
+			Hydrate:       listAwsRoute53ResolverFirewallRules,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "firewall_rule_group_id", Require: plugin.Optional},
+				{Name: "action", Require: plugin.Optional},
+				{Name: "priority", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_rule_group_id",
+				Description: "The ID of the rule group that the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "firewall_domain_list_id",
+				Description: "The ID of the domain list that the rule inspects DNS queries against.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the rule in the rule group. Rules are evaluated in ascending order of priority.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "action",
+				Description: "The action that DNS Firewall takes on a DNS query that matches the domain list, such as ALLOW, BLOCK or ALERT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "block_response",
+				Description: "The response that DNS Firewall sends back for a blocked query, such as NODATA, NXDOMAIN or OVERRIDE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "block_override_domain",
+				Description: "The custom DNS record sent back in response to a blocked query, when the block response is OVERRIDE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "block_override_dns_type",
+				Description: "The DNS record type of the custom response sent for a blocked query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "block_override_ttl",
+				Description: "The recommended amount of time, in seconds, for the DNS resolver or web browser to cache the override record.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the rule was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the rule was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsRoute53ResolverFirewallRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ruleGroupId := route53ResolverFirewallRuleGroupId(h.Item)
+
+	// Minimize API calls when a specific rule group has been requested
+	if d.KeyColumnQuals["firewall_rule_group_id"] != nil && d.KeyColumnQuals["firewall_rule_group_id"].GetStringValue() != ruleGroupId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule.listAwsRoute53ResolverFirewallRules", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallRulesInput{
+		FirewallRuleGroupId: aws.String(ruleGroupId),
+		MaxResults:          aws.Int32(maxItems),
+	}
+
+	if d.KeyColumnQuals["action"] != nil {
+		input.Action = types.Action(d.KeyColumnQuals["action"].GetStringValue())
+	}
+	if d.KeyColumnQuals["priority"] != nil {
+		input.Priority = aws.Int32(int32(d.KeyColumnQuals["priority"].GetInt64Value()))
+	}
+
+	paginator := route53resolver.NewListFirewallRulesPaginator(svc, input, func(o *route53resolver.ListFirewallRulesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule.listAwsRoute53ResolverFirewallRules", "api_error", err)
+			return nil, err
+		}
+
+		for _, rule := range output.FirewallRules {
+			d.StreamListItem(ctx, rule)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverFirewallRuleGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_firewall_rule_group",
+		Description: "AWS Route53 Resolver DNS Firewall Rule Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsRoute53ResolverFirewallRuleGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsRoute53ResolverFirewallRuleGroups,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID for the account that created the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Indicates whether the rule group is shared with other AWS accounts, or was shared with the current account by another AWS account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the rule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the rule group.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "status_message",
+				Description: "Additional information about the status of the rule group, if available.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "rule_count",
+				Description: "The number of rules in the rule group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the rule group was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "modification_time",
+				Description: "The date and time that the rule group was last modified, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroup,
+			},
+			{
+				Name:        "associations",
+				Description: "The VPCs that the rule group is associated with, including the priority, mutation protection and status of each association.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsRoute53ResolverFirewallRuleGroupAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the rule group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsRoute53ResolverFirewallRuleGroupTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverRuleTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsRoute53ResolverFirewallRuleGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listAwsRoute53ResolverFirewallRuleGroups", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupsInput{
+		MaxResults: aws.Int32(maxItems),
+	}
+
+	paginator := route53resolver.NewListFirewallRuleGroupsPaginator(svc, input, func(o *route53resolver.ListFirewallRuleGroupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listAwsRoute53ResolverFirewallRuleGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, ruleGroup := range output.FirewallRuleGroups {
+			d.StreamListItem(ctx, ruleGroup)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsRoute53ResolverFirewallRuleGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var id string
+	if h.Item != nil {
+		id = route53ResolverFirewallRuleGroupId(h.Item)
+	} else {
+		id = d.KeyColumnQuals["id"].GetStringValue()
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getAwsRoute53ResolverFirewallRuleGroup", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: aws.String(id),
+	}
+
+	// Get call
+	data, err := svc.GetFirewallRuleGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getAwsRoute53ResolverFirewallRuleGroup", "api_error", err)
+		return nil, err
+	}
+	return data.FirewallRuleGroup, nil
+}
+
+func listAwsRoute53ResolverFirewallRuleGroupAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := route53ResolverFirewallRuleGroupId(h.Item)
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listAwsRoute53ResolverFirewallRuleGroupAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &route53resolver.ListFirewallRuleGroupAssociationsInput{
+		FirewallRuleGroupId: aws.String(id),
+		MaxResults:          aws.Int32(100),
+	}
+
+	paginator := route53resolver.NewListFirewallRuleGroupAssociationsPaginator(svc, input, func(o *route53resolver.ListFirewallRuleGroupAssociationsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	var associations []types.FirewallRuleGroupAssociation
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.listAwsRoute53ResolverFirewallRuleGroupAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.FirewallRuleGroupAssociations...)
+	}
+
+	return associations, nil
+}
+
+func getAwsRoute53ResolverFirewallRuleGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		arn = aws.ToString(item.Arn)
+	case *types.FirewallRuleGroup:
+		arn = aws.ToString(item.Arn)
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getAwsRoute53ResolverFirewallRuleGroupTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_firewall_rule_group.getAwsRoute53ResolverFirewallRuleGroupTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func route53ResolverFirewallRuleGroupId(item interface{}) string {
+	switch item := item.(type) {
+	case types.FirewallRuleGroupMetadata:
+		return aws.ToString(item.Id)
+	case *types.FirewallRuleGroup:
+		return aws.ToString(item.Id)
+	}
+	return ""
+}
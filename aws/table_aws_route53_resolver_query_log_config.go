@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRoute53ResolverQueryLogConfig(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_route53_resolver_query_log_config",
+		Description: "AWS Route53 Resolver Query Log Config",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "InvalidParameterException"}),
+			},
+			Hydrate: getAwsRoute53ResolverQueryLogConfig,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsRoute53ResolverQueryLogConfigs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "destination_arn", Require: plugin.Optional},
+				{Name: "share_status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID that Resolver assigned to the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the query logging configuration, such as CREATING, CREATED, DELETING or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_arn",
+				Description: "The ARN of the CloudWatch Logs log group, S3 bucket or Kinesis Data Firehose delivery stream that query logs are sent to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "association_count",
+				Description: "The number of VPCs that are associated with the query logging configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The AWS account ID for the account that created the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_status",
+				Description: "Indicates whether the query logging configuration is shared with other AWS accounts, or was shared with the current account by another AWS account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creator_request_id",
+				Description: "A unique string that identifies the request that created the query logging configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The date and time that the query logging configuration was created, in Unix time format and Coordinated Universal Time (UTC).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "associations",
+				Description: "The VPCs that are associated with the query logging configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsRoute53ResolverQueryLogConfigAssociations,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the query logging configuration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsRoute53ResolverQueryLogConfigTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsRoute53ResolverQueryLogConfigTags,
+				Transform:   transform.FromField("Tags").Transform(route53resolverRuleTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsRoute53ResolverQueryLogConfigs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.listAwsRoute53ResolverQueryLogConfigs", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+	input := route53resolver.ListResolverQueryLogConfigsInput{}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	filter := buildRoute53ResolverQueryLogConfigFilter(d.Quals)
+	if len(filter) > 0 {
+		input.Filters = filter
+	}
+
+	// List call
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := route53resolver.NewListResolverQueryLogConfigsPaginator(svc, &input, func(o *route53resolver.ListResolverQueryLogConfigsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.listAwsRoute53ResolverQueryLogConfigs", "api_error", err)
+			return nil, err
+		}
+
+		for _, queryLogConfig := range output.ResolverQueryLogConfigs {
+			d.StreamListItem(ctx, queryLogConfig)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsRoute53ResolverQueryLogConfig(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	id := d.KeyColumnQuals["id"].GetStringValue()
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.getAwsRoute53ResolverQueryLogConfig", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.GetResolverQueryLogConfigInput{
+		ResolverQueryLogConfigId: &id,
+	}
+
+	// Get call
+	data, err := svc.GetResolverQueryLogConfig(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.getAwsRoute53ResolverQueryLogConfig", "api_error", err)
+		return nil, err
+	}
+	return data.ResolverQueryLogConfig, nil
+}
+
+func listAwsRoute53ResolverQueryLogConfigAssociations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := route53ResolverQueryLogConfigId(h.Item)
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.listAwsRoute53ResolverQueryLogConfigAssociations", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &route53resolver.ListResolverQueryLogConfigAssociationsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("ResolverQueryLogConfigId"),
+				Values: []string{id},
+			},
+		},
+		MaxResults: aws.Int32(100),
+	}
+
+	paginator := route53resolver.NewListResolverQueryLogConfigAssociationsPaginator(svc, input, func(o *route53resolver.ListResolverQueryLogConfigAssociationsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	var associations []types.ResolverQueryLogConfigAssociation
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.listAwsRoute53ResolverQueryLogConfigAssociations", "api_error", err)
+			return nil, err
+		}
+		associations = append(associations, output.ResolverQueryLogConfigAssociations...)
+	}
+
+	return associations, nil
+}
+
+func getAwsRoute53ResolverQueryLogConfigTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn string
+	switch item := h.Item.(type) {
+	case types.ResolverQueryLogConfig:
+		arn = aws.ToString(item.Arn)
+	case *types.ResolverQueryLogConfig:
+		arn = aws.ToString(item.Arn)
+	}
+
+	// Create session
+	svc, err := Route53ResolverClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.getAwsRoute53ResolverQueryLogConfigTags", "client_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Build the params
+	params := &route53resolver.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_resolver_query_log_config.getAwsRoute53ResolverQueryLogConfigTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func route53ResolverQueryLogConfigId(item interface{}) string {
+	switch item := item.(type) {
+	case types.ResolverQueryLogConfig:
+		return aws.ToString(item.Id)
+	case *types.ResolverQueryLogConfig:
+		return aws.ToString(item.Id)
+	}
+	return ""
+}
+
+// Build route53resolver query log config list call input filter
+func buildRoute53ResolverQueryLogConfigFilter(quals plugin.KeyColumnQualMap) []types.Filter {
+	filters := make([]types.Filter, 0)
+
+	filterQuals := map[string]string{
+		"name":            "Name",
+		"status":          "Status",
+		"destination_arn": "DestinationArn",
+		"share_status":    "ShareStatus",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.Filter{
+				Name: aws.String(filterName),
+			}
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
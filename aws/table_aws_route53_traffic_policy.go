@@ -60,6 +60,13 @@ func tableAwsRoute53TrafficPolicy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getTrafficPolicy,
 			},
+			{
+				Name:        "instances",
+				Description: "The traffic policy instances created from this version of the traffic policy, including the DNS name and hosted zone of each instance.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTrafficPolicyInstancesByPolicy,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -171,6 +178,7 @@ func listTrafficPolicyVersionsAsync(ctx context.Context, d *plugin.QueryData, sv
 		if err != nil {
 			plugin.Logger(ctx).Error("aws_route53_traffic_policy.listTrafficPolicyVersionsAsync", "ListTrafficPolicyVersions_api_error", err)
 			errorCh <- err
+			return
 		}
 		for _, policies := range result.TrafficPolicies {
 			d.StreamListItem(ctx, policies)
@@ -228,6 +236,46 @@ func getTrafficPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	return *item.TrafficPolicy, nil
 }
 
+func listTrafficPolicyInstancesByPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	trafficPolicy := h.Item.(types.TrafficPolicy)
+
+	// Create session
+	svc, err := Route53Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_route53_traffic_policy.listTrafficPolicyInstancesByPolicy", "connection_error", err)
+		return nil, err
+	}
+
+	input := &route53.ListTrafficPolicyInstancesByPolicyInput{
+		TrafficPolicyId:      trafficPolicy.Id,
+		TrafficPolicyVersion: trafficPolicy.Version,
+		MaxItems:             aws.Int32(100),
+	}
+
+	var instances []types.TrafficPolicyInstance
+
+	// List call
+	pagesLeft := true
+	for pagesLeft {
+		result, err := svc.ListTrafficPolicyInstancesByPolicy(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_route53_traffic_policy.listTrafficPolicyInstancesByPolicy", "api_error", err)
+			return nil, err
+		}
+		instances = append(instances, result.TrafficPolicyInstances...)
+
+		if result.IsTruncated {
+			input.HostedZoneIdMarker = result.HostedZoneIdMarker
+			input.TrafficPolicyInstanceNameMarker = result.TrafficPolicyInstanceNameMarker
+			input.TrafficPolicyInstanceTypeMarker = result.TrafficPolicyInstanceTypeMarker
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return instances, nil
+}
+
 func getRoute53TrafficPolicyTurbotAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	trafficPolicy := h.Item.(types.TrafficPolicy)
 	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
@@ -239,7 +287,7 @@ func getRoute53TrafficPolicyTurbotAkas(ctx context.Context, d *plugin.QueryData,
 
 	// Get data for turbot defined properties
 	//arn:aws:route53::<account-id>:trafficpolicy/<id>/<version>
-	arn := fmt.Sprintf("arn:%s:route53::%s:trafficpolicy/%s/%s", commonColumnData.Partition, commonColumnData.AccountId, *trafficPolicy.Id, string(*trafficPolicy.Version))
+	arn := fmt.Sprintf("arn:%s:route53::%s:trafficpolicy/%s/%d", commonColumnData.Partition, commonColumnData.AccountId, *trafficPolicy.Id, *trafficPolicy.Version)
 
 	return []string{arn}, nil
 }
@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rum"
+	"github.com/aws/aws-sdk-go-v2/service/rum/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsRUMAppMonitor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_rum_app_monitor",
+		Description: "AWS CloudWatch RUM App Monitor",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getRUMAppMonitor,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRUMAppMonitors,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the app monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the app monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the app monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The date and time that the app monitor was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time of the most recent changes to the app monitor's configuration.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "domain",
+				Description: "The top-level internet domain name for which the app monitor collects data.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeRUMAppMonitor,
+			},
+			{
+				Name:        "cw_log_enabled",
+				Description: "Indicates whether the app monitor stores a copy of the telemetry data in CloudWatch Logs.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("DataStorage.CwLog.CwLogEnabled"),
+			},
+			{
+				Name:        "cw_log_group",
+				Description: "The name of the log group where the copies of the telemetry data are stored.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("DataStorage.CwLog.CwLogGroup"),
+			},
+			{
+				Name:        "allow_cookies",
+				Description: "Indicates whether the RUM web client sets two cookies, a session cookie and a user cookie.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("AppMonitorConfiguration.AllowCookies"),
+			},
+			{
+				Name:        "enable_xray",
+				Description: "Indicates whether X-Ray tracing of user sessions is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("AppMonitorConfiguration.EnableXRay"),
+			},
+			{
+				Name:        "session_sample_rate",
+				Description: "The portion of user sessions to use for RUM data collection, between 0 and 1.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("AppMonitorConfiguration.SessionSampleRate"),
+			},
+			{
+				Name:        "guest_role_arn",
+				Description: "The ARN of the guest IAM role that is attached to the Amazon Cognito identity pool used to authorize sending data to RUM.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("AppMonitorConfiguration.GuestRoleArn"),
+			},
+			{
+				Name:        "identity_pool_id",
+				Description: "The ID of the Amazon Cognito identity pool used to authorize sending data to RUM.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeRUMAppMonitor,
+				Transform:   transform.FromField("AppMonitorConfiguration.IdentityPoolId"),
+			},
+			{
+				Name:        "app_monitor_configuration",
+				Description: "The configuration of the app monitor, including included and excluded pages and the types of telemetry collected.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeRUMAppMonitor,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeRUMAppMonitor,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRUMAppMonitorAkas,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRUMAppMonitors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := RUMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rum_app_monitor.listRUMAppMonitors", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &rum.ListAppMonitorsInput{}
+
+	paginator := rum.NewListAppMonitorsPaginator(svc, input, func(o *rum.ListAppMonitorsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_rum_app_monitor.listRUMAppMonitors", "api_error", err)
+			return nil, err
+		}
+
+		for _, monitor := range output.AppMonitorSummaries {
+			d.StreamListItem(ctx, monitor)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRUMAppMonitor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	monitor, err := getRUMAppMonitorDetails(ctx, d, name)
+	if err != nil || monitor == nil {
+		return nil, err
+	}
+
+	return types.AppMonitorSummary{
+		Created:      monitor.Created,
+		Id:           monitor.Id,
+		LastModified: monitor.LastModified,
+		Name:         monitor.Name,
+		State:        monitor.State,
+	}, nil
+}
+
+// describeRUMAppMonitor returns the configuration and data storage settings of
+// the app monitor, which are not included in the list response
+func describeRUMAppMonitor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.AppMonitorSummary).Name
+
+	monitor, err := getRUMAppMonitorDetails(ctx, d, *name)
+	if err != nil || monitor == nil {
+		return nil, err
+	}
+
+	return monitor, nil
+}
+
+func getRUMAppMonitorDetails(ctx context.Context, d *plugin.QueryData, name string) (*types.AppMonitor, error) {
+	// Create Session
+	svc, err := RUMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rum_app_monitor.getRUMAppMonitorDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &rum.GetAppMonitorInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetAppMonitor(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rum_app_monitor.getRUMAppMonitorDetails", "api_error", err)
+		return nil, err
+	}
+
+	return op.AppMonitor, nil
+}
+
+func getRUMAppMonitorAkas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.AppMonitorSummary).Name
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	commonData, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_rum_app_monitor.getRUMAppMonitorAkas", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := commonData.(*awsCommonColumnData)
+
+	// App monitors don't have an ARN in the API response, so build it
+	arn := "arn:" + commonColumnData.Partition + ":rum:" + region + ":" + commonColumnData.AccountId + ":appmonitor/" + *name
+
+	return []string{arn}, nil
+}
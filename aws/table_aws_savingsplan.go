@@ -0,0 +1,269 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSavingsPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_savingsplan",
+		Description: "AWS Savings Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("savings_plan_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ValidationException", "ResourceNotFoundException"}),
+			},
+			Hydrate: getSavingsPlan,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlans,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "state", Require: plugin.Optional},
+				{Name: "savings_plan_type", Require: plugin.Optional},
+				{Name: "payment_option", Require: plugin.Optional},
+				{Name: "savings_plan_region", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "savings_plan_id",
+				Description: "The ID of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the Savings Plan. Possible values are payment-pending, payment-failed, active, retired, queued and queued-deleted.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_type",
+				Description: "The type of Savings Plan. Possible values are Compute, EC2Instance and SageMaker.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The payment option for the Savings Plan. Possible values are All Upfront, Partial Upfront and No Upfront.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "commitment",
+				Description: "The hourly commitment, in USD.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the Savings Plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "term_duration_in_seconds",
+				Description: "The duration of the term, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "start",
+				Description: "The start time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end",
+				Description: "The end time of the Savings Plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "savings_plan_region",
+				Description: "The AWS Region of the Savings Plan. Only set for EC2 Instance Savings Plans.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Region"),
+			},
+			{
+				Name:        "ec2_instance_family",
+				Description: "The EC2 instance family covered by the Savings Plan. Only set for EC2 Instance Savings Plans.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "offering_id",
+				Description: "The ID of the offering.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "upfront_payment_amount",
+				Description: "The up-front payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recurring_payment_amount",
+				Description: "The recurring payment amount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "product_types",
+				Description: "The product types covered by the Savings Plan.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A map of tags attached to the Savings Plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SavingsPlanId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SavingsPlanArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SavingsPlansClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan.listSavingsPlans", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &savingsplans.DescribeSavingsPlansInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if state := d.KeyColumnQualString("state"); state != "" {
+		input.States = []types.SavingsPlanState{types.SavingsPlanState(state)}
+	}
+
+	var filters []types.SavingsPlanFilter
+	if planType := d.KeyColumnQualString("savings_plan_type"); planType != "" {
+		filters = append(filters, types.SavingsPlanFilter{
+			Name:   types.SavingsPlansFilterNameSavingsPlanType,
+			Values: []string{planType},
+		})
+	}
+	if paymentOption := d.KeyColumnQualString("payment_option"); paymentOption != "" {
+		filters = append(filters, types.SavingsPlanFilter{
+			Name:   types.SavingsPlansFilterNamePaymentOption,
+			Values: []string{paymentOption},
+		})
+	}
+	if region := d.KeyColumnQualString("savings_plan_region"); region != "" {
+		filters = append(filters, types.SavingsPlanFilter{
+			Name:   types.SavingsPlansFilterNameRegion,
+			Values: []string{region},
+		})
+	}
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		result, err := svc.DescribeSavingsPlans(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_savingsplan.listSavingsPlans", "api_error", err)
+			return nil, err
+		}
+
+		for _, plan := range result.SavingsPlans {
+			d.StreamListItem(ctx, plan)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.NextToken != nil {
+			input.NextToken = result.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSavingsPlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["savings_plan_id"].GetStringValue()
+
+	// check if id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SavingsPlansClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan.getSavingsPlan", "connection_error", err)
+		return nil, err
+	}
+
+	params := &savingsplans.DescribeSavingsPlansInput{
+		SavingsPlanIds: []string{id},
+	}
+
+	op, err := svc.DescribeSavingsPlans(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan.getSavingsPlan", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.SavingsPlans) > 0 {
+		return op.SavingsPlans[0], nil
+	}
+
+	return nil, nil
+}
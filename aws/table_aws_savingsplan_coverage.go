@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsSavingsPlanCoverage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_savingsplan_coverage",
+		Description: "AWS Cost Explorer - Savings Plans Coverage",
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlanCoverage,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "granularity", Require: plugin.Required},
+				{Name: "group_by", Require: plugin.Optional},
+				{Name: "period_start", Operators: []string{"=", ">=", ">"}, Require: plugin.Optional},
+				{Name: "period_end", Operators: []string{"=", "<=", "<"}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "period_start",
+				Description: "Start timestamp for this coverage data.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.Start"),
+			},
+			{
+				Name:        "period_end",
+				Description: "End timestamp for this coverage data.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.End"),
+			},
+			{
+				Name:        "coverage_percentage",
+				Description: "The percentage of your existing Savings Plans covered usage, divided by all of your eligible Savings Plans usage in an account (or set of accounts).",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coverage.CoveragePercentage"),
+			},
+			{
+				Name:        "on_demand_cost",
+				Description: "The cost of your Amazon Web Services usage at the public On-Demand rate.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coverage.OnDemandCost"),
+			},
+			{
+				Name:        "spend_covered_by_savings_plans",
+				Description: "The amount of your Amazon Web Services usage that's covered by a Savings Plans.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coverage.SpendCoveredBySavingsPlans"),
+			},
+			{
+				Name:        "total_cost",
+				Description: "The total cost of your Amazon Web Services usage, regardless of your purchase option.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coverage.TotalCost"),
+			},
+			{
+				Name:        "attributes",
+				Description: "The attribute that applies to a specific dimension, such as the service or instance family when group_by is set.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Quals columns - to filter the lookups
+			{
+				Name:        "granularity",
+				Description: "The granularity of the coverage data. Valid values are DAILY and MONTHLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("granularity"),
+			},
+			{
+				Name:        "group_by",
+				Description: "The dimension to group coverage by. Valid values are SERVICE, INSTANCE_FAMILY, REGION and LINKED_ACCOUNT.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("group_by"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlanCoverage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan_coverage.listSavingsPlanCoverage", "connection_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.KeyColumnQualString("granularity"))
+	startTime, endTime := getCETimePeriodFromQuals(d, granularity)
+
+	input := &costexplorer.GetSavingsPlansCoverageInput{
+		TimePeriod: &types.DateInterval{
+			Start: aws.String(startTime),
+			End:   aws.String(endTime),
+		},
+		Granularity: types.Granularity(granularity),
+	}
+	if groupBy := d.KeyColumnQualString("group_by"); groupBy != "" {
+		input.GroupBy = []types.GroupDefinition{
+			{
+				Type: types.GroupDefinitionTypeDimension,
+				Key:  aws.String(strings.ToUpper(groupBy)),
+			},
+		}
+	}
+
+	// API doesn't support aws-sdk-go-v2 paginator as of date.
+	pagesLeft := true
+
+	for pagesLeft {
+		output, err := svc.GetSavingsPlansCoverage(ctx, input)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_savingsplan_coverage.listSavingsPlanCoverage", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.SavingsPlansCoverages {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if output.NextToken != nil {
+			input.NextToken = output.NextToken
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAwsSavingsPlanUtilization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_savingsplan_utilization",
+		Description: "AWS Cost Explorer - Savings Plans Utilization",
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlanUtilization,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "granularity", Require: plugin.Required},
+				{Name: "period_start", Operators: []string{"=", ">=", ">"}, Require: plugin.Optional},
+				{Name: "period_end", Operators: []string{"=", "<=", "<"}, Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "period_start",
+				Description: "Start timestamp for this utilization data.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.Start"),
+			},
+			{
+				Name:        "period_end",
+				Description: "End timestamp for this utilization data.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TimePeriod.End"),
+			},
+			{
+				Name:        "total_commitment",
+				Description: "The total amount of Savings Plans commitment that's been purchased in an account (or set of accounts).",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.TotalCommitment"),
+			},
+			{
+				Name:        "used_commitment",
+				Description: "The amount of your Savings Plans commitment that was consumed from Savings Plans eligible usage in a specific period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UsedCommitment"),
+			},
+			{
+				Name:        "unused_commitment",
+				Description: "The amount of your Savings Plans commitment that wasn't consumed from Savings Plans eligible usage in a specific period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UnusedCommitment"),
+			},
+			{
+				Name:        "utilization_percentage",
+				Description: "The amount of UsedCommitment divided by the TotalCommitment for your Savings Plans.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Utilization.UtilizationPercentage"),
+			},
+			{
+				Name:        "net_savings",
+				Description: "The savings amount that you're accumulating for the usage that's covered by a Savings Plans, when compared to the On-Demand equivalent of the same usage.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Savings.NetSavings"),
+			},
+			{
+				Name:        "on_demand_cost_equivalent",
+				Description: "How much the amount that the usage would have cost if it was accrued at the On-Demand rate.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Savings.OnDemandCostEquivalent"),
+			},
+			{
+				Name:        "total_amortized_commitment",
+				Description: "The total amortized amount of your Savings Plans commitment, regardless of your Savings Plans purchase method.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.TotalAmortizedCommitment"),
+			},
+			{
+				Name:        "amortized_recurring_commitment",
+				Description: "The amortized amount of your Savings Plans commitment that was purchased with either a Partial or a NoUpfront.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.AmortizedRecurringCommitment"),
+			},
+			{
+				Name:        "amortized_upfront_commitment",
+				Description: "The amortized amount of your Savings Plans commitment that was purchased with an Upfront or PartialUpfront Savings Plans.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("AmortizedCommitment.AmortizedUpfrontCommitment"),
+			},
+
+			// Quals columns - to filter the lookups
+			{
+				Name:        "granularity",
+				Description: "The granularity of the utilization data. Valid values are DAILY and MONTHLY.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("granularity"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlanUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := CostExplorerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan_utilization.listSavingsPlanUtilization", "connection_error", err)
+		return nil, err
+	}
+
+	granularity := strings.ToUpper(d.KeyColumnQualString("granularity"))
+	startTime, endTime := getCETimePeriodFromQuals(d, granularity)
+
+	input := &costexplorer.GetSavingsPlansUtilizationInput{
+		TimePeriod: &types.DateInterval{
+			Start: aws.String(startTime),
+			End:   aws.String(endTime),
+		},
+		Granularity: types.Granularity(granularity),
+	}
+
+	output, err := svc.GetSavingsPlansUtilization(ctx, input)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_savingsplan_utilization.listSavingsPlanUtilization", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range output.SavingsPlansUtilizationsByTime {
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
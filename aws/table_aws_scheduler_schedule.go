@@ -0,0 +1,265 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchedulerSchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_scheduler_schedule",
+		Description: "AWS EventBridge Scheduler Schedule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "group_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getSchedulerSchedule,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSchedulerSchedules,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "group_name", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_name",
+				Description: "The name of the schedule group associated with this schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Specifies whether the schedule is enabled or disabled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "The expression that defines when the schedule runs, either an at, rate or cron expression.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "schedule_expression_timezone",
+				Description: "The timezone in which the scheduling expression is evaluated.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "start_date",
+				Description: "The date after which the schedule can begin invoking its target.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "end_date",
+				Description: "The date before which the schedule can invoke its target.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The time at which the schedule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modification_date",
+				Description: "The time at which the schedule was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kms_key_arn",
+				Description: "The ARN of the customer managed KMS key used to encrypt the schedule's target payload.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "target_arn",
+				Description: "The ARN of the target invoked by the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Target.Arn"),
+			},
+			{
+				Name:        "role_arn",
+				Description: "The ARN of the IAM role that EventBridge Scheduler uses for the target when the schedule is invoked.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+				Transform:   transform.FromField("Target.RoleArn"),
+			},
+			{
+				Name:        "dead_letter_config_arn",
+				Description: "The ARN of the SQS queue used as the dead-letter queue for the schedule's target.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchedulerSchedule,
+				Transform:   transform.FromField("Target.DeadLetterConfig.Arn"),
+			},
+			{
+				Name:        "flexible_time_window",
+				Description: "The window of time within which the schedule can be invoked.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeSchedulerSchedule,
+			},
+			{
+				Name:        "retry_policy",
+				Description: "The retry policy of the schedule's target.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeSchedulerSchedule,
+				Transform:   transform.FromField("Target.RetryPolicy"),
+			},
+			{
+				Name:        "target",
+				Description: "The schedule's target details, including the input and any service specific parameters.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeSchedulerSchedule,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchedulerSchedules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SchedulerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule.listSchedulerSchedules", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &scheduler.ListSchedulesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.KeyColumnQuals["group_name"] != nil {
+		input.GroupName = aws.String(d.KeyColumnQuals["group_name"].GetStringValue())
+	}
+	if d.KeyColumnQuals["state"] != nil {
+		input.State = types.ScheduleState(d.KeyColumnQuals["state"].GetStringValue())
+	}
+
+	paginator := scheduler.NewListSchedulesPaginator(svc, input, func(o *scheduler.ListSchedulesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_scheduler_schedule.listSchedulerSchedules", "api_error", err)
+			return nil, err
+		}
+
+		for _, schedule := range output.Schedules {
+			d.StreamListItem(ctx, schedule)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchedulerSchedule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+	groupName := d.KeyColumnQuals["group_name"].GetStringValue()
+
+	// check if name or group name is empty
+	if name == "" || groupName == "" {
+		return nil, nil
+	}
+
+	return getSchedule(ctx, d, name, groupName)
+}
+
+// describeSchedulerSchedule returns the schedule configuration, which is not
+// included in the list response
+func describeSchedulerSchedule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *scheduler.GetScheduleOutput:
+		return item, nil
+	case types.ScheduleSummary:
+		return getSchedule(ctx, d, *item.Name, *item.GroupName)
+	}
+	return nil, nil
+}
+
+func getSchedule(ctx context.Context, d *plugin.QueryData, name string, groupName string) (*scheduler.GetScheduleOutput, error) {
+	// Create Session
+	svc, err := SchedulerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule.getSchedule", "connection_error", err)
+		return nil, err
+	}
+
+	params := &scheduler.GetScheduleInput{
+		Name:      aws.String(name),
+		GroupName: aws.String(groupName),
+	}
+
+	op, err := svc.GetSchedule(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule.getSchedule", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchedulerScheduleGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_scheduler_schedule_group",
+		Description: "AWS EventBridge Scheduler Schedule Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+			Hydrate: getSchedulerScheduleGroup,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSchedulerScheduleGroups,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the schedule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the schedule group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Specifies the state of the schedule group, either ACTIVE or DELETING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The time at which the schedule group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modification_date",
+				Description: "The time at which the schedule group was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSchedulerScheduleGroupTags,
+				Transform:   transform.FromValue().Transform(schedulerTagsToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchedulerScheduleGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SchedulerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule_group.listSchedulerScheduleGroups", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &scheduler.ListScheduleGroupsInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := scheduler.NewListScheduleGroupsPaginator(svc, input, func(o *scheduler.ListScheduleGroupsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_scheduler_schedule_group.listSchedulerScheduleGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, group := range output.ScheduleGroups {
+			d.StreamListItem(ctx, group)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchedulerScheduleGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SchedulerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule_group.getSchedulerScheduleGroup", "connection_error", err)
+		return nil, err
+	}
+
+	params := &scheduler.GetScheduleGroupInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.GetScheduleGroup(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule_group.getSchedulerScheduleGroup", "api_error", err)
+		return nil, err
+	}
+
+	return types.ScheduleGroupSummary{
+		Arn:                  op.Arn,
+		CreationDate:         op.CreationDate,
+		LastModificationDate: op.LastModificationDate,
+		Name:                 op.Name,
+		State:                op.State,
+	}, nil
+}
+
+func getSchedulerScheduleGroupTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(types.ScheduleGroupSummary)
+
+	// Create Session
+	svc, err := SchedulerClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule_group.getSchedulerScheduleGroupTags", "connection_error", err)
+		return nil, err
+	}
+
+	params := &scheduler.ListTagsForResourceInput{
+		ResourceArn: group.Arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_scheduler_schedule_group.getSchedulerScheduleGroupTags", "api_error", err)
+		return nil, err
+	}
+
+	return op.Tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func schedulerTagsToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tags, ok := d.Value.([]types.Tag)
+	if !ok || len(tags) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tags {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
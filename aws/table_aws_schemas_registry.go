@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/aws/aws-sdk-go-v2/service/schemas/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSchemasRegistry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_schemas_registry",
+		Description: "AWS EventBridge Schemas Registry",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("registry_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getSchemasRegistry,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSchemasRegistries,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "registry_name",
+				Description: "The name of the registry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the registry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the registry.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchemasRegistry,
+			},
+			{
+				Name:        "policy",
+				Description: "The resource-based policy of the registry.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSchemasRegistryPolicy,
+				Transform:   transform.FromField("Policy").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "policy_std",
+				Description: "Contains the policy in a canonical form for easier searching.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSchemasRegistryPolicy,
+				Transform:   transform.FromField("Policy").Transform(unescape).Transform(policyToCanonical),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RegistryArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchemasRegistries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.listSchemasRegistries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &schemas.ListRegistriesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+
+	paginator := schemas.NewListRegistriesPaginator(svc, input, func(o *schemas.ListRegistriesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_registry.listSchemasRegistries", "api_error", err)
+			return nil, err
+		}
+
+		for _, registry := range output.Registries {
+			d.StreamListItem(ctx, registry)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchemasRegistry(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["registry_name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := describeRegistry(ctx, d, name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// describeSchemasRegistry returns the registry description, which is not
+// included in the list response
+func describeSchemasRegistry(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	switch item := h.Item.(type) {
+	case *schemas.DescribeRegistryOutput:
+		return item, nil
+	case types.RegistrySummary:
+		op, err := describeRegistry(ctx, d, *item.RegistryName)
+		if err != nil || op == nil {
+			return nil, err
+		}
+		return op, nil
+	}
+	return nil, nil
+}
+
+func describeRegistry(ctx context.Context, d *plugin.QueryData, name string) (*schemas.DescribeRegistryOutput, error) {
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.describeRegistry", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &schemas.DescribeRegistryInput{
+		RegistryName: aws.String(name),
+	}
+
+	op, err := svc.DescribeRegistry(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.describeRegistry", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getSchemasRegistryPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name *string
+	switch item := h.Item.(type) {
+	case *schemas.DescribeRegistryOutput:
+		name = item.RegistryName
+	case types.RegistrySummary:
+		name = item.RegistryName
+	}
+
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_registry.getSchemasRegistryPolicy", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &schemas.GetResourcePolicyInput{
+		RegistryName: name,
+	}
+
+	op, err := svc.GetResourcePolicy(ctx, params)
+	if err != nil {
+		// Registries without a resource policy return a NotFoundException
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "NotFoundException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_schemas_registry.getSchemasRegistryPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
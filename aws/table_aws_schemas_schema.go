@@ -0,0 +1,305 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	"github.com/aws/aws-sdk-go-v2/service/schemas/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type schemasSchemaInfo = struct {
+	types.SchemaSummary
+	RegistryName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSchemasSchema(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_schemas_schema",
+		Description: "AWS EventBridge Schemas Schema",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"registry_name", "schema_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFoundException"}),
+			},
+			Hydrate: getSchemasSchema,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSchemasRegistries,
+			Hydrate:       listSchemasSchemas,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "registry_name", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "schema_name",
+				Description: "The name of the schema.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "registry_name",
+				Description: "The name of the registry that contains the schema.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaArn"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchemasSchema,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the schema, either OpenApi3 or JSONSchemaDraft4.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchemasSchema,
+			},
+			{
+				Name:        "schema_version",
+				Description: "The latest version of the schema.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     describeSchemasSchema,
+			},
+			{
+				Name:        "version_count",
+				Description: "The number of versions available for the schema.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     listSchemasSchemaVersions,
+				Transform:   transform.FromValue().Transform(countSchemasSchemaVersions),
+			},
+			{
+				Name:        "last_modified",
+				Description: "The date and time that the schema was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "version_created_date",
+				Description: "The date and time that the latest schema version was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     describeSchemasSchema,
+			},
+			{
+				Name:        "content",
+				Description: "The source of the latest version of the schema.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     describeSchemasSchema,
+				Transform:   transform.FromField("Content").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "versions",
+				Description: "The version history of the schema.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSchemasSchemaVersions,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemaName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SchemaArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSchemasSchemas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	registry := h.Item.(types.RegistrySummary)
+
+	// Minimize API calls when a specific registry has been requested
+	if d.KeyColumnQuals["registry_name"] != nil && d.KeyColumnQuals["registry_name"].GetStringValue() != *registry.RegistryName {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemas", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &schemas.ListSchemasInput{
+		RegistryName: registry.RegistryName,
+		Limit:        aws.Int32(maxLimit),
+	}
+
+	paginator := schemas.NewListSchemasPaginator(svc, input, func(o *schemas.ListSchemasPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemas", "api_error", err)
+			return nil, err
+		}
+
+		for _, schema := range output.Schemas {
+			d.StreamLeafListItem(ctx, schemasSchemaInfo{schema, registry.RegistryName})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSchemasSchema(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	registryName := d.KeyColumnQuals["registry_name"].GetStringValue()
+	schemaName := d.KeyColumnQuals["schema_name"].GetStringValue()
+
+	// check if registry name or schema name is empty
+	if registryName == "" || schemaName == "" {
+		return nil, nil
+	}
+
+	op, err := describeSchema(ctx, d, registryName, schemaName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	summary := types.SchemaSummary{
+		LastModified: op.LastModified,
+		SchemaArn:    op.SchemaArn,
+		SchemaName:   op.SchemaName,
+		Tags:         op.Tags,
+	}
+
+	return schemasSchemaInfo{summary, aws.String(registryName)}, nil
+}
+
+// describeSchemasSchema returns the latest version of the schema, including
+// its content
+func describeSchemasSchema(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	schema := h.Item.(schemasSchemaInfo)
+
+	op, err := describeSchema(ctx, d, *schema.RegistryName, *schema.SchemaName)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func describeSchema(ctx context.Context, d *plugin.QueryData, registryName string, schemaName string) (*schemas.DescribeSchemaOutput, error) {
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.describeSchema", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &schemas.DescribeSchemaInput{
+		RegistryName: aws.String(registryName),
+		SchemaName:   aws.String(schemaName),
+	}
+
+	op, err := svc.DescribeSchema(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.describeSchema", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listSchemasSchemaVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	schema := h.Item.(schemasSchemaInfo)
+
+	// Create Session
+	svc, err := SchemasClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemaVersions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &schemas.ListSchemaVersionsInput{
+		RegistryName: schema.RegistryName,
+		SchemaName:   schema.SchemaName,
+	}
+
+	paginator := schemas.NewListSchemaVersionsPaginator(svc, params, func(o *schemas.ListSchemaVersionsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var versions []types.SchemaVersionSummary
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_schemas_schema.listSchemasSchemaVersions", "api_error", err)
+			return nil, err
+		}
+		versions = append(versions, output.SchemaVersions...)
+	}
+
+	return versions, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func countSchemasSchemaVersions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	versions, ok := d.Value.([]types.SchemaVersionSummary)
+	if !ok {
+		return nil, nil
+	}
+
+	return len(versions), nil
+}
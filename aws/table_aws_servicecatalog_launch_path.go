@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type serviceCatalogLaunchPathInfo = struct {
+	types.LaunchPathSummary
+	ProductId   *string
+	ProductName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsServiceCatalogLaunchPath(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_servicecatalog_launch_path",
+		Description: "AWS Service Catalog Launch Path",
+		List: &plugin.ListConfig{
+			ParentHydrate: listServiceCatalogProducts,
+			Hydrate:       listServiceCatalogLaunchPaths,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "product_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The identifier of the product path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the portfolio that contains the product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_id",
+				Description: "The identifier of the product the launch path belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product the launch path belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "constraint_summaries",
+				Description: "The constraints on the portfolio-product relationship.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "The tags associated with this product path.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(serviceCatalogTagListToTurbotTags),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listServiceCatalogProducts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_launch_path.listServiceCatalogProducts", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &servicecatalog.SearchProductsInput{}
+
+	paginator := servicecatalog.NewSearchProductsPaginator(svc, input, func(o *servicecatalog.SearchProductsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_servicecatalog_launch_path.listServiceCatalogProducts", "api_error", err)
+			return nil, err
+		}
+
+		for _, product := range output.ProductViewSummaries {
+			d.StreamListItem(ctx, product)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listServiceCatalogLaunchPaths(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	product := h.Item.(types.ProductViewSummary)
+
+	// Minimize API calls when a specific product has been requested
+	if d.KeyColumnQuals["product_id"] != nil && d.KeyColumnQuals["product_id"].GetStringValue() != *product.ProductId {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_launch_path.listServiceCatalogLaunchPaths", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &servicecatalog.ListLaunchPathsInput{
+		ProductId: product.ProductId,
+	}
+
+	paginator := servicecatalog.NewListLaunchPathsPaginator(svc, input, func(o *servicecatalog.ListLaunchPathsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_servicecatalog_launch_path.listServiceCatalogLaunchPaths", "api_error", err)
+			return nil, err
+		}
+
+		for _, path := range output.LaunchPathSummaries {
+			d.StreamLeafListItem(ctx, serviceCatalogLaunchPathInfo{path, product.ProductId, product.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsServiceCatalogProvisionedProduct(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_servicecatalog_provisioned_product",
+		Description: "AWS Service Catalog Provisioned Product",
+		List: &plugin.ListConfig{
+			Hydrate: listServiceCatalogProvisionedProducts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+				{Name: "product_id", Require: plugin.Optional},
+				{Name: "provisioning_artifact_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The user-friendly name of the provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The identifier of the provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN of the provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of provisioned product. The supported values are CFN_STACK and CFN_STACKSET.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "The current status message of the provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The UTC time stamp of the creation time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "physical_id",
+				Description: "The assigned identifier for the resource, such as an EC2 instance ID or an S3 bucket name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_id",
+				Description: "The product identifier.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_artifact_id",
+				Description: "The identifier of the provisioning artifact (product version) the product was provisioned with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_artifact_name",
+				Description: "The name of the provisioning artifact (product version) the product was provisioned with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_artifact_active",
+				Description: "Indicates whether the provisioning artifact is still active. Inactive artifacts can no longer be used to provision or update products.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getServiceCatalogProvisionedProductArtifact,
+				Transform:   transform.FromField("ProvisioningArtifactDetail.Active"),
+			},
+			{
+				Name:        "provisioning_artifact_guidance",
+				Description: "The guidance for the provisioning artifact. DEPRECATED indicates that a newer version of the product should be used.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getServiceCatalogProvisionedProductArtifact,
+				Transform:   transform.FromField("ProvisioningArtifactDetail.Guidance"),
+			},
+			{
+				Name:        "last_record_id",
+				Description: "The record identifier of the last request performed on this provisioned product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_provisioning_record_id",
+				Description: "The record identifier of the last request performed on this provisioned product of the following types: ProvisionedProduct, UpdateProvisionedProduct, ExecuteProvisionedProductPlan, TerminateProvisionedProduct.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_successful_provisioning_record_id",
+				Description: "The record identifier of the last successful request performed on this provisioned product of the following types: ProvisionedProduct, UpdateProvisionedProduct, ExecuteProvisionedProductPlan, TerminateProvisionedProduct.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_record",
+				Description: "The details of the last request performed on this provisioned product, including its type, status and any errors.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getServiceCatalogProvisionedProductLastRecord,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "user_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_arn_session",
+				Description: "The ARN of the IAM user in the session. This ARN might contain a session ID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "idempotency_token",
+				Description: "A unique identifier that you provide to ensure idempotency.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the provisioned product.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(serviceCatalogTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceCatalogProvisionedProducts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.listServiceCatalogProvisionedProducts", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// List every provisioned product in the account, not only the ones
+	// provisioned by the caller
+	input := &servicecatalog.SearchProvisionedProductsInput{
+		AccessLevelFilter: &types.AccessLevelFilter{
+			Key:   types.AccessLevelFilterKeyAccount,
+			Value: aws.String("self"),
+		},
+	}
+
+	searchQuery := buildServiceCatalogProvisionedProductSearchQuery(d.KeyColumnQuals)
+	if len(searchQuery) > 0 {
+		input.Filters = map[string][]string{
+			string(types.ProvisionedProductViewFilterBySearchQuery): searchQuery,
+		}
+	}
+
+	paginator := servicecatalog.NewSearchProvisionedProductsPaginator(svc, input, func(o *servicecatalog.SearchProvisionedProductsPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.listServiceCatalogProvisionedProducts", "api_error", err)
+			return nil, err
+		}
+
+		for _, product := range output.ProvisionedProducts {
+			d.StreamListItem(ctx, product)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceCatalogProvisionedProductArtifact(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	product := h.Item.(types.ProvisionedProductAttribute)
+
+	// Create Session
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductArtifact", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &servicecatalog.DescribeProvisioningArtifactInput{
+		ProductId:              product.ProductId,
+		ProvisioningArtifactId: product.ProvisioningArtifactId,
+	}
+
+	op, err := svc.DescribeProvisioningArtifact(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductArtifact", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getServiceCatalogProvisionedProductLastRecord(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	product := h.Item.(types.ProvisionedProductAttribute)
+
+	if product.LastRecordId == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := ServiceCatalogClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductLastRecord", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &servicecatalog.DescribeRecordInput{
+		Id: product.LastRecordId,
+	}
+
+	op, err := svc.DescribeRecord(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_servicecatalog_provisioned_product.getServiceCatalogProvisionedProductLastRecord", "api_error", err)
+		return nil, err
+	}
+
+	return op.RecordDetail, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serviceCatalogTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.Value.([]types.Tag)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tagList != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tagList {
+			turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+		}
+	}
+
+	return turbotTagsMap, nil
+}
+
+//// UTILITY FUNCTION
+
+// The SearchQuery filter takes a list of "attribute:value" terms
+func buildServiceCatalogProvisionedProductSearchQuery(equalQuals plugin.KeyColumnEqualsQualMap) []string {
+	searchQuery := []string{}
+
+	filterQuals := map[string]string{
+		"id":                       "id",
+		"status":                   "status",
+		"type":                     "type",
+		"product_id":               "productId",
+		"provisioning_artifact_id": "provisioningArtifactId",
+	}
+
+	for columnName, attribute := range filterQuals {
+		if equalQuals[columnName] != nil {
+			searchQuery = append(searchQuery, attribute+":"+equalQuals[columnName].GetStringValue())
+		}
+	}
+
+	return searchQuery
+}
@@ -21,6 +21,10 @@ func tableAwsStepFunctionsStateMachineExecutionHistory(_ context.Context) *plugi
 		List: &plugin.ListConfig{
 			Hydrate:       listStepFunctionsStateMachineExecutionHistories,
 			ParentHydrate: listStepFunctionsStateManchines,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "execution_arn", Require: plugin.Optional},
+				{Name: "include_execution_data", Require: plugin.Optional},
+			},
 		},
 		GetMatrixItemFunc: BuildRegionList,
 		Columns: awsRegionalColumns([]*plugin.Column{
@@ -49,6 +53,42 @@ func tableAwsStepFunctionsStateMachineExecutionHistory(_ context.Context) *plugi
 				Description: "The type of the event.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "state_name",
+				Description: "The name of the state for state entered and state exited events.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(executionHistoryStateName),
+			},
+			{
+				Name:        "input",
+				Description: "The JSON input of the event, for execution started, state entered, task scheduled, Lambda function scheduled and activity scheduled events.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(executionHistoryPayload, "Input").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "output",
+				Description: "The JSON output of the event, for execution succeeded, state exited, task succeeded, Lambda function succeeded and activity succeeded events.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(executionHistoryPayload, "Output").Transform(transform.UnmarshalYAML),
+			},
+			{
+				Name:        "error",
+				Description: "The error code of the failure, for failed, aborted and timed out events.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(executionHistoryFailure, "Error"),
+			},
+			{
+				Name:        "cause",
+				Description: "A more detailed explanation of the cause of the failure, for failed, aborted and timed out events.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(executionHistoryFailure, "Cause"),
+			},
+			{
+				Name:        "include_execution_data",
+				Description: "Set to false to exclude the input and output payloads of the events, which can be up to 256 KiB each. Defaults to true.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_execution_data"),
+			},
 			{
 				Name:        "activity_failed_event_details",
 				Description: "Contains details about an activity that failed during an execution.",
@@ -248,6 +288,33 @@ func listStepFunctionsStateMachineExecutionHistories(ctx context.Context, d *plu
 	}
 
 	stateMachineArn := h.Item.(types.StateMachineListItem).StateMachineArn
+
+	// Only get the history of the requested execution, if any. The state machine
+	// ARN is derived from the execution ARN, e.g.
+	// arn:aws:states:us-east-1:123456789012:execution:HelloWorld:a44bc846 belongs
+	// to arn:aws:states:us-east-1:123456789012:stateMachine:HelloWorld
+	if d.KeyColumnQuals["execution_arn"] != nil {
+		executionArn := d.KeyColumnQuals["execution_arn"].GetStringValue()
+		if executionHistoryStateMachineArn(executionArn) != *stateMachineArn {
+			return nil, nil
+		}
+
+		items, err := getRowDataForExecutionHistory(ctx, d, executionArn)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			d.StreamLeafListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		return nil, nil
+	}
+
 	var executions []types.ExecutionListItem
 	maxLimit := int32(1000)
 	// If the requested number of items is less than the paging max limit
@@ -341,18 +408,32 @@ func getRowDataForExecutionHistory(ctx context.Context, d *plugin.QueryData, arn
 
 	params := &sfn.GetExecutionHistoryInput{
 		ExecutionArn: aws.String(arn),
+		MaxResults:   1000,
+	}
+
+	// Input and output payloads can be up to 256 KiB per event, so allow them to
+	// be excluded from the response
+	if d.KeyColumnQuals["include_execution_data"] != nil {
+		params.IncludeExecutionData = aws.Bool(d.KeyColumnQuals["include_execution_data"].GetBoolValue())
 	}
 
 	var items []historyInfo
 
-	listHistory, err := svc.GetExecutionHistory(ctx, params)
-	if err != nil {
-		plugin.Logger(ctx).Error("aws_sfn_state_machine_execution_history.getRowDataForExecutionHistory", "api_error", err)
-		return nil, err
-	}
+	paginator := sfn.NewGetExecutionHistoryPaginator(svc, params, func(o *sfn.GetExecutionHistoryPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sfn_state_machine_execution_history.getRowDataForExecutionHistory", "api_error", err)
+			return nil, err
+		}
 
-	for _, event := range listHistory.Events {
-		items = append(items, historyInfo{event, arn})
+		for _, event := range output.Events {
+			items = append(items, historyInfo{event, arn})
+		}
 	}
 
 	return items, nil
@@ -369,3 +450,104 @@ func executionHistoryArn(ctx context.Context, d *transform.TransformData) (inter
 
 	return akas, nil
 }
+
+// executionHistoryStateMachineArn returns the ARN of the state machine that an
+// execution belongs to
+func executionHistoryStateMachineArn(executionArn string) string {
+	parts := strings.Split(executionArn, ":")
+	if len(parts) < 8 || parts[5] != "execution" {
+		return ""
+	}
+	return strings.Join(parts[:5], ":") + ":stateMachine:" + parts[6]
+}
+
+func executionHistoryStateName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	history := d.HydrateItem.(historyInfo)
+
+	switch {
+	case history.StateEnteredEventDetails != nil:
+		return history.StateEnteredEventDetails.Name, nil
+	case history.StateExitedEventDetails != nil:
+		return history.StateExitedEventDetails.Name, nil
+	}
+	return nil, nil
+}
+
+// executionHistoryPayload returns the input or output payload of an event,
+// depending on the transform param
+func executionHistoryPayload(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	history := d.HydrateItem.(historyInfo)
+
+	if d.Param.(string) == "Input" {
+		switch {
+		case history.ExecutionStartedEventDetails != nil:
+			return history.ExecutionStartedEventDetails.Input, nil
+		case history.StateEnteredEventDetails != nil:
+			return history.StateEnteredEventDetails.Input, nil
+		case history.TaskScheduledEventDetails != nil:
+			return history.TaskScheduledEventDetails.Parameters, nil
+		case history.LambdaFunctionScheduledEventDetails != nil:
+			return history.LambdaFunctionScheduledEventDetails.Input, nil
+		case history.ActivityScheduledEventDetails != nil:
+			return history.ActivityScheduledEventDetails.Input, nil
+		}
+		return nil, nil
+	}
+
+	switch {
+	case history.ExecutionSucceededEventDetails != nil:
+		return history.ExecutionSucceededEventDetails.Output, nil
+	case history.StateExitedEventDetails != nil:
+		return history.StateExitedEventDetails.Output, nil
+	case history.TaskSucceededEventDetails != nil:
+		return history.TaskSucceededEventDetails.Output, nil
+	case history.LambdaFunctionSucceededEventDetails != nil:
+		return history.LambdaFunctionSucceededEventDetails.Output, nil
+	case history.ActivitySucceededEventDetails != nil:
+		return history.ActivitySucceededEventDetails.Output, nil
+	}
+	return nil, nil
+}
+
+// executionHistoryFailure returns the error or cause of a failed, aborted or
+// timed out event, depending on the transform param
+func executionHistoryFailure(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	history := d.HydrateItem.(historyInfo)
+
+	var errorCode, cause *string
+	switch {
+	case history.ExecutionFailedEventDetails != nil:
+		errorCode, cause = history.ExecutionFailedEventDetails.Error, history.ExecutionFailedEventDetails.Cause
+	case history.ExecutionAbortedEventDetails != nil:
+		errorCode, cause = history.ExecutionAbortedEventDetails.Error, history.ExecutionAbortedEventDetails.Cause
+	case history.ExecutionTimedOutEventDetails != nil:
+		errorCode, cause = history.ExecutionTimedOutEventDetails.Error, history.ExecutionTimedOutEventDetails.Cause
+	case history.TaskFailedEventDetails != nil:
+		errorCode, cause = history.TaskFailedEventDetails.Error, history.TaskFailedEventDetails.Cause
+	case history.TaskStartFailedEventDetails != nil:
+		errorCode, cause = history.TaskStartFailedEventDetails.Error, history.TaskStartFailedEventDetails.Cause
+	case history.TaskSubmitFailedEventDetails != nil:
+		errorCode, cause = history.TaskSubmitFailedEventDetails.Error, history.TaskSubmitFailedEventDetails.Cause
+	case history.TaskTimedOutEventDetails != nil:
+		errorCode, cause = history.TaskTimedOutEventDetails.Error, history.TaskTimedOutEventDetails.Cause
+	case history.LambdaFunctionFailedEventDetails != nil:
+		errorCode, cause = history.LambdaFunctionFailedEventDetails.Error, history.LambdaFunctionFailedEventDetails.Cause
+	case history.LambdaFunctionScheduleFailedEventDetails != nil:
+		errorCode, cause = history.LambdaFunctionScheduleFailedEventDetails.Error, history.LambdaFunctionScheduleFailedEventDetails.Cause
+	case history.LambdaFunctionStartFailedEventDetails != nil:
+		errorCode, cause = history.LambdaFunctionStartFailedEventDetails.Error, history.LambdaFunctionStartFailedEventDetails.Cause
+	case history.LambdaFunctionTimedOutEventDetails != nil:
+		errorCode, cause = history.LambdaFunctionTimedOutEventDetails.Error, history.LambdaFunctionTimedOutEventDetails.Cause
+	case history.ActivityFailedEventDetails != nil:
+		errorCode, cause = history.ActivityFailedEventDetails.Error, history.ActivityFailedEventDetails.Cause
+	case history.ActivityScheduleFailedEventDetails != nil:
+		errorCode, cause = history.ActivityScheduleFailedEventDetails.Error, history.ActivityScheduleFailedEventDetails.Cause
+	case history.ActivityTimedOutEventDetails != nil:
+		errorCode, cause = history.ActivityTimedOutEventDetails.Error, history.ActivityTimedOutEventDetails.Cause
+	}
+
+	if d.Param.(string) == "Error" {
+		return errorCode, nil
+	}
+	return cause, nil
+}
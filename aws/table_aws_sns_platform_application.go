@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/turbot/go-kit/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSnsPlatformApplication(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sns_platform_application",
+		Description: "AWS SNS Platform Application",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"NotFound", "InvalidParameter"}),
+			},
+			Hydrate: getSnsPlatformApplication,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSnsPlatformApplications,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the platform application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlatformApplicationArn").TransformP(snsPlatformApplicationArnPart, 2),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the platform application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlatformApplicationArn"),
+			},
+			{
+				Name:        "platform",
+				Description: "The push notification service of the platform application, such as APNS, APNS_SANDBOX, GCM, ADM, BAIDU or MPNS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlatformApplicationArn").TransformP(snsPlatformApplicationArnPart, 1),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the platform application is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Attributes.Enabled"),
+			},
+			{
+				Name:        "apple_certificate_expiration_date",
+				Description: "The expiry date of the SSL certificate used to connect to APNs, for certificate based authentication.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.AppleCertificateExpirationDate"),
+			},
+			{
+				Name:        "apple_platform_team_id",
+				Description: "The Apple developer account team ID, for token based authentication with APNs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.ApplePlatformTeamID"),
+			},
+			{
+				Name:        "apple_platform_bundle_id",
+				Description: "The bundle identifier of the app, for token based authentication with APNs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.ApplePlatformBundleID"),
+			},
+			{
+				Name:        "event_endpoint_created",
+				Description: "The topic ARN to which EndpointCreated event notifications are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.EventEndpointCreated"),
+			},
+			{
+				Name:        "event_endpoint_deleted",
+				Description: "The topic ARN to which EndpointDeleted event notifications are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.EventEndpointDeleted"),
+			},
+			{
+				Name:        "event_endpoint_updated",
+				Description: "The topic ARN to which EndpointUpdate event notifications are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.EventEndpointUpdated"),
+			},
+			{
+				Name:        "event_delivery_failure",
+				Description: "The topic ARN to which DeliveryFailure event notifications are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.EventDeliveryFailure"),
+			},
+			{
+				Name:        "success_feedback_role_arn",
+				Description: "IAM role for successful deliveries of notification messages sent to the platform application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.SuccessFeedbackRoleArn"),
+			},
+			{
+				Name:        "failure_feedback_role_arn",
+				Description: "IAM role for failed deliveries of notification messages sent to the platform application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.FailureFeedbackRoleArn"),
+			},
+			{
+				Name:        "success_feedback_sample_rate",
+				Description: "Sample rate for successful deliveries of notification messages sent to the platform application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.SuccessFeedbackSampleRate"),
+			},
+			{
+				Name:        "attributes",
+				Description: "All attributes of the platform application.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlatformApplicationArn").TransformP(snsPlatformApplicationArnPart, 2),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PlatformApplicationArn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSnsPlatformApplications(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := SNSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_platform_application.listSnsPlatformApplications", "get_client_error", err)
+		return nil, err
+	}
+
+	params := &sns.ListPlatformApplicationsInput{}
+	// Does not support limit
+	paginator := sns.NewListPlatformApplicationsPaginator(svc, params, func(o *sns.ListPlatformApplicationsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sns_platform_application.listSnsPlatformApplications", "api_error", err)
+			return nil, err
+		}
+		for _, application := range output.PlatformApplications {
+			d.StreamListItem(ctx, application)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSnsPlatformApplication(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	arn := d.KeyColumnQuals["arn"].GetStringValue()
+
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := SNSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_platform_application.getSnsPlatformApplication", "get_client_error", err)
+		return nil, err
+	}
+
+	// Build params
+	params := &sns.GetPlatformApplicationAttributesInput{
+		PlatformApplicationArn: aws.String(arn),
+	}
+
+	op, err := svc.GetPlatformApplicationAttributes(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_platform_application.getSnsPlatformApplication", "api_error", err)
+		return nil, err
+	}
+
+	return snsTypes.PlatformApplication{
+		PlatformApplicationArn: aws.String(arn),
+		Attributes:             op.Attributes,
+	}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// snsPlatformApplicationArnPart returns a part of the resource path of a
+// platform application ARN, e.g. arn:aws:sns:us-east-1:123456789012:app/<platform>/<name>
+func snsPlatformApplicationArnPart(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	arn := types.SafeString(d.Value)
+	index := d.Param.(int)
+
+	parts := strings.SplitN(arn, "/", 3)
+	if len(parts) <= index {
+		return nil, nil
+	}
+	return parts[index], nil
+}
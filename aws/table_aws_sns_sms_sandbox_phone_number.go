@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSnsSmsSandboxPhoneNumber(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sns_sms_sandbox_phone_number",
+		Description: "AWS SNS SMS Sandbox Phone Number",
+		List: &plugin.ListConfig{
+			Hydrate: listSnsSmsSandboxPhoneNumbers,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "phone_number",
+				Description: "The destination phone number, in E.164 format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The verification status of the phone number, either Pending or Verified.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PhoneNumber"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSnsSmsSandboxPhoneNumbers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := SNSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_sms_sandbox_phone_number.listSnsSmsSandboxPhoneNumbers", "get_client_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &sns.ListSMSSandboxPhoneNumbersInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := sns.NewListSMSSandboxPhoneNumbersPaginator(svc, params, func(o *sns.ListSMSSandboxPhoneNumbersPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sns_sms_sandbox_phone_number.listSnsSmsSandboxPhoneNumbers", "api_error", err)
+			return nil, err
+		}
+		for _, phoneNumber := range output.PhoneNumbers {
+			d.StreamListItem(ctx, phoneNumber)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSnsSmsSettings(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sns_sms_settings",
+		Description: "AWS SNS SMS Settings",
+		List: &plugin.ListConfig{
+			Hydrate: listSnsSmsSettings,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "is_in_sandbox",
+				Description: "Indicates whether the account is in the SMS sandbox in the region.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSnsSmsSandboxAccountStatus,
+				Transform:   transform.FromField("IsInSandbox"),
+			},
+			{
+				Name:        "monthly_spend_limit",
+				Description: "The maximum amount in USD that the account is willing to spend each month to send SMS messages.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attributes.MonthlySpendLimit"),
+			},
+			{
+				Name:        "default_sms_type",
+				Description: "The type of SMS message sent by default, either Promotional or Transactional.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.DefaultSMSType"),
+			},
+			{
+				Name:        "default_sender_id",
+				Description: "The default string that is displayed as the sender on the receiving device.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.DefaultSenderID"),
+			},
+			{
+				Name:        "delivery_status_iam_role",
+				Description: "The ARN of the IAM role that allows Amazon SNS to write logs about SMS deliveries in CloudWatch Logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.DeliveryStatusIAMRole"),
+			},
+			{
+				Name:        "delivery_status_success_sampling_rate",
+				Description: "The percentage of successful SMS deliveries for which Amazon SNS will write logs in CloudWatch Logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.DeliveryStatusSuccessSamplingRate"),
+			},
+			{
+				Name:        "usage_report_s3_bucket",
+				Description: "The name of the Amazon S3 bucket that receives daily SMS usage reports.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.UsageReportS3Bucket"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSnsSmsSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := SNSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_sms_settings.listSnsSmsSettings", "get_client_error", err)
+		return nil, err
+	}
+
+	op, err := svc.GetSMSAttributes(ctx, &sns.GetSMSAttributesInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_sms_settings.listSnsSmsSettings", "api_error", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, op)
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSnsSmsSandboxAccountStatus(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get client
+	svc, err := SNSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_sms_settings.getSnsSmsSandboxAccountStatus", "get_client_error", err)
+		return nil, err
+	}
+
+	op, err := svc.GetSMSSandboxAccountStatus(ctx, &sns.GetSMSSandboxAccountStatusInput{})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sns_sms_settings.getSnsSmsSandboxAccountStatus", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
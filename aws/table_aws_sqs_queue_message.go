@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSqsQueueMessage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_sqs_queue_message",
+		Description: "AWS SQS Queue Message",
+		List: &plugin.ListConfig{
+			Hydrate: listSqsQueueMessages,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "queue_url"},
+				{Name: "visibility_timeout", Require: plugin.Optional},
+				{Name: "max_messages", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AWS.SimpleQueueService.NonExistentQueue"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "queue_url",
+				Description: "The URL of the queue the message was received from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("queue_url"),
+			},
+			{
+				Name:        "message_id",
+				Description: "A unique identifier for the message.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "body",
+				Description: "The message's contents.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "md5_of_body",
+				Description: "An MD5 digest of the message body.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sent_timestamp",
+				Description: "The time at which the message was sent to the queue.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.SentTimestamp").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "approximate_first_receive_timestamp",
+				Description: "The time at which the message was first received from the queue.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.ApproximateFirstReceiveTimestamp").Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "approximate_receive_count",
+				Description: "The number of times the message has been received across all queues but not deleted, including this receive.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attributes.ApproximateReceiveCount"),
+			},
+			{
+				Name:        "sender_id",
+				Description: "The IAM user ID or role ID of the sender of the message.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.SenderId"),
+			},
+			{
+				Name:        "message_group_id",
+				Description: "The message group ID of the message, for FIFO queues.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.MessageGroupId"),
+			},
+			{
+				Name:        "message_deduplication_id",
+				Description: "The message deduplication ID of the message, for FIFO queues.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.MessageDeduplicationId"),
+			},
+			{
+				Name:        "visibility_timeout",
+				Description: "The duration, in seconds, that the received messages are hidden from subsequent retrieve requests. Defaults to 0 so the messages stay visible to consumers.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("visibility_timeout"),
+			},
+			{
+				Name:        "max_messages",
+				Description: "The maximum number of messages to receive from the queue. Defaults to 10, and can be at most 100.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("max_messages"),
+			},
+			{
+				Name:        "attributes",
+				Description: "The system attributes of the message.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "message_attributes",
+				Description: "The custom attributes of the message.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MessageId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSqsQueueMessages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	queueURL := d.KeyColumnQuals["queue_url"].GetStringValue()
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// Only receive messages in the region of the queue, since every receive
+	// increments the receive count of the messages
+	queueRegion, err := extractRegionFromSqsQueueURL(queueURL)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sqs_queue_message.listSqsQueueMessages", "invalid_queue_url", err)
+		return nil, err
+	}
+	if queueRegion != region {
+		return nil, nil
+	}
+
+	// Get client
+	svc, err := SQSClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_sqs_queue_message.listSqsQueueMessages", "get_client_error", err)
+		return nil, err
+	}
+
+	// By default received messages are made visible again straight away, so
+	// peeking at a queue doesn't hide messages from its consumers
+	visibilityTimeout := int32(0)
+	if d.KeyColumnQuals["visibility_timeout"] != nil {
+		visibilityTimeout = int32(d.KeyColumnQuals["visibility_timeout"].GetInt64Value())
+	}
+
+	// Each receive increments the receive count of the messages, so cap the
+	// number of messages received by a query
+	maxMessages := int64(10)
+	if d.KeyColumnQuals["max_messages"] != nil {
+		maxMessages = d.KeyColumnQuals["max_messages"].GetInt64Value()
+		if maxMessages > 100 {
+			maxMessages = 100
+		}
+	}
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < maxMessages {
+		maxMessages = *d.QueryContext.Limit
+	}
+	if maxMessages < 1 {
+		maxMessages = 1
+	}
+
+	params := &sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(queueURL),
+		VisibilityTimeout:     visibilityTimeout,
+		AttributeNames:        []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeName("All")},
+		MessageAttributeNames: []string{"All"},
+	}
+
+	// Messages are not deleted, so with a low visibility timeout the same
+	// messages can be returned again. Stop after a single pass over the queue,
+	// i.e. as soon as a call returns nothing or a message already streamed.
+	seen := map[string]bool{}
+	for int64(len(seen)) < maxMessages {
+		// ReceiveMessage returns at most 10 messages per call
+		params.MaxNumberOfMessages = int32(maxMessages - int64(len(seen)))
+		if params.MaxNumberOfMessages > 10 {
+			params.MaxNumberOfMessages = 10
+		}
+
+		output, err := svc.ReceiveMessage(ctx, params)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_sqs_queue_message.listSqsQueueMessages", "api_error", err)
+			return nil, err
+		}
+		if len(output.Messages) == 0 {
+			break
+		}
+
+		duplicate := false
+		for _, message := range output.Messages {
+			if seen[*message.MessageId] {
+				duplicate = true
+				continue
+			}
+			seen[*message.MessageId] = true
+
+			d.StreamListItem(ctx, message)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if duplicate {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,281 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMAutomationStepExecution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_automation_step_execution",
+		Description: "AWS SSM Automation Step Execution",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"AutomationExecutionNotFoundException", "ValidationException"}),
+			},
+			Hydrate: listAwsSSMAutomationStepExecutions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "automation_execution_id", Require: plugin.Required},
+				{Name: "step_execution_id", Require: plugin.Optional},
+				{Name: "step_name", Require: plugin.Optional},
+				{Name: "step_status", Require: plugin.Optional},
+				{Name: "action", Require: plugin.Optional},
+				{Name: "execution_start_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "automation_execution_id",
+				Description: "The ID of the automation execution the step belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("automation_execution_id"),
+			},
+			{
+				Name:        "step_execution_id",
+				Description: "The unique ID of a step execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "step_name",
+				Description: "The name of this execution step.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "The action this step performs. The action determines the behavior of the step.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "step_status",
+				Description: "The execution status for this step.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "execution_start_time",
+				Description: "If a step has begun execution, this contains the time the step started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "execution_end_time",
+				Description: "If a step has finished execution, this contains the time the execution ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "failure_message",
+				Description: "If a step failed, this message explains why the execution failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "response",
+				Description: "A message associated with the response code for an execution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "response_code",
+				Description: "The response code returned by the execution of the step.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "on_failure",
+				Description: "The action to take if the step fails.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_attempts",
+				Description: "The maximum number of tries to run the action of the step.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "timeout_seconds",
+				Description: "The timeout seconds of the step.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "is_critical",
+				Description: "The flag which can be used to help decide whether the failure of current step leads to the Automation failure.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_end",
+				Description: "The flag which can be used to end automation no matter whether the step succeeds or fails.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "next_step",
+				Description: "The next step after the step succeeds.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "valid_next_steps",
+				Description: "Strategies used when step fails.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "inputs",
+				Description: "Fully-resolved values passed into the step before execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "outputs",
+				Description: "Returned values from the execution of the step.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "overridden_parameters",
+				Description: "A user-specified list of parameters to override when running a step.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "failure_details",
+				Description: "Information about the step failure, including the failure stage, type and details.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "targets",
+				Description: "The targets for the step execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_location",
+				Description: "The combination of Amazon Web Services Regions and Amazon Web Services accounts targeted by the current Automation execution.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StepName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMAutomationStepExecutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_automation_step_execution.listAwsSSMAutomationStepExecutions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	executionId := d.KeyColumnQuals["automation_execution_id"].GetStringValue()
+
+	// Build the params
+	maxItems := int32(50)
+	input := &ssm.DescribeAutomationStepExecutionsInput{
+		AutomationExecutionId: aws.String(executionId),
+	}
+
+	filters := buildSSMAutomationStepExecutionFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := ssm.NewDescribeAutomationStepExecutionsPaginator(svc, input, func(o *ssm.DescribeAutomationStepExecutionsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_automation_step_execution.listAwsSSMAutomationStepExecutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, step := range output.StepExecutions {
+			d.StreamListItem(ctx, step)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm automation step execution list call input filter
+func buildSSMAutomationStepExecutionFilter(quals plugin.KeyColumnQualMap) []types.StepExecutionFilter {
+	filters := make([]types.StepExecutionFilter, 0)
+
+	filterQuals := map[string]types.StepExecutionFilterKey{
+		"step_execution_id": types.StepExecutionFilterKeyStepExecutionId,
+		"step_name":         types.StepExecutionFilterKeyStepName,
+		"step_status":       types.StepExecutionFilterKeyStepExecutionStatus,
+		"action":            types.StepExecutionFilterKeyAction,
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.StepExecutionFilter{
+				Key: filterName,
+			}
+
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			} else {
+				filter.Values = value.([]string)
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	if quals["execution_start_time"] != nil {
+		for _, q := range quals["execution_start_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				filters = append(filters, types.StepExecutionFilter{
+					Key:    types.StepExecutionFilterKeyStartTimeAfter,
+					Values: []string{timestamp},
+				})
+			case "<", "<=":
+				filters = append(filters, types.StepExecutionFilter{
+					Key:    types.StepExecutionFilterKeyStartTimeBefore,
+					Values: []string{timestamp},
+				})
+			}
+		}
+	}
+
+	return filters
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMInstancePatch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_instance_patch",
+		Description: "AWS SSM Instance Patch",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidInstanceId", "ValidationException"}),
+			},
+			Hydrate: listAwsSSMInstancePatches,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "instance_id", Require: plugin.Required},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "classification", Require: plugin.Optional},
+				{Name: "kb_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the managed node the patch compliance information was collected for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("instance_id"),
+			},
+			{
+				Name:        "title",
+				Description: "The title of the patch.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kb_id",
+				Description: "The operating system-specific ID of the patch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KBId"),
+			},
+			{
+				Name:        "classification",
+				Description: "The classification of the patch, such as SecurityUpdates, Updates, and CriticalUpdates.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the patch such as Critical, Important, and Moderate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the patch on the managed node, such as INSTALLED or FAILED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "installed_time",
+				Description: "The date/time the patch was installed on the managed node.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cve_ids",
+				Description: "The IDs of one or more Common Vulnerabilities and Exposure (CVE) issues that are resolved by the patch.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CVEIds").Transform(splitSSMPatchCVEIds),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMInstancePatches(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_instance_patch.listAwsSSMInstancePatches", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	instanceId := d.KeyColumnQuals["instance_id"].GetStringValue()
+
+	// Build the params
+	maxItems := int32(100)
+	input := &ssm.DescribeInstancePatchesInput{
+		InstanceId: aws.String(instanceId),
+	}
+
+	filters := buildSSMInstancePatchFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 10 {
+				maxItems = int32(10)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := ssm.NewDescribeInstancePatchesPaginator(svc, input, func(o *ssm.DescribeInstancePatchesPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_instance_patch.listAwsSSMInstancePatches", "api_error", err)
+			return nil, err
+		}
+
+		for _, patch := range output.Patches {
+			d.StreamListItem(ctx, patch)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The API returns the CVE IDs as a single comma separated string
+func splitSSMPatchCVEIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	cveIds := d.Value.(*string)
+	if cveIds == nil || *cveIds == "" {
+		return nil, nil
+	}
+
+	return strings.Split(*cveIds, ","), nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm instance patch list call input filter
+func buildSSMInstancePatchFilter(quals plugin.KeyColumnQualMap) []types.PatchOrchestratorFilter {
+	filters := make([]types.PatchOrchestratorFilter, 0)
+
+	filterQuals := map[string]string{
+		"state":          "State",
+		"severity":       "Severity",
+		"classification": "Classification",
+		"kb_id":          "KBId",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.PatchOrchestratorFilter{
+				Key: aws.String(filterName),
+			}
+
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			} else {
+				filter.Values = value.([]string)
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
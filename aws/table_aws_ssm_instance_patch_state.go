@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMInstancePatchState(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_instance_patch_state",
+		Description: "AWS SSM Instance Patch State",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSsmManagedInstances,
+			Hydrate:       listAwsSSMInstancePatchStates,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "instance_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "instance_id",
+				Description: "The ID of the managed node the high-level patch compliance information was collected for.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "patch_group",
+				Description: "The name of the patch group the managed node belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "baseline_id",
+				Description: "The ID of the patch baseline used to patch the managed node.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The type of patching operation that was performed: Scan or Install.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation_start_time",
+				Description: "The time the most recent patching operation was started on the managed node.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "operation_end_time",
+				Description: "The time the most recent patching operation completed on the managed node.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "installed_count",
+				Description: "The number of patches from the patch baseline that are installed on the managed node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_other_count",
+				Description: "The number of patches not specified in the patch baseline that are installed on the managed node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_pending_reboot_count",
+				Description: "The number of patches installed since the last time the managed node was rebooted.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "installed_rejected_count",
+				Description: "The number of patches installed on the managed node that are specified in a RejectedPatches list.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "missing_count",
+				Description: "The number of patches from the patch baseline that are applicable for the managed node but aren't currently installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "failed_count",
+				Description: "The number of patches from the patch baseline that were attempted to be installed during the last patching operation, but failed to install.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "not_applicable_count",
+				Description: "The number of patches from the patch baseline that aren't applicable for the managed node and therefore aren't installed on the node.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "unreported_not_applicable_count",
+				Description: "The number of patches beyond the supported limit of NotApplicableCount that aren't reported by name to Inventory.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "critical_non_compliant_count",
+				Description: "The number of patches per node that are specified as Critical for compliance reporting in the patch baseline aren't installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "security_non_compliant_count",
+				Description: "The number of patches per node that are specified as Security in a patch advisory aren't installed.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "other_non_compliant_count",
+				Description: "The number of patches per node that are specified as other than Critical or Security but aren't compliant with the patch baseline.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "last_no_reboot_install_operation_time",
+				Description: "The time of the last attempt to patch the managed node with NoReboot specified as the reboot option.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "reboot_option",
+				Description: "Indicates the reboot option specified in the patch baseline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "snapshot_id",
+				Description: "The ID of the patch baseline snapshot used during the patching operation when this compliance data was collected.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "install_override_list",
+				Description: "An https URL or an Amazon S3 path-style URL to a list of patches to be installed.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceId"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMInstancePatchStates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(types.InstanceInformation)
+
+	// Minimize API calls when a specific instance has been requested
+	if d.KeyColumnQuals["instance_id"] != nil && d.KeyColumnQuals["instance_id"].GetStringValue() != *instance.InstanceId {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_instance_patch_state.listAwsSSMInstancePatchStates", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &ssm.DescribeInstancePatchStatesInput{
+		InstanceIds: []string{*instance.InstanceId},
+	}
+
+	paginator := ssm.NewDescribeInstancePatchStatesPaginator(svc, input, func(o *ssm.DescribeInstancePatchStatesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_instance_patch_state.listAwsSSMInstancePatchStates", "api_error", err)
+			return nil, err
+		}
+
+		for _, state := range output.InstancePatchStates {
+			d.StreamLeafListItem(ctx, state)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
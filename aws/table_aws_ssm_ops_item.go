@@ -0,0 +1,475 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMOpsItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_ops_item",
+		Description: "AWS SSM OpsItem",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("ops_item_id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"OpsItemNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getAwsSSMOpsItem,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMOpsItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+				{Name: "source", Require: plugin.Optional},
+				{Name: "category", Require: plugin.Optional},
+				{Name: "ops_item_type", Require: plugin.Optional},
+				{Name: "created_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "ops_item_id",
+				Description: "The ID of the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: "A short heading that describes the nature of the OpsItem and the impacted resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItemARN,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "status",
+				Description: "The OpsItem status. Status can be Open, In Progress, or Resolved.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "A list of OpsItems by severity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "priority",
+				Description: "The importance of this OpsItem in relation to other OpsItems in the system.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "source",
+				Description: "The impacted Amazon Web Services resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "A list of OpsItems by category.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "ops_item_type",
+				Description: "The type of OpsItem, for example /aws/issue or /aws/changerequest.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_by",
+				Description: "The Amazon Resource Name (ARN) of the IAM entity that created the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time the OpsItem was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The Amazon Resource Name (ARN) of the IAM entity that last updated the OpsItem.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time the OpsItem was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "actual_start_time",
+				Description: "The time a runbook workflow started. Currently reported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "actual_end_time",
+				Description: "The time a runbook workflow ended. Currently reported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "planned_start_time",
+				Description: "The time specified in a change request for a runbook workflow to start. Currently supported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "planned_end_time",
+				Description: "The time specified in a change request for a runbook workflow to end. Currently supported only for the OpsItem type /aws/changerequest.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "description",
+				Description: "The OpsItem description.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItemDetails,
+			},
+			{
+				Name:        "version",
+				Description: "The version of this OpsItem.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAwsSSMOpsItemDetails,
+			},
+			{
+				Name:        "operational_data",
+				Description: "Operational data is custom data that provides useful reference details about the OpsItem.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notifications",
+				Description: "The Amazon Resource Name (ARN) of an Amazon Simple Notification Service (Amazon SNS) topic where notifications are sent when this OpsItem is edited or changed.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemDetails,
+			},
+			{
+				Name:        "related_ops_items",
+				Description: "One or more OpsItems that share something in common with the current OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemDetails,
+			},
+			{
+				Name:        "related_items",
+				Description: "The resources, runbooks and other items that are related to the OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAwsSSMOpsItemRelatedItems,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the OpsItem.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemTags,
+				Transform:   transform.FromField("TagList"),
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemTags,
+				Transform:   transform.FromField("TagList").Transform(ssmOpsItemTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAwsSSMOpsItemARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMOpsItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItems", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(50)
+	input := &ssm.DescribeOpsItemsInput{}
+
+	filters := buildSSMOpsItemFilter(d.Quals)
+	if len(filters) > 0 {
+		input.OpsItemFilters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := ssm.NewDescribeOpsItemsPaginator(svc, input, func(o *ssm.DescribeOpsItemsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.OpsItemSummaries {
+			d.StreamListItem(ctx, item)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMOpsItem(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.KeyColumnQuals["ops_item_id"].GetStringValue()
+
+	// check if ops_item_id is empty
+	if id == "" {
+		return nil, nil
+	}
+
+	item, err := getAwsSSMOpsItemByID(ctx, d, id)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return types.OpsItemSummary{
+		ActualEndTime:    item.ActualEndTime,
+		ActualStartTime:  item.ActualStartTime,
+		Category:         item.Category,
+		CreatedBy:        item.CreatedBy,
+		CreatedTime:      item.CreatedTime,
+		LastModifiedBy:   item.LastModifiedBy,
+		LastModifiedTime: item.LastModifiedTime,
+		OperationalData:  item.OperationalData,
+		OpsItemId:        item.OpsItemId,
+		OpsItemType:      item.OpsItemType,
+		PlannedEndTime:   item.PlannedEndTime,
+		PlannedStartTime: item.PlannedStartTime,
+		Priority:         item.Priority,
+		Severity:         item.Severity,
+		Source:           item.Source,
+		Status:           item.Status,
+		Title:            item.Title,
+	}, nil
+}
+
+// getAwsSSMOpsItemDetails returns the description, notifications and related
+// OpsItems, which are not included in the list response
+func getAwsSSMOpsItemDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.OpsItemSummary).OpsItemId
+
+	item, err := getAwsSSMOpsItemByID(ctx, d, *id)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func getAwsSSMOpsItemByID(ctx context.Context, d *plugin.QueryData, id string) (*types.OpsItem, error) {
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemByID", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	op, err := svc.GetOpsItem(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemByID", "api_error", err)
+		return nil, err
+	}
+
+	return op.OpsItem, nil
+}
+
+func listAwsSSMOpsItemRelatedItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.OpsItemSummary).OpsItemId
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItemRelatedItems", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	input := &ssm.ListOpsItemRelatedItemsInput{
+		OpsItemId: id,
+	}
+
+	paginator := ssm.NewListOpsItemRelatedItemsPaginator(svc, input, func(o *ssm.ListOpsItemRelatedItemsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var relatedItems []types.OpsItemRelatedItemSummary
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_ops_item.listAwsSSMOpsItemRelatedItems", "api_error", err)
+			return nil, err
+		}
+		relatedItems = append(relatedItems, output.Summaries...)
+	}
+
+	return relatedItems, nil
+}
+
+// API call for fetching tag list
+func getAwsSSMOpsItemTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	id := h.Item.(types.OpsItemSummary).OpsItemId
+
+	// Create Session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	// Build the params
+	params := &ssm.ListTagsForResourceInput{
+		ResourceType: types.ResourceTypeForTaggingOpsItem,
+		ResourceId:   id,
+	}
+
+	// Get call
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getAwsSSMOpsItemARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	id := h.Item.(types.OpsItemSummary).OpsItemId
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_ops_item.getAwsSSMOpsItemARN", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ssm:" + region + ":" + commonColumnData.AccountId + ":opsitem/" + *id
+
+	return arn, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func ssmOpsItemTagListToTurbotTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList := d.Value.([]types.Tag)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if tagList != nil {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tagList {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm ops item list call input filter
+func buildSSMOpsItemFilter(quals plugin.KeyColumnQualMap) []types.OpsItemFilter {
+	filters := make([]types.OpsItemFilter, 0)
+
+	filterQuals := map[string]types.OpsItemFilterKey{
+		"status":        types.OpsItemFilterKeyStatus,
+		"severity":      types.OpsItemFilterKeySeverity,
+		"source":        types.OpsItemFilterKeySource,
+		"category":      types.OpsItemFilterKeyCategory,
+		"ops_item_type": types.OpsItemFilterKeyOpsitemType,
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.OpsItemFilter{
+				Key:      filterName,
+				Operator: types.OpsItemFilterOperatorEqual,
+			}
+
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			} else {
+				filter.Values = value.([]string)
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	if quals["created_time"] != nil {
+		for _, q := range quals["created_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			filter := types.OpsItemFilter{
+				Key:    types.OpsItemFilterKeyCreatedTime,
+				Values: []string{timestamp},
+			}
+			switch q.Operator {
+			case ">=", ">":
+				filter.Operator = types.OpsItemFilterOperatorGreaterThan
+			case "<", "<=":
+				filter.Operator = types.OpsItemFilterOperatorLessThan
+			}
+			filters = append(filters, filter)
+		}
+	}
+
+	return filters
+}
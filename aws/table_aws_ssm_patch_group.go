@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSSMPatchGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_patch_group",
+		Description: "AWS SSM Patch Group",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMPatchGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "patch_group", Require: plugin.Optional},
+				{Name: "operating_system", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "patch_group",
+				Description: "The name of the patch group registered with the patch baseline.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "baseline_id",
+				Description: "The ID of the patch baseline registered with the patch group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BaselineIdentity.BaselineId"),
+			},
+			{
+				Name:        "baseline_name",
+				Description: "The name of the patch baseline registered with the patch group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BaselineIdentity.BaselineName"),
+			},
+			{
+				Name:        "operating_system",
+				Description: "Defines the operating system the patch baseline applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BaselineIdentity.OperatingSystem"),
+			},
+			{
+				Name:        "default_baseline",
+				Description: "Whether this is the default baseline for the operating system.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("BaselineIdentity.DefaultBaseline"),
+			},
+			{
+				Name:        "instances",
+				Description: "The number of managed nodes in the patch group.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_installed_patches",
+				Description: "The number of managed nodes with installed patches.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_installed_other_patches",
+				Description: "The number of managed nodes with patches installed that aren't defined in the patch baseline.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_installed_pending_reboot_patches",
+				Description: "The number of managed nodes with patches installed that are awaiting a reboot.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_installed_rejected_patches",
+				Description: "The number of managed nodes with patches installed that are specified in a RejectedPatches list.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_missing_patches",
+				Description: "The number of managed nodes with missing patches from the patch baseline.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_failed_patches",
+				Description: "The number of managed nodes with patches from the patch baseline that failed to install.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_not_applicable_patches",
+				Description: "The number of managed nodes with patches that aren't applicable.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_unreported_not_applicable_patches",
+				Description: "The number of managed nodes with NotApplicable patches beyond the supported limit, which aren't reported by name to Inventory.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_critical_non_compliant_patches",
+				Description: "The number of managed nodes where patches that are specified as Critical for compliance reporting in the patch baseline aren't installed.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_security_non_compliant_patches",
+				Description: "The number of managed nodes where patches that are specified as Security in a patch advisory aren't installed.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+			{
+				Name:        "instances_with_other_non_compliant_patches",
+				Description: "The number of managed nodes with patches installed that are specified as other than Critical or Security but aren't compliant with the patch baseline.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAwsSSMPatchGroupState,
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PatchGroup"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMPatchGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_patch_group.listAwsSSMPatchGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(100)
+	input := &ssm.DescribePatchGroupsInput{}
+
+	filters := buildSSMPatchGroupFilter(d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	input.MaxResults = aws.Int32(maxItems)
+	paginator := ssm.NewDescribePatchGroupsPaginator(svc, input, func(o *ssm.DescribePatchGroupsPaginatorOptions) {
+		o.Limit = maxItems
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_ssm_patch_group.listAwsSSMPatchGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, mapping := range output.Mappings {
+			d.StreamListItem(ctx, mapping)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAwsSSMPatchGroupState(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	patchGroup := h.Item.(types.PatchGroupPatchBaselineMapping).PatchGroup
+
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_patch_group.getAwsSSMPatchGroupState", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	params := &ssm.DescribePatchGroupStateInput{
+		PatchGroup: patchGroup,
+	}
+
+	op, err := svc.DescribePatchGroupState(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_patch_group.getAwsSSMPatchGroupState", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm patch group list call input filter
+func buildSSMPatchGroupFilter(quals plugin.KeyColumnQualMap) []types.PatchOrchestratorFilter {
+	filters := make([]types.PatchOrchestratorFilter, 0)
+
+	filterQuals := map[string]string{
+		"patch_group":      "NAME_PREFIX",
+		"operating_system": "OPERATING_SYSTEM",
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.PatchOrchestratorFilter{
+				Key: aws.String(filterName),
+			}
+
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Values = []string{val}
+			} else {
+				filter.Values = value.([]string)
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type ssmSessionInfo = struct {
+	types.Session
+	State types.SessionState
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSSMSession(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_ssm_session",
+		Description: "AWS SSM Session",
+		List: &plugin.ListConfig{
+			Hydrate: listAwsSSMSessions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "session_id", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "target", Require: plugin.Optional},
+				{Name: "owner", Require: plugin.Optional},
+				{Name: "start_date", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "session_id",
+				Description: "The ID of the session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target",
+				Description: "The instance that the session is connected to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Whether the session is currently active (Active) or has ended (History).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the session. For example, Connected or Terminated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner",
+				Description: "The ID of the Amazon Web Services user account that started the session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_date",
+				Description: "The date and time when the session began.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date",
+				Description: "The date and time when the session was terminated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "document_name",
+				Description: "The name of the Session Manager SSM document used to define the parameters and plugin settings for the session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reason",
+				Description: "The reason for connecting to the instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "details",
+				Description: "Reserved for future use.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "max_session_duration",
+				Description: "The maximum duration of a session before it terminates.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "output_url",
+				Description: "The S3 bucket and CloudWatch log group the session output was sent to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSSMSessionARN,
+				Transform:   transform.FromValue().Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAwsSSMSessions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := SSMClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_session.listAwsSSMSessions", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region check
+		return nil, nil
+	}
+
+	maxItems := int32(200)
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxItems {
+			if limit < 1 {
+				maxItems = int32(1)
+			} else {
+				maxItems = int32(limit)
+			}
+		}
+	}
+
+	filters := buildSSMSessionFilter(d.Quals)
+
+	// DescribeSessions returns either active or terminated sessions, so both
+	// states are listed unless one has been requested
+	states := []types.SessionState{types.SessionStateActive, types.SessionStateHistory}
+	if d.KeyColumnQuals["state"] != nil {
+		states = []types.SessionState{types.SessionState(d.KeyColumnQuals["state"].GetStringValue())}
+	}
+
+	for _, state := range states {
+		input := &ssm.DescribeSessionsInput{
+			State: state,
+		}
+		if len(filters) > 0 {
+			input.Filters = filters
+		}
+
+		paginator := ssm.NewDescribeSessionsPaginator(svc, input, func(o *ssm.DescribeSessionsPaginatorOptions) {
+			o.Limit = maxItems
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_ssm_session.listAwsSSMSessions", "api_error", err)
+				return nil, err
+			}
+
+			for _, session := range output.Sessions {
+				d.StreamListItem(ctx, ssmSessionInfo{session, state})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSSMSessionARN(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	session := h.Item.(ssmSessionInfo)
+	getCommonColumnsCached := plugin.HydrateFunc(getCommonColumns).WithCache()
+	c, err := getCommonColumnsCached(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_ssm_session.getSSMSessionARN", "common_data_error", err)
+		return nil, err
+	}
+	commonColumnData := c.(*awsCommonColumnData)
+	arn := "arn:" + commonColumnData.Partition + ":ssm:" + region + ":" + commonColumnData.AccountId + ":session/" + *session.SessionId
+
+	return arn, nil
+}
+
+//// UTILITY FUNCTION
+
+// Build ssm session list call input filter
+func buildSSMSessionFilter(quals plugin.KeyColumnQualMap) []types.SessionFilter {
+	filters := make([]types.SessionFilter, 0)
+
+	filterQuals := map[string]string{
+		"session_id": string(types.SessionFilterKeySessionId),
+		"status":     string(types.SessionFilterKeyStatus),
+		"target":     string(types.SessionFilterKeyTargetId),
+		"owner":      string(types.SessionFilterKeyOwner),
+	}
+
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			filter := types.SessionFilter{
+				Key: types.SessionFilterKey(filterName),
+			}
+
+			value := getQualsValueByColumn(quals, columnName, "string")
+			val, ok := value.(string)
+			if ok {
+				filter.Value = aws.String(val)
+				filters = append(filters, filter)
+			}
+		}
+	}
+
+	if quals["start_date"] != nil {
+		for _, q := range quals["start_date"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			switch q.Operator {
+			case ">=", ">":
+				filters = append(filters, types.SessionFilter{
+					Key:   types.SessionFilterKeyInvokedAfter,
+					Value: aws.String(timestamp),
+				})
+			case "<", "<=":
+				filters = append(filters, types.SessionFilter{
+					Key:   types.SessionFilterKeyInvokedBefore,
+					Value: aws.String(timestamp),
+				})
+			}
+		}
+	}
+
+	return filters
+}
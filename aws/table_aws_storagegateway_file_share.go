@@ -0,0 +1,362 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsStorageGatewayFileShare(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_storagegateway_file_share",
+		Description: "AWS Storage Gateway File Share",
+		List: &plugin.ListConfig{
+			Hydrate: listStorageGatewayFileShares,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "gateway_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "file_share_name",
+				Description: "The name of the file share.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "file_share_id",
+				Description: "The ID of the file share.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the file share.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileShareARN"),
+			},
+			{
+				Name:        "file_share_type",
+				Description: "The type of the file share, which can be NFS or SMB.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_share_status",
+				Description: "The status of the file share, which can be CREATING, UPDATING, AVAILABLE or DELETING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_arn",
+				Description: "The Amazon Resource Name (ARN) of the gateway the file share belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayARN"),
+			},
+			{
+				Name:        "location_arn",
+				Description: "The ARN of the backend storage used for storing file data.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("LocationARN"),
+			},
+			{
+				Name:        "path",
+				Description: "The file share path used by the NFS or SMB client to identify the mount point.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "role",
+				Description: "The ARN of the IAM role that the file gateway assumes when it accesses the underlying storage.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "kms_encrypted",
+				Description: "Indicates whether the file share uses an Amazon S3 managed key or a KMS key for server-side encryption.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("KMSEncrypted"),
+			},
+			{
+				Name:        "kms_key",
+				Description: "The Amazon Resource Name (ARN) of the KMS key used for Amazon S3 server-side encryption.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("KMSKey"),
+			},
+			{
+				Name:        "default_storage_class",
+				Description: "The default storage class for objects put into an Amazon S3 bucket by the file gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "object_acl",
+				Description: "A value that sets the access control list (ACL) permission for objects in the S3 bucket that a file gateway puts objects into.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("ObjectACL"),
+			},
+			{
+				Name:        "read_only",
+				Description: "A value that sets the write status of a file share.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "requester_pays",
+				Description: "A value that sets who pays the cost of the request and the cost associated with data download from the S3 bucket.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "guess_mime_type_enabled",
+				Description: "A value that enables guessing of the MIME type for uploaded objects based on file extensions.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("GuessMIMETypeEnabled"),
+			},
+			{
+				Name:        "bucket_region",
+				Description: "The Region of the S3 bucket where the file share stores files.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "vpc_endpoint_dns_name",
+				Description: "The DNS name of the VPC endpoint used to connect to Amazon S3, if a VPC endpoint is used.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("VPCEndpointDNSName"),
+			},
+			{
+				Name:        "audit_destination_arn",
+				Description: "The Amazon Resource Name (ARN) of the storage used for audit logs.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("AuditDestinationARN"),
+			},
+			{
+				Name:        "squash",
+				Description: "The user mapped to anonymous user of an NFS file share. Only applies to NFS file shares.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "authentication",
+				Description: "The authentication method of the SMB file share, which can be ActiveDirectory or GuestAccess. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "case_sensitivity",
+				Description: "The case of an object name in an Amazon S3 bucket. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "smb_acl_enabled",
+				Description: "Indicates whether Windows ACLs are used to control access to the SMB file share. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("SMBACLEnabled"),
+			},
+			{
+				Name:        "access_based_enumeration",
+				Description: "Indicates whether AccessBasedEnumeration is enabled. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "oplocks_enabled",
+				Description: "Indicates whether opportunistic locking is enabled. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "client_list",
+				Description: "The list of clients that are allowed to access the file gateway. Only applies to NFS file shares.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "nfs_file_share_defaults",
+				Description: "Describes the default values for the NFS file share. Only applies to NFS file shares.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("NFSFileShareDefaults"),
+			},
+			{
+				Name:        "admin_user_list",
+				Description: "A list of users or groups in the Active Directory that have administrator rights to the file share. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "valid_user_list",
+				Description: "A list of users or groups in the Active Directory that are allowed to access the file share. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "invalid_user_list",
+				Description: "A list of users or groups in the Active Directory that are not allowed to access the file share. Only applies to SMB file shares.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "cache_attributes",
+				Description: "The refresh cache information for the file share.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "notification_policy",
+				Description: "The notification policy of the file share.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayFileShareDetails,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the file share.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FileShareId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayFileShareDetails,
+				Transform:   transform.FromField("Tags").Transform(storageGatewayTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FileShareARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageGatewayFileShares(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_file_share.listStorageGatewayFileShares", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &storagegateway.ListFileSharesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["gateway_arn"] != nil {
+		input.GatewayARN = aws.String(d.KeyColumnQuals["gateway_arn"].GetStringValue())
+	}
+
+	paginator := storagegateway.NewListFileSharesPaginator(svc, input, func(o *storagegateway.ListFileSharesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_file_share.listStorageGatewayFileShares", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.FileShareInfoList {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageGatewayFileShareDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fileShare := h.Item.(types.FileShareInfo)
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_file_share.getStorageGatewayFileShareDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// NFS and SMB file shares are described by different API operations
+	if fileShare.FileShareType == types.FileShareTypeSmb {
+		op, err := svc.DescribeSMBFileShares(ctx, &storagegateway.DescribeSMBFileSharesInput{
+			FileShareARNList: []string{*fileShare.FileShareARN},
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_file_share.getStorageGatewayFileShareDetails", "api_error", err)
+			return nil, err
+		}
+		if len(op.SMBFileShareInfoList) > 0 {
+			return op.SMBFileShareInfoList[0], nil
+		}
+		return nil, nil
+	}
+
+	op, err := svc.DescribeNFSFileShares(ctx, &storagegateway.DescribeNFSFileSharesInput{
+		FileShareARNList: []string{*fileShare.FileShareARN},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_file_share.getStorageGatewayFileShareDetails", "api_error", err)
+		return nil, err
+	}
+	if len(op.NFSFileShareInfoList) > 0 {
+		return op.NFSFileShareInfoList[0], nil
+	}
+
+	return nil, nil
+}
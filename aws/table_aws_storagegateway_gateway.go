@@ -0,0 +1,413 @@
+package aws
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsStorageGatewayGateway(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_storagegateway_gateway",
+		Description: "AWS Storage Gateway Gateway",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidGatewayRequestException", "ValidationException"}),
+			},
+			Hydrate: getStorageGatewayGateway,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStorageGatewayGateways,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "gateway_name",
+				Description: "The name of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_id",
+				Description: "The unique identifier assigned to the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayARN"),
+			},
+			{
+				Name:        "gateway_type",
+				Description: "The type of the gateway, which can be STORED, CACHED, VTL, VTL_SNOW, FILE_S3, FILE_FSX_SMB or FILE_FSX_ZFS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_operational_state",
+				Description: "The state of the gateway, which can be ACTIVE or SHUTDOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_state",
+				Description: "A value that indicates the operating state of the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "host_environment",
+				Description: "The type of hardware or software platform on which the gateway is running.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "software_version",
+				Description: "The version number of the software running on the gateway appliance.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "last_software_update",
+				Description: "The date on which the last software update was applied to the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "next_update_availability_date",
+				Description: "The date on which an update to the gateway is available.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "software_updates_end_date",
+				Description: "Date after which this gateway will not receive software updates for new features.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "gateway_timezone",
+				Description: "A value that indicates the time zone configured for the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "gateway_capacity",
+				Description: "Specifies the size of the gateway's metadata cache.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "The type of endpoint for your gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "vpc_endpoint",
+				Description: "The configuration settings for the virtual private cloud (VPC) endpoint for your gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+				Transform:   transform.FromField("VPCEndpoint"),
+			},
+			{
+				Name:        "ec2_instance_id",
+				Description: "The ID of the Amazon EC2 instance that was used to launch the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "ec2_instance_region",
+				Description: "The Amazon Web Services Region where the Amazon EC2 instance is located.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "cloud_watch_log_group_arn",
+				Description: "The Amazon Resource Name (ARN) of the Amazon CloudWatch log group that is used to monitor events in the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayGateway,
+				Transform:   transform.FromField("CloudWatchLogGroupARN"),
+			},
+			{
+				Name:        "cache_allocated_in_bytes",
+				Description: "The amount of cache in bytes allocated to a gateway.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getStorageGatewayCache,
+			},
+			{
+				Name:        "cache_used_percentage",
+				Description: "Percent use of the gateway's cache storage.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getStorageGatewayCache,
+			},
+			{
+				Name:        "cache_dirty_percentage",
+				Description: "The file share's contribution to the overall percentage of the gateway's cache that has not been persisted to Amazon Web Services.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getStorageGatewayCache,
+			},
+			{
+				Name:        "cache_hit_percentage",
+				Description: "Percent of application read operations from the file shares that are served from cache.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getStorageGatewayCache,
+			},
+			{
+				Name:        "cache_miss_percentage",
+				Description: "Percent of application read operations from the file shares that are not served from cache.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getStorageGatewayCache,
+			},
+			{
+				Name:        "upload_buffer_allocated_in_bytes",
+				Description: "The total number of bytes allocated in the gateway as upload buffer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getStorageGatewayUploadBuffer,
+			},
+			{
+				Name:        "upload_buffer_used_in_bytes",
+				Description: "The total number of bytes being used in the gateway's upload buffer.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getStorageGatewayUploadBuffer,
+			},
+			{
+				Name:        "gateway_network_interfaces",
+				Description: "A list of the network interfaces of the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "supported_gateway_capacities",
+				Description: "A list of the metadata cache sizes that the gateway can support based on its current hardware specifications.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayGateway,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayGateway,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayGateway,
+				Transform:   transform.FromField("Tags").Transform(storageGatewayTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GatewayARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageGatewayGateways(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.listStorageGatewayGateways", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &storagegateway.ListGatewaysInput{
+		Limit: aws.Int32(maxLimit),
+	}
+
+	paginator := storagegateway.NewListGatewaysPaginator(svc, input, func(o *storagegateway.ListGatewaysPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_gateway.listStorageGatewayGateways", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.Gateways {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageGatewayGateway(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := storageGatewayGatewayArn(d, h)
+
+	// Empty check
+	if arn == "" {
+		return nil, nil
+	}
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayGateway", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &storagegateway.DescribeGatewayInformationInput{
+		GatewayARN: aws.String(arn),
+	}
+
+	op, err := svc.DescribeGatewayInformation(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayGateway", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getStorageGatewayCache(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := storageGatewayGatewayArn(d, h)
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayCache", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &storagegateway.DescribeCacheInput{
+		GatewayARN: aws.String(arn),
+	}
+
+	op, err := svc.DescribeCache(ctx, params)
+	if err != nil {
+		// Gateways without a cache, e.g. stored volume gateways, do not support this operation
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "InvalidGatewayRequestException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayCache", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getStorageGatewayUploadBuffer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := storageGatewayGatewayArn(d, h)
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayUploadBuffer", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &storagegateway.DescribeUploadBufferInput{
+		GatewayARN: aws.String(arn),
+	}
+
+	op, err := svc.DescribeUploadBuffer(ctx, params)
+	if err != nil {
+		// File gateways do not use an upload buffer and do not support this operation
+		var ae smithy.APIError
+		if errors.As(err, &ae) {
+			if ae.ErrorCode() == "InvalidGatewayRequestException" {
+				return nil, nil
+			}
+		}
+		plugin.Logger(ctx).Error("aws_storagegateway_gateway.getStorageGatewayUploadBuffer", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func storageGatewayGatewayArn(d *plugin.QueryData, h *plugin.HydrateData) string {
+	switch item := h.Item.(type) {
+	case types.GatewayInfo:
+		return *item.GatewayARN
+	case *storagegateway.DescribeGatewayInformationOutput:
+		return *item.GatewayARN
+	}
+	return d.KeyColumnQuals["arn"].GetStringValue()
+}
+
+//// TRANSFORM FUNCTIONS
+
+func storageGatewayTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.Tag)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = *i.Value
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,300 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
+	"github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsStorageGatewayVolume(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_storagegateway_volume",
+		Description: "AWS Storage Gateway Volume",
+		List: &plugin.ListConfig{
+			Hydrate: listStorageGatewayVolumes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "gateway_arn", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "volume_id",
+				Description: "The unique identifier assigned to the volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the volume.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VolumeARN"),
+			},
+			{
+				Name:        "volume_type",
+				Description: "The type of the volume, which can be CACHED iSCSI or STORED iSCSI.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gateway_arn",
+				Description: "The Amazon Resource Name (ARN) of the gateway the volume belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayARN"),
+			},
+			{
+				Name:        "gateway_id",
+				Description: "The unique identifier assigned to the gateway the volume belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_attachment_status",
+				Description: "One of the VolumeStatus values that indicates the state of the volume.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "volume_size_in_bytes",
+				Description: "The size of the volume in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "volume_status",
+				Description: "One of the VolumeStatus values that indicates the state of the storage volume.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "volume_used_in_bytes",
+				Description: "The size of the data stored on the volume in bytes.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "volume_progress",
+				Description: "Represents the percentage complete if the volume is restoring or bootstrapping.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the volume was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "kms_key",
+				Description: "The Amazon Resource Name (ARN) of the KMS key used for server-side encryption.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayVolumeDetails,
+				Transform:   transform.FromField("KMSKey"),
+			},
+			{
+				Name:        "source_snapshot_id",
+				Description: "If the volume was created from a snapshot, the ID of that snapshot.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "target_name",
+				Description: "The name of the iSCSI target used by an initiator to connect to the volume.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "volume_disk_id",
+				Description: "The ID of the local disk that was specified in the CreateStorediSCSIVolume operation. Only applies to stored volumes.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "preserved_existing_data",
+				Description: "Indicates if when the stored volume was created, existing data on the underlying local disk was preserved. Only applies to stored volumes.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getStorageGatewayVolumeDetails,
+			},
+			{
+				Name:        "volume_iscsi_attributes",
+				Description: "An VolumeiSCSIAttributes object that represents a collection of iSCSI attributes for one stored volume.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getStorageGatewayVolumeDetails,
+				Transform:   transform.FromField("VolumeiSCSIAttributes"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the volume.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listStorageGatewayVolumeTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VolumeId"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listStorageGatewayVolumeTags,
+				Transform:   transform.FromValue().Transform(storageGatewayTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VolumeARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageGatewayVolumes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_volume.listStorageGatewayVolumes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &storagegateway.ListVolumesInput{
+		Limit: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["gateway_arn"] != nil {
+		input.GatewayARN = aws.String(d.KeyColumnQuals["gateway_arn"].GetStringValue())
+	}
+
+	paginator := storagegateway.NewListVolumesPaginator(svc, input, func(o *storagegateway.ListVolumesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_volume.listStorageGatewayVolumes", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range output.VolumeInfos {
+			d.StreamListItem(ctx, item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStorageGatewayVolumeDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	volume := h.Item.(types.VolumeInfo)
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_volume.getStorageGatewayVolumeDetails", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Cached and stored volumes are described by different API operations
+	if volume.VolumeType != nil && *volume.VolumeType == "STORED iSCSI" {
+		op, err := svc.DescribeStorediSCSIVolumes(ctx, &storagegateway.DescribeStorediSCSIVolumesInput{
+			VolumeARNs: []string{*volume.VolumeARN},
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_volume.getStorageGatewayVolumeDetails", "api_error", err)
+			return nil, err
+		}
+		if len(op.StorediSCSIVolumes) > 0 {
+			return op.StorediSCSIVolumes[0], nil
+		}
+		return nil, nil
+	}
+
+	op, err := svc.DescribeCachediSCSIVolumes(ctx, &storagegateway.DescribeCachediSCSIVolumesInput{
+		VolumeARNs: []string{*volume.VolumeARN},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_volume.getStorageGatewayVolumeDetails", "api_error", err)
+		return nil, err
+	}
+	if len(op.CachediSCSIVolumes) > 0 {
+		return op.CachediSCSIVolumes[0], nil
+	}
+
+	return nil, nil
+}
+
+func listStorageGatewayVolumeTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	volume := h.Item.(types.VolumeInfo)
+
+	// Create session
+	svc, err := StorageGatewayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_storagegateway_volume.listStorageGatewayVolumeTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &storagegateway.ListTagsForResourceInput{
+		ResourceARN: volume.VolumeARN,
+	}
+
+	paginator := storagegateway.NewListTagsForResourcePaginator(svc, params, func(o *storagegateway.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var tags []types.Tag
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_storagegateway_volume.listStorageGatewayVolumeTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
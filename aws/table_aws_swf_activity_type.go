@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type swfActivityTypeInfo = struct {
+	types.ActivityTypeInfo
+	Domain *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSWFActivityType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_swf_activity_type",
+		Description: "AWS SWF Activity Type",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain", "name", "version"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UnknownResourceFault"}),
+			},
+			Hydrate: getSWFActivityType,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSWFDomains,
+			Hydrate:       listSWFActivityTypes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "domain", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the activity type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActivityType.Name"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the activity type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActivityType.Version"),
+			},
+			{
+				Name:        "domain",
+				Description: "The name of the domain in which the activity type is registered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The registration status of the activity type, either REGISTERED or DEPRECATED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the activity type provided through RegisterActivityType.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the activity type was registered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deprecation_date",
+				Description: "The date and time the activity type was deprecated, if it has been deprecated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "default_task_list",
+				Description: "The default task list to use for scheduling tasks of the activity type.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+				Transform:   transform.FromField("DefaultTaskList.Name"),
+			},
+			{
+				Name:        "default_task_priority",
+				Description: "The default task priority for tasks of the activity type.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+			},
+			{
+				Name:        "default_task_heartbeat_timeout",
+				Description: "The default maximum time, in seconds, before which a worker processing a task must report progress by calling RecordActivityTaskHeartbeat. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+			},
+			{
+				Name:        "default_task_schedule_to_close_timeout",
+				Description: "The default maximum duration, in seconds, for tasks of the activity type. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+			},
+			{
+				Name:        "default_task_schedule_to_start_timeout",
+				Description: "The default maximum duration, in seconds, that a task of the activity type can wait before being assigned to a worker. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+			},
+			{
+				Name:        "default_task_start_to_close_timeout",
+				Description: "The default maximum duration, in seconds, that a worker can take to process tasks of the activity type. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFActivityTypeConfiguration,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActivityType.Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSWFActivityTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(types.DomainInfo)
+
+	// Minimize API calls when a specific domain has been requested
+	if d.KeyColumnQuals["domain"] != nil && d.KeyColumnQuals["domain"].GetStringValue() != *domain.Name {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_activity_type.listSWFActivityTypes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The registration status is required, so list both registered and
+	// deprecated activity types unless a specific status has been requested
+	statuses := []types.RegistrationStatus{types.RegistrationStatusRegistered, types.RegistrationStatusDeprecated}
+	if d.KeyColumnQuals["status"] != nil {
+		statuses = []types.RegistrationStatus{types.RegistrationStatus(d.KeyColumnQuals["status"].GetStringValue())}
+	}
+
+	for _, status := range statuses {
+		input := &swf.ListActivityTypesInput{
+			Domain:             domain.Name,
+			RegistrationStatus: status,
+		}
+		if d.KeyColumnQuals["name"] != nil {
+			input.Name = aws.String(d.KeyColumnQuals["name"].GetStringValue())
+		}
+
+		paginator := swf.NewListActivityTypesPaginator(svc, input, func(o *swf.ListActivityTypesPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_swf_activity_type.listSWFActivityTypes", "api_error", err)
+				return nil, err
+			}
+
+			for _, activityType := range output.TypeInfos {
+				d.StreamLeafListItem(ctx, swfActivityTypeInfo{activityType, domain.Name})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSWFActivityType(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domain := d.KeyColumnQuals["domain"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+	version := d.KeyColumnQuals["version"].GetStringValue()
+
+	// check if domain, name or version is empty
+	if domain == "" || name == "" || version == "" {
+		return nil, nil
+	}
+
+	op, err := describeSWFActivityType(ctx, d, domain, name, version)
+	if err != nil || op == nil || op.TypeInfo == nil {
+		return nil, err
+	}
+
+	return swfActivityTypeInfo{*op.TypeInfo, aws.String(domain)}, nil
+}
+
+func getSWFActivityTypeConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	activityType := h.Item.(swfActivityTypeInfo)
+
+	op, err := describeSWFActivityType(ctx, d, *activityType.Domain, *activityType.ActivityType.Name, *activityType.ActivityType.Version)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op.Configuration, nil
+}
+
+func describeSWFActivityType(ctx context.Context, d *plugin.QueryData, domain string, name string, version string) (*swf.DescribeActivityTypeOutput, error) {
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_activity_type.describeSWFActivityType", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &swf.DescribeActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &types.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	op, err := svc.DescribeActivityType(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_activity_type.describeSWFActivityType", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,256 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsSWFDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_swf_domain",
+		Description: "AWS SWF Domain",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UnknownResourceFault"}),
+			},
+			Hydrate: getSWFDomain,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSWFDomains,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The registration status of the domain, either REGISTERED or DEPRECATED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the domain provided through RegisterDomain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workflow_execution_retention_period_in_days",
+				Description: "The retention period for workflow executions in the domain, in days.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getSWFDomainConfiguration,
+				Transform:   transform.FromField("WorkflowExecutionRetentionPeriodInDays"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the domain.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSWFDomainTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSWFDomainTags,
+				Transform:   transform.FromField("Tags").Transform(swfTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSWFDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_domain.listSWFDomains", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The registration status is required, so list both registered and
+	// deprecated domains unless a specific status has been requested
+	statuses := []types.RegistrationStatus{types.RegistrationStatusRegistered, types.RegistrationStatusDeprecated}
+	if d.KeyColumnQuals["status"] != nil {
+		statuses = []types.RegistrationStatus{types.RegistrationStatus(d.KeyColumnQuals["status"].GetStringValue())}
+	}
+
+	for _, status := range statuses {
+		input := &swf.ListDomainsInput{
+			RegistrationStatus: status,
+		}
+
+		paginator := swf.NewListDomainsPaginator(svc, input, func(o *swf.ListDomainsPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_swf_domain.listSWFDomains", "api_error", err)
+				return nil, err
+			}
+
+			for _, domain := range output.DomainInfos {
+				d.StreamListItem(ctx, domain)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSWFDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	// check if name is empty
+	if name == "" {
+		return nil, nil
+	}
+
+	op, err := describeSWFDomain(ctx, d, name)
+	if err != nil || op == nil || op.DomainInfo == nil {
+		return nil, err
+	}
+
+	return *op.DomainInfo, nil
+}
+
+func getSWFDomainConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := h.Item.(types.DomainInfo).Name
+
+	op, err := describeSWFDomain(ctx, d, *name)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op.Configuration, nil
+}
+
+func describeSWFDomain(ctx context.Context, d *plugin.QueryData, name string) (*swf.DescribeDomainOutput, error) {
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_domain.describeSWFDomain", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &swf.DescribeDomainInput{
+		Name: aws.String(name),
+	}
+
+	op, err := svc.DescribeDomain(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_domain.describeSWFDomain", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getSWFDomainTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	arn := h.Item.(types.DomainInfo).Arn
+
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_domain.getSWFDomainTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &swf.ListTagsForResourceInput{
+		ResourceArn: arn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_domain.getSWFDomainTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func swfTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.ResourceTag)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,264 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type swfWorkflowTypeInfo = struct {
+	types.WorkflowTypeInfo
+	Domain *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsSWFWorkflowType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_swf_workflow_type",
+		Description: "AWS SWF Workflow Type",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"domain", "name", "version"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"UnknownResourceFault"}),
+			},
+			Hydrate: getSWFWorkflowType,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSWFDomains,
+			Hydrate:       listSWFWorkflowTypes,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "domain", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the workflow type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkflowType.Name"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the workflow type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkflowType.Version"),
+			},
+			{
+				Name:        "domain",
+				Description: "The name of the domain in which the workflow type is registered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The registration status of the workflow type, either REGISTERED or DEPRECATED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the workflow type provided through RegisterWorkflowType.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date and time the workflow type was registered.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deprecation_date",
+				Description: "The date and time the workflow type was deprecated, if it has been deprecated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "default_task_list",
+				Description: "The default task list to use for scheduling decision tasks for executions of the workflow type.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+				Transform:   transform.FromField("DefaultTaskList.Name"),
+			},
+			{
+				Name:        "default_task_priority",
+				Description: "The default task priority to assign to the workflow type.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+			},
+			{
+				Name:        "default_child_policy",
+				Description: "The default policy to use for the child workflow executions when a workflow execution of this type is terminated, such as TERMINATE or ABANDON.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+			},
+			{
+				Name:        "default_execution_start_to_close_timeout",
+				Description: "The default maximum duration, in seconds, for executions of the workflow type. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+			},
+			{
+				Name:        "default_task_start_to_close_timeout",
+				Description: "The default maximum duration, in seconds, of decision tasks for the workflow type. NONE means unlimited.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+			},
+			{
+				Name:        "default_lambda_role",
+				Description: "The default IAM role attached to the workflow type to invoke Lambda functions.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSWFWorkflowTypeConfiguration,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkflowType.Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSWFWorkflowTypes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(types.DomainInfo)
+
+	// Minimize API calls when a specific domain has been requested
+	if d.KeyColumnQuals["domain"] != nil && d.KeyColumnQuals["domain"].GetStringValue() != *domain.Name {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_workflow_type.listSWFWorkflowTypes", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	// The registration status is required, so list both registered and
+	// deprecated workflow types unless a specific status has been requested
+	statuses := []types.RegistrationStatus{types.RegistrationStatusRegistered, types.RegistrationStatusDeprecated}
+	if d.KeyColumnQuals["status"] != nil {
+		statuses = []types.RegistrationStatus{types.RegistrationStatus(d.KeyColumnQuals["status"].GetStringValue())}
+	}
+
+	for _, status := range statuses {
+		input := &swf.ListWorkflowTypesInput{
+			Domain:             domain.Name,
+			RegistrationStatus: status,
+		}
+		if d.KeyColumnQuals["name"] != nil {
+			input.Name = aws.String(d.KeyColumnQuals["name"].GetStringValue())
+		}
+
+		paginator := swf.NewListWorkflowTypesPaginator(svc, input, func(o *swf.ListWorkflowTypesPaginatorOptions) {
+			o.Limit = maxLimit
+			o.StopOnDuplicateToken = true
+		})
+
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("aws_swf_workflow_type.listSWFWorkflowTypes", "api_error", err)
+				return nil, err
+			}
+
+			for _, workflowType := range output.TypeInfos {
+				d.StreamLeafListItem(ctx, swfWorkflowTypeInfo{workflowType, domain.Name})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSWFWorkflowType(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	domain := d.KeyColumnQuals["domain"].GetStringValue()
+	name := d.KeyColumnQuals["name"].GetStringValue()
+	version := d.KeyColumnQuals["version"].GetStringValue()
+
+	// check if domain, name or version is empty
+	if domain == "" || name == "" || version == "" {
+		return nil, nil
+	}
+
+	op, err := describeSWFWorkflowType(ctx, d, domain, name, version)
+	if err != nil || op == nil || op.TypeInfo == nil {
+		return nil, err
+	}
+
+	return swfWorkflowTypeInfo{*op.TypeInfo, aws.String(domain)}, nil
+}
+
+func getSWFWorkflowTypeConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflowType := h.Item.(swfWorkflowTypeInfo)
+
+	op, err := describeSWFWorkflowType(ctx, d, *workflowType.Domain, *workflowType.WorkflowType.Name, *workflowType.WorkflowType.Version)
+	if err != nil || op == nil {
+		return nil, err
+	}
+
+	return op.Configuration, nil
+}
+
+func describeSWFWorkflowType(ctx context.Context, d *plugin.QueryData, domain string, name string, version string) (*swf.DescribeWorkflowTypeOutput, error) {
+	// Create Session
+	svc, err := SWFClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_workflow_type.describeSWFWorkflowType", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &types.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	op, err := svc.DescribeWorkflowType(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_swf_workflow_type.describeSWFWorkflowType", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTimestreamDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_timestream_database",
+		Description: "AWS Timestream Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("database_name"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getTimestreamDatabase,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTimestreamDatabases,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "database_name",
+				Description: "The name of the Timestream database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name that uniquely identifies this database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the database was created, calculated from the Unix epoch time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The last time that this database was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The identifier of the KMS key used to encrypt the data stored in the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_count",
+				Description: "The total number of tables found within a Timestream database.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the database.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamResourceTags,
+				Transform:   transform.From(timestreamTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listTimestreamDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := TimestreamWriteClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_database.listTimestreamDatabases", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(20)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &timestreamwrite.ListDatabasesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := timestreamwrite.NewListDatabasesPaginator(svc, input, func(o *timestreamwrite.ListDatabasesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_timestream_database.listTimestreamDatabases", "api_error", err)
+			return nil, err
+		}
+
+		for _, database := range output.Databases {
+			d.StreamListItem(ctx, database)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTimestreamDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.KeyColumnQuals["database_name"].GetStringValue()
+
+	// Empty check
+	if name == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := TimestreamWriteClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_database.getTimestreamDatabase", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &timestreamwrite.DescribeDatabaseInput{
+		DatabaseName: aws.String(name),
+	}
+
+	op, err := svc.DescribeDatabase(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_database.getTimestreamDatabase", "api_error", err)
+		return nil, err
+	}
+
+	if op.Database != nil {
+		return *op.Database, nil
+	}
+	return nil, nil
+}
+
+// listTimestreamResourceTags is shared by the Timestream database and table tables
+func listTimestreamResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var resourceArn *string
+	switch item := h.Item.(type) {
+	case types.Database:
+		resourceArn = item.Arn
+	case types.Table:
+		resourceArn = item.Arn
+	}
+
+	if resourceArn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := TimestreamWriteClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream.listTimestreamResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &timestreamwrite.ListTagsForResourceInput{
+		ResourceARN: resourceArn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream.listTimestreamResourceTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func timestreamTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	tags := d.HydrateItem.(*timestreamwrite.ListTagsForResourceOutput)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if len(tags.Tags) > 0 {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags.Tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
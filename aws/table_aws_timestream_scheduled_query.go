@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTimestreamScheduledQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_timestream_scheduled_query",
+		Description: "AWS Timestream Scheduled Query",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("arn"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getTimestreamScheduledQuery,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTimestreamScheduledQueries,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the scheduled query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name of the scheduled query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the scheduled query, which can be ENABLED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the scheduled query.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_run_status",
+				Description: "Status of the last scheduled query run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "previous_invocation_time",
+				Description: "The last time the scheduled query was run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "next_invocation_time",
+				Description: "The next time the scheduled query is to be run.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "schedule_expression",
+				Description: "An expression that denotes when to trigger the scheduled query run, e.g. a cron or rate expression.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTimestreamScheduledQuery,
+				Transform:   transform.FromField("ScheduleConfiguration.ScheduleExpression"),
+			},
+			{
+				Name:        "query_string",
+				Description: "The query to be run.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "scheduled_query_execution_role_arn",
+				Description: "The IAM role that Timestream uses to run the scheduled query.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "A customer provided KMS key used to encrypt the scheduled query resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "target_destination",
+				Description: "The Timestream database and table the scheduled query writes its results to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "target_configuration",
+				Description: "The configuration used for writing the results of the query.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "notification_configuration",
+				Description: "The notification configuration for the scheduled query.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "error_report_configuration",
+				Description: "The configuration for where error reports are sent when the scheduled query fails.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "last_run_summary",
+				Description: "Runtime summary for the last scheduled query run.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "recently_failed_runs",
+				Description: "Runtime summary for the last five failed scheduled query runs.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTimestreamScheduledQuery,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the scheduled query.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamScheduledQueryTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamScheduledQueryTags,
+				Transform:   transform.From(timestreamScheduledQueryTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listTimestreamScheduledQueries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := TimestreamQueryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_scheduled_query.listTimestreamScheduledQueries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(1000)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &timestreamquery.ListScheduledQueriesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	paginator := timestreamquery.NewListScheduledQueriesPaginator(svc, input, func(o *timestreamquery.ListScheduledQueriesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_timestream_scheduled_query.listTimestreamScheduledQueries", "api_error", err)
+			return nil, err
+		}
+
+		for _, query := range output.ScheduledQueries {
+			d.StreamListItem(ctx, query)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTimestreamScheduledQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var queryArn string
+	if h.Item != nil {
+		queryArn = *h.Item.(types.ScheduledQuery).Arn
+	} else {
+		queryArn = d.KeyColumnQuals["arn"].GetStringValue()
+	}
+
+	// Empty check
+	if queryArn == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := TimestreamQueryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_scheduled_query.getTimestreamScheduledQuery", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &timestreamquery.DescribeScheduledQueryInput{
+		ScheduledQueryArn: aws.String(queryArn),
+	}
+
+	op, err := svc.DescribeScheduledQuery(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_scheduled_query.getTimestreamScheduledQuery", "api_error", err)
+		return nil, err
+	}
+
+	return op.ScheduledQuery, nil
+}
+
+func listTimestreamScheduledQueryTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var queryArn *string
+	switch item := h.Item.(type) {
+	case types.ScheduledQuery:
+		queryArn = item.Arn
+	case *types.ScheduledQueryDescription:
+		queryArn = item.Arn
+	}
+
+	if queryArn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := TimestreamQueryClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_scheduled_query.listTimestreamScheduledQueryTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &timestreamquery.ListTagsForResourceInput{
+		ResourceARN: queryArn,
+	}
+
+	op, err := svc.ListTagsForResource(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_scheduled_query.listTimestreamScheduledQueryTags", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func timestreamScheduledQueryTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	tags := d.HydrateItem.(*timestreamquery.ListTagsForResourceOutput)
+
+	// Mapping the resource tags inside turbotTags
+	var turbotTagsMap map[string]string
+	if len(tags.Tags) > 0 {
+		turbotTagsMap = map[string]string{}
+		for _, i := range tags.Tags {
+			turbotTagsMap[*i.Key] = *i.Value
+		}
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,227 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTimestreamTable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_timestream_table",
+		Description: "AWS Timestream Table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"database_name", "table_name"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException", "ValidationException"}),
+			},
+			Hydrate: getTimestreamTable,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTimestreamTables,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "database_name", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"ResourceNotFoundException"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "table_name",
+				Description: "The name of the Timestream table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name that uniquely identifies this table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the Timestream database that contains this table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "table_status",
+				Description: "The current state of the table, which can be ACTIVE, DELETING or RESTORING.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "creation_time",
+				Description: "The time when the Timestream table was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_updated_time",
+				Description: "The time when the Timestream table was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "memory_store_retention_period_in_hours",
+				Description: "The duration for which data must be stored in the memory store.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetentionProperties.MemoryStoreRetentionPeriodInHours"),
+			},
+			{
+				Name:        "magnetic_store_retention_period_in_days",
+				Description: "The duration for which data must be stored in the magnetic store.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RetentionProperties.MagneticStoreRetentionPeriodInDays"),
+			},
+			{
+				Name:        "enable_magnetic_store_writes",
+				Description: "A flag to enable magnetic store writes.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MagneticStoreWriteProperties.EnableMagneticStoreWrites"),
+			},
+			{
+				Name:        "magnetic_store_rejected_data_location",
+				Description: "The location to write error reports for records rejected asynchronously during magnetic store writes.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MagneticStoreWriteProperties.MagneticStoreRejectedDataLocation"),
+			},
+			{
+				Name:        "schema",
+				Description: "The schema of the table, i.e. the partition keys used for composite partitioning.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags attached to the table.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamResourceTags,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listTimestreamResourceTags,
+				Transform:   transform.From(timestreamTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listTimestreamTables(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := TimestreamWriteClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_table.listTimestreamTables", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	// Limiting the results
+	maxLimit := int32(20)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &timestreamwrite.ListTablesInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+
+	if d.KeyColumnQuals["database_name"] != nil {
+		input.DatabaseName = aws.String(d.KeyColumnQuals["database_name"].GetStringValue())
+	}
+
+	paginator := timestreamwrite.NewListTablesPaginator(svc, input, func(o *timestreamwrite.ListTablesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_timestream_table.listTimestreamTables", "api_error", err)
+			return nil, err
+		}
+
+		for _, table := range output.Tables {
+			d.StreamListItem(ctx, table)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTimestreamTable(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	databaseName := d.KeyColumnQuals["database_name"].GetStringValue()
+	tableName := d.KeyColumnQuals["table_name"].GetStringValue()
+
+	// Empty check
+	if databaseName == "" || tableName == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := TimestreamWriteClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_table.getTimestreamTable", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	params := &timestreamwrite.DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	op, err := svc.DescribeTable(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_timestream_table.getTimestreamTable", "api_error", err)
+		return nil, err
+	}
+
+	if op.Table != nil {
+		return *op.Table, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsTrustedAdvisorCheck(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_trustedadvisor_check",
+		Description: "AWS Trusted Advisor Check",
+		List: &plugin.ListConfig{
+			Hydrate: listTrustedAdvisorChecks,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "language", Require: plugin.Optional},
+				{Name: "source", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier of the Trusted Advisor check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the Trusted Advisor check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Trusted Advisor check.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of what the Trusted Advisor check is monitoring.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The source of the check, for example ta_check, compute_optimizer or security_hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pillars",
+				Description: "The recommendation pillars that the check falls under, for example cost_optimizing, security, fault_tolerance, performance or service_limits.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "aws_services",
+				Description: "The AWS services that the check applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "The column headings for the metadata returned for each resource flagged by the check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the latest recommendation of the check: ok, warning or error.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The time the recommendation of the check was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+			},
+			{
+				Name:        "resources_ok",
+				Description: "The number of resources that were flagged to be OK by the check.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+				Transform:   transform.FromField("ResourcesAggregates.OkCount"),
+			},
+			{
+				Name:        "resources_warning",
+				Description: "The number of resources that were flagged with a warning by the check.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+				Transform:   transform.FromField("ResourcesAggregates.WarningCount"),
+			},
+			{
+				Name:        "resources_error",
+				Description: "The number of resources that were flagged with an error by the check.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+				Transform:   transform.FromField("ResourcesAggregates.ErrorCount"),
+			},
+			{
+				Name:        "estimated_monthly_savings",
+				Description: "The estimated monthly savings that might be realized if the recommended operations are taken. Only set for cost optimizing checks.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+				Transform:   transform.FromField("PillarSpecificAggregates.CostOptimizing.EstimatedMonthlySavings"),
+			},
+			{
+				Name:        "estimated_percent_monthly_savings",
+				Description: "The estimated percentage of monthly savings that might be realized if the recommended operations are taken. Only set for cost optimizing checks.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getTrustedAdvisorCheckRecommendation,
+				Transform:   transform.FromField("PillarSpecificAggregates.CostOptimizing.EstimatedPercentMonthlySavings"),
+			},
+			{
+				Name:        "language",
+				Description: "The ISO 639-1 code for the language the check is described in. Defaults to en.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("language"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listTrustedAdvisorChecks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := TrustedAdvisorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_trustedadvisor_check.listTrustedAdvisorChecks", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(200)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &trustedadvisor.ListChecksInput{
+		MaxResults: aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["language"] != nil {
+		input.Language = types.RecommendationLanguage(d.KeyColumnQuals["language"].GetStringValue())
+	}
+	if d.KeyColumnQuals["source"] != nil {
+		input.Source = types.RecommendationSource(d.KeyColumnQuals["source"].GetStringValue())
+	}
+
+	paginator := trustedadvisor.NewListChecksPaginator(svc, input, func(o *trustedadvisor.ListChecksPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_trustedadvisor_check.listTrustedAdvisorChecks", "api_error", err)
+			return nil, err
+		}
+
+		for _, check := range output.CheckSummaries {
+			d.StreamListItem(ctx, check)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTrustedAdvisorCheckRecommendation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	check := h.Item.(types.CheckSummary)
+
+	// Create Session
+	svc, err := TrustedAdvisorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_trustedadvisor_check.getTrustedAdvisorCheckRecommendation", "connection_error", err)
+		return nil, err
+	}
+
+	params := &trustedadvisor.ListRecommendationsInput{
+		CheckIdentifier: check.Arn,
+	}
+
+	op, err := svc.ListRecommendations(ctx, params)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_trustedadvisor_check.getTrustedAdvisorCheckRecommendation", "api_error", err)
+		return nil, err
+	}
+
+	if len(op.RecommendationSummaries) > 0 {
+		return op.RecommendationSummaries[0], nil
+	}
+
+	return nil, nil
+}
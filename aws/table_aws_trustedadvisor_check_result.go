@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type trustedAdvisorCheckResultInfo = struct {
+	types.RecommendationResourceSummary
+	CheckArn             *string
+	RecommendationName   *string
+	RecommendationStatus types.RecommendationStatus
+	Pillars              []types.RecommendationPillar
+}
+
+//// TABLE DEFINITION
+
+func tableAwsTrustedAdvisorCheckResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_trustedadvisor_check_result",
+		Description: "AWS Trusted Advisor Check Result",
+		List: &plugin.ListConfig{
+			ParentHydrate: listTrustedAdvisorRecommendations,
+			Hydrate:       listTrustedAdvisorCheckResults,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "check_arn", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "resource_region", Require: plugin.Optional},
+				{Name: "exclusion_status", Require: plugin.Optional},
+			},
+		},
+		Columns: awsColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the recommendation resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the recommendation resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "aws_resource_id",
+				Description: "The identifier of the flagged AWS resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "check_arn",
+				Description: "The Amazon Resource Name (ARN) of the Trusted Advisor check that flagged the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_arn",
+				Description: "The Amazon Resource Name (ARN) of the recommendation the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_name",
+				Description: "The name of the recommendation the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_status",
+				Description: "The status of the recommendation the resource belongs to: ok, warning or error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "pillars",
+				Description: "The pillars that the recommendation is optimizing.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the resource: ok, warning or error.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "exclusion_status",
+				Description: "Whether the resource is excluded from the recommendation: excluded or included.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_region",
+				Description: "The AWS Region of the flagged resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegionCode"),
+			},
+			{
+				Name:        "last_updated_at",
+				Description: "The time the recommendation resource was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "metadata",
+				Description: "Additional information about the flagged resource, keyed by the index of the column headings in the metadata of the check.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AwsResourceId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Arn").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listTrustedAdvisorRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := TrustedAdvisorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_trustedadvisor_check_result.listTrustedAdvisorRecommendations", "connection_error", err)
+		return nil, err
+	}
+
+	input := &trustedadvisor.ListRecommendationsInput{
+		MaxResults: aws.Int32(200),
+	}
+
+	// Minimize API calls when a specific check has been requested
+	if d.KeyColumnQuals["check_arn"] != nil {
+		input.CheckIdentifier = aws.String(d.KeyColumnQuals["check_arn"].GetStringValue())
+	}
+
+	paginator := trustedadvisor.NewListRecommendationsPaginator(svc, input, func(o *trustedadvisor.ListRecommendationsPaginatorOptions) {
+		o.Limit = 200
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_trustedadvisor_check_result.listTrustedAdvisorRecommendations", "api_error", err)
+			return nil, err
+		}
+
+		for _, recommendation := range output.RecommendationSummaries {
+			d.StreamListItem(ctx, recommendation)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listTrustedAdvisorCheckResults(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	recommendation := h.Item.(types.RecommendationSummary)
+
+	// Create Session
+	svc, err := TrustedAdvisorClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_trustedadvisor_check_result.listTrustedAdvisorCheckResults", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(200)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	input := &trustedadvisor.ListRecommendationResourcesInput{
+		RecommendationIdentifier: recommendation.Arn,
+		MaxResults:               aws.Int32(maxLimit),
+	}
+	if d.KeyColumnQuals["status"] != nil {
+		input.Status = types.ResourceStatus(d.KeyColumnQuals["status"].GetStringValue())
+	}
+	if d.KeyColumnQuals["resource_region"] != nil {
+		input.RegionCode = aws.String(d.KeyColumnQuals["resource_region"].GetStringValue())
+	}
+	if d.KeyColumnQuals["exclusion_status"] != nil {
+		input.ExclusionStatus = types.ExclusionStatus(d.KeyColumnQuals["exclusion_status"].GetStringValue())
+	}
+
+	paginator := trustedadvisor.NewListRecommendationResourcesPaginator(svc, input, func(o *trustedadvisor.ListRecommendationResourcesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_trustedadvisor_check_result.listTrustedAdvisorCheckResults", "api_error", err)
+			return nil, err
+		}
+
+		for _, resource := range output.RecommendationResourceSummaries {
+			d.StreamLeafListItem(ctx, trustedAdvisorCheckResultInfo{
+				RecommendationResourceSummary: resource,
+				CheckArn:                      recommendation.CheckArn,
+				RecommendationName:            recommendation.Name,
+				RecommendationStatus:          recommendation.Status,
+				Pillars:                       recommendation.Pillars,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
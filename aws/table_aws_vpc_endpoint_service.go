@@ -237,16 +237,14 @@ func listVpcEndpointServicePermissions(ctx context.Context, d *plugin.QueryData,
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			if err != nil {
-				if strings.Contains(err.Error(), "NotFound") {
-					return nil, nil
-				}
-				plugin.Logger(ctx).Error("aws_vpc_endpoint_service.listVpcEndpointServicePermissions", "api_error", err)
-				return nil, err
+			if strings.Contains(err.Error(), "NotFound") {
+				return nil, nil
 			}
-
-			allowedPrincipals = append(allowedPrincipals, output.AllowedPrincipals...)
+			plugin.Logger(ctx).Error("aws_vpc_endpoint_service.listVpcEndpointServicePermissions", "api_error", err)
+			return nil, err
 		}
+
+		allowedPrincipals = append(allowedPrincipals, output.AllowedPrincipals...)
 	}
 	return allowedPrincipals, nil
 }
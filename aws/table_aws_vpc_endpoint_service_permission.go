@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type vpcEndpointServicePermission struct {
+	ServiceName        *string
+	AcceptanceRequired *bool
+	types.AllowedPrincipal
+}
+
+//// TABLE DEFINITION
+
+func tableAwsVpcEndpointServicePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_endpoint_service_permission",
+		Description: "AWS VPC Endpoint Service Permission",
+		List: &plugin.ListConfig{
+			ParentHydrate: listVpcEndpointServiceConfigurations,
+			Hydrate:       listVpcEndpointServicePermissionPrincipals,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service_id", Require: plugin.Optional},
+			},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidVpcEndpointServiceId.NotFound", "InvalidVpcEndpointServiceId.Malformed"}),
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "service_id",
+				Description: "The ID of the endpoint service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the endpoint service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal",
+				Description: "The Amazon Resource Name (ARN) of the principal allowed to create endpoints for the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of principal, such as Account, User, Role or All.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_account_id",
+				Description: "The ID of the AWS account of the principal, or '*' if all principals are allowed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal").Transform(vpcEndpointServicePrincipalAccountId),
+			},
+			{
+				Name:        "service_permission_id",
+				Description: "The ID of the service permission.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "acceptance_required",
+				Description: "Indicates whether requests from the principal to create an endpoint to the service must first be accepted.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "vpc_endpoint_connections",
+				Description: "The pending and available VPC endpoint connections to the service made from the principal's account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listVpcEndpointServicePermissionConnections,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags assigned to the service permission.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags").Transform(ec2TransitGatewayTagsToTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Principal"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listVpcEndpointServiceConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServiceConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	// Permissions can only be described for services owned by the account
+	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		MaxResults: aws.Int32(1000),
+	}
+
+	serviceId := d.KeyColumnQuals["service_id"].GetStringValue()
+	if serviceId != "" {
+		input.Filters = []types.Filter{
+			{
+				Name:   aws.String("service-id"),
+				Values: []string{serviceId},
+			},
+		}
+	}
+
+	paginator := ec2.NewDescribeVpcEndpointServiceConfigurationsPaginator(svc, input, func(o *ec2.DescribeVpcEndpointServiceConfigurationsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServiceConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range output.ServiceConfigurations {
+			d.StreamListItem(ctx, configuration)
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listVpcEndpointServicePermissionPrincipals(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configuration := h.Item.(types.ServiceConfiguration)
+
+	// Create session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServicePermissionPrincipals", "connection_error", err)
+		return nil, err
+	}
+
+	input := &ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId:  configuration.ServiceId,
+		MaxResults: aws.Int32(1000),
+	}
+
+	paginator := ec2.NewDescribeVpcEndpointServicePermissionsPaginator(svc, input, func(o *ec2.DescribeVpcEndpointServicePermissionsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServicePermissionPrincipals", "api_error", err)
+			return nil, err
+		}
+
+		for _, principal := range output.AllowedPrincipals {
+			// ServiceId is not populated for every principal
+			if principal.ServiceId == nil {
+				principal.ServiceId = configuration.ServiceId
+			}
+
+			d.StreamListItem(ctx, vpcEndpointServicePermission{
+				ServiceName:        configuration.ServiceName,
+				AcceptanceRequired: configuration.AcceptanceRequired,
+				AllowedPrincipal:   principal,
+			})
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listVpcEndpointServicePermissionConnections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	permission := h.Item.(vpcEndpointServicePermission)
+	principalAccountId := vpcEndpointServicePrincipalAccount(aws.ToString(permission.Principal))
+
+	// Create session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServicePermissionConnections", "connection_error", err)
+		return nil, err
+	}
+
+	input := &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("service-id"),
+				Values: []string{aws.ToString(permission.ServiceId)},
+			},
+		},
+		MaxResults: aws.Int32(1000),
+	}
+
+	// A wildcard principal allows endpoints from every account
+	if principalAccountId != "*" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   aws.String("vpc-endpoint-owner"),
+			Values: []string{principalAccountId},
+		})
+	}
+
+	paginator := ec2.NewDescribeVpcEndpointConnectionsPaginator(svc, input, func(o *ec2.DescribeVpcEndpointConnectionsPaginatorOptions) {
+		o.Limit = 1000
+		o.StopOnDuplicateToken = true
+	})
+
+	var connections []types.VpcEndpointConnection
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_endpoint_service_permission.listVpcEndpointServicePermissionConnections", "api_error", err)
+			return nil, err
+		}
+		connections = append(connections, output.VpcEndpointConnections...)
+	}
+
+	return connections, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func vpcEndpointServicePrincipalAccountId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	principal, ok := d.Value.(*string)
+	if !ok || principal == nil {
+		return nil, nil
+	}
+	return vpcEndpointServicePrincipalAccount(*principal), nil
+}
+
+//// UTILITY FUNCTIONS
+
+// vpcEndpointServicePrincipalAccount returns the account ID from a principal
+// ARN such as arn:aws:iam::123456789012:root, or "*" for the wildcard principal
+func vpcEndpointServicePrincipalAccount(principal string) string {
+	if principal == "*" {
+		return principal
+	}
+	parts := strings.Split(principal, ":")
+	if len(parts) < 5 {
+		return principal
+	}
+	return parts[4]
+}
@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type managedPrefixListEntry struct {
+	PrefixListId   *string
+	PrefixListName *string
+	PrefixListArn  *string
+	OwnerId        *string
+	AddressFamily  *string
+	Version        *int64
+	Cidr           *string
+	Description    *string
+}
+
+//// TABLE DEFINITION
+
+func tableAwsVpcManagedPrefixListEntry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_managed_prefix_list_entry",
+		Description: "AWS VPC Managed Prefix List Entry",
+		List: &plugin.ListConfig{
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: shouldIgnoreErrors([]string{"InvalidAction", "InvalidRequest", "InvalidPrefixListID.NotFound", "InvalidPrefixListId.Malformed"}),
+			},
+			ParentHydrate: listManagedPrefixListsForEntries,
+			Hydrate:       listManagedPrefixListEntries,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "prefix_list_id", Require: plugin.Optional},
+				{Name: "prefix_list_name", Require: plugin.Optional},
+				{Name: "owner_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "prefix_list_id",
+				Description: "The ID of the prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix_list_name",
+				Description: "The name of the prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cidr",
+				Description: "The CIDR block of the entry.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the entry.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the prefix list the entry belongs to.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "address_family",
+				Description: "The IP address version of the prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the owner of the prefix list. AWS-managed prefix lists are owned by AWS.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "prefix_list_arn",
+				Description: "The Amazon Resource Name (ARN) of the prefix list.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Cidr"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTIONS
+
+func listManagedPrefixListsForEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	equalQuals := d.KeyColumnQuals
+	filters := []types.Filter{}
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_managed_prefix_list_entry.listManagedPrefixListsForEntries", "connection_error", err)
+		return nil, err
+	}
+
+	params := &ec2.DescribeManagedPrefixListsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	if equalQuals["owner_id"] != nil {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("owner-id"),
+			Values: []string{equalQuals["owner_id"].GetStringValue()},
+		})
+	}
+
+	if equalQuals["prefix_list_id"] != nil {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("prefix-list-id"),
+			Values: []string{equalQuals["prefix_list_id"].GetStringValue()},
+		})
+	}
+
+	if equalQuals["prefix_list_name"] != nil {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("prefix-list-name"),
+			Values: []string{equalQuals["prefix_list_name"].GetStringValue()},
+		})
+	}
+
+	// Add filters as request parameter when at least one filter is present
+	if len(filters) > 0 {
+		params.Filters = filters
+	}
+
+	paginator := ec2.NewDescribeManagedPrefixListsPaginator(svc, params, func(o *ec2.DescribeManagedPrefixListsPaginatorOptions) {
+		o.Limit = 100
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_managed_prefix_list_entry.listManagedPrefixListsForEntries", "api_error", err)
+			return nil, err
+		}
+
+		for _, items := range output.PrefixLists {
+			d.StreamListItem(ctx, items)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listManagedPrefixListEntries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	prefixList := h.Item.(types.ManagedPrefixList)
+
+	// Create Session
+	svc, err := EC2Client(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_vpc_managed_prefix_list_entry.listManagedPrefixListEntries", "connection_error", err)
+		return nil, err
+	}
+
+	// Limiting the results
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			if limit < 1 {
+				maxLimit = 1
+			} else {
+				maxLimit = limit
+			}
+		}
+	}
+
+	params := &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: prefixList.PrefixListId,
+		MaxResults:   aws.Int32(maxLimit),
+	}
+
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(svc, params, func(o *ec2.GetManagedPrefixListEntriesPaginatorOptions) {
+		o.Limit = maxLimit
+		o.StopOnDuplicateToken = true
+	})
+
+	// List call
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_vpc_managed_prefix_list_entry.listManagedPrefixListEntries", "api_error", err)
+			return nil, err
+		}
+
+		for _, entry := range output.Entries {
+			d.StreamListItem(ctx, managedPrefixListEntry{
+				PrefixListId:   prefixList.PrefixListId,
+				PrefixListName: prefixList.PrefixListName,
+				PrefixListArn:  prefixList.PrefixListArn,
+				OwnerId:        prefixList.OwnerId,
+				AddressFamily:  prefixList.AddressFamily,
+				Version:        prefixList.Version,
+				Cidr:           entry.Cidr,
+				Description:    entry.Description,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
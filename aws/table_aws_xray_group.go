@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsXRayGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_group",
+		Description: "AWS X-Ray Group",
+		List: &plugin.ListConfig{
+			Hydrate: listXRayGroups,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The unique case-sensitive name of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupARN"),
+			},
+			{
+				Name:        "filter_expression",
+				Description: "The filter expression defining the parameters to include traces.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "insights_enabled",
+				Description: "Indicates whether insights are enabled for the group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InsightsConfiguration.InsightsEnabled"),
+			},
+			{
+				Name:        "notifications_enabled",
+				Description: "Indicates whether insight notifications are sent through Amazon EventBridge for the group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InsightsConfiguration.NotificationsEnabled"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GroupName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayResourceTags,
+				Transform:   transform.FromValue().Transform(xrayTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GroupARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRayGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := XRayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_xray_group.listXRayGroups", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &xray.GetGroupsInput{}
+
+	paginator := xray.NewGetGroupsPaginator(svc, input, func(o *xray.GetGroupsPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_xray_group.listXRayGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, group := range output.Groups {
+			d.StreamListItem(ctx, group)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getXRayResourceTags is shared by the X-Ray group and sampling rule tables
+func getXRayResourceTags(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var arn *string
+	switch item := h.Item.(type) {
+	case types.GroupSummary:
+		arn = item.GroupARN
+	case types.SamplingRuleRecord:
+		if item.SamplingRule != nil {
+			arn = item.SamplingRule.RuleARN
+		}
+	}
+	if arn == nil {
+		return nil, nil
+	}
+
+	// Create Session
+	svc, err := XRayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getXRayResourceTags", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &xray.ListTagsForResourceInput{
+		ResourceARN: aws.String(*arn),
+	}
+
+	paginator := xray.NewListTagsForResourcePaginator(svc, input, func(o *xray.ListTagsForResourcePaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	var tags []types.Tag
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("getXRayResourceTags", "api_error", err)
+			return nil, err
+		}
+		tags = append(tags, output.Tags...)
+	}
+
+	return tags, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func xrayTagListToTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tagList, ok := d.Value.([]types.Tag)
+	if !ok || len(tagList) == 0 {
+		return nil, nil
+	}
+
+	// Mapping the resource tags inside turbotTags
+	turbotTagsMap := map[string]string{}
+	for _, i := range tagList {
+		turbotTagsMap[*i.Key] = aws.ToString(i.Value)
+	}
+
+	return turbotTagsMap, nil
+}
@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAwsXRaySamplingRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_sampling_rule",
+		Description: "AWS X-Ray Sampling Rule",
+		List: &plugin.ListConfig{
+			Hydrate: listXRaySamplingRules,
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "rule_name",
+				Description: "The name of the sampling rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleName"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the sampling rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleARN"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the sampling rule. Rules are evaluated in ascending order of priority.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.Priority"),
+			},
+			{
+				Name:        "fixed_rate",
+				Description: "The percentage of matching requests to instrument, after the reservoir is exhausted.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SamplingRule.FixedRate"),
+			},
+			{
+				Name:        "reservoir_size",
+				Description: "A fixed number of matching requests to instrument per second, prior to applying the fixed rate.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.ReservoirSize"),
+			},
+			{
+				Name:        "service_name",
+				Description: "Matches the name that the service uses to identify itself in segments.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ServiceName"),
+			},
+			{
+				Name:        "service_type",
+				Description: "Matches the origin that the service uses to identify its type in segments.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ServiceType"),
+			},
+			{
+				Name:        "host",
+				Description: "Matches the hostname from a request URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.Host"),
+			},
+			{
+				Name:        "http_method",
+				Description: "Matches the HTTP method of a request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.HTTPMethod"),
+			},
+			{
+				Name:        "url_path",
+				Description: "Matches the path from a request URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.URLPath"),
+			},
+			{
+				Name:        "resource_arn",
+				Description: "Matches the ARN of the Amazon Web Services resource on which the service runs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.ResourceARN"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the sampling rule format.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SamplingRule.Version"),
+			},
+			{
+				Name:        "created_at",
+				Description: "When the rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified_at",
+				Description: "When the rule was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "attributes",
+				Description: "Matches attributes derived from the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SamplingRule.Attributes"),
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags associated with the sampling rule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayResourceTags,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SamplingRule.RuleName"),
+			},
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getXRayResourceTags,
+				Transform:   transform.FromValue().Transform(xrayTagListToTurbotTags),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SamplingRule.RuleARN").Transform(transform.EnsureStringArray),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRaySamplingRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := XRayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_xray_sampling_rule.listXRaySamplingRules", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	input := &xray.GetSamplingRulesInput{}
+
+	paginator := xray.NewGetSamplingRulesPaginator(svc, input, func(o *xray.GetSamplingRulesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_xray_sampling_rule.listXRaySamplingRules", "api_error", err)
+			return nil, err
+		}
+
+		for _, rule := range output.SamplingRuleRecords {
+			d.StreamListItem(ctx, rule)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
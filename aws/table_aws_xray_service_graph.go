@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type xrayServiceGraphInfo = struct {
+	types.Service
+	QueryStartTime time.Time
+	QueryEndTime   time.Time
+}
+
+//// TABLE DEFINITION
+
+func tableAwsXRayServiceGraph(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_service_graph",
+		Description: "AWS X-Ray Service Graph",
+		List: &plugin.ListConfig{
+			Hydrate: listXRayServiceGraph,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "group_name", Require: plugin.Optional},
+				{Name: "start_time", Require: plugin.Optional},
+				{Name: "end_time", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The canonical name of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reference_id",
+				Description: "Identifier for the service. Unique within the service map.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "type",
+				Description: "The type of service, e.g. AWS::EC2::Instance for an application running on EC2, or client for clients that called the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The service's state.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "root",
+				Description: "Indicates that the service was the first service to process a request.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "service_account_id",
+				Description: "Identifier of the Amazon Web Services account in which the service runs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountId"),
+			},
+			{
+				Name:        "names",
+				Description: "A list of names for the service, including the canonical name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "summary_statistics",
+				Description: "Aggregated statistics for the service, including request, error and fault counts and total response time.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "edges",
+				Description: "Connections to downstream services, with their aggregated statistics.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "duration_histogram",
+				Description: "A histogram that maps the spread of service durations.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "response_time_histogram",
+				Description: "A histogram that maps the spread of service response times.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Inputs to the table
+			{
+				Name:        "group_name",
+				Description: "The name of the group the service graph was generated for. Defaults to the Default group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("group_name"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start of the time frame for which to generate the graph. Defaults to one hour before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueryStartTime"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time frame for which to generate the graph. Defaults to the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueryEndTime"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRayServiceGraph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := XRayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_xray_service_graph.listXRayServiceGraph", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	equalQuals := d.KeyColumnQuals
+
+	endTime := time.Now()
+	if equalQuals["end_time"] != nil {
+		endTime = equalQuals["end_time"].GetTimestampValue().AsTime()
+	}
+	startTime := endTime.Add(-1 * time.Hour)
+	if equalQuals["start_time"] != nil {
+		startTime = equalQuals["start_time"].GetTimestampValue().AsTime()
+	}
+
+	input := &xray.GetServiceGraphInput{
+		StartTime: aws.Time(startTime),
+		EndTime:   aws.Time(endTime),
+	}
+	if equalQuals["group_name"] != nil {
+		input.GroupName = aws.String(equalQuals["group_name"].GetStringValue())
+	}
+
+	paginator := xray.NewGetServiceGraphPaginator(svc, input, func(o *xray.GetServiceGraphPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_xray_service_graph.listXRayServiceGraph", "api_error", err)
+			return nil, err
+		}
+
+		for _, service := range output.Services {
+			d.StreamListItem(ctx, xrayServiceGraphInfo{service, startTime, endTime})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
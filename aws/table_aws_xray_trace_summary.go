@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type xrayTraceSummaryInfo = struct {
+	types.TraceSummary
+	QueryStartTime time.Time
+	QueryEndTime   time.Time
+}
+
+//// TABLE DEFINITION
+
+func tableAwsXRayTraceSummary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_xray_trace_summary",
+		Description: "AWS X-Ray Trace Summary",
+		List: &plugin.ListConfig{
+			Hydrate: listXRayTraceSummaries,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "filter_expression", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "time_range_type", Require: plugin.Optional},
+				{Name: "start_time", Require: plugin.Optional},
+				{Name: "end_time", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItemFunc: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The unique identifier for the request that generated the trace's segments and subsegments.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "duration",
+				Description: "The length of time in seconds between the start time of the root segment and the end time of the last segment that completed.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "response_time",
+				Description: "The length of time in seconds between the start and end times of the root segment.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "has_error",
+				Description: "The root segment or a subsegment of the trace recorded a client error (HTTP 4XX).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_fault",
+				Description: "The root segment or a subsegment of the trace recorded a server error (HTTP 5XX).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_throttle",
+				Description: "One or more segments of the trace recorded a throttling error (HTTP 429).",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_partial",
+				Description: "One or more of the segment documents of the trace are in progress.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "matched_event_time",
+				Description: "The matched time stamp of a defined event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "http_url",
+				Description: "The request URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Http.HttpURL"),
+			},
+			{
+				Name:        "http_method",
+				Description: "The request method.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Http.HttpMethod"),
+			},
+			{
+				Name:        "http_status",
+				Description: "The response status.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Http.HttpStatus"),
+			},
+			{
+				Name:        "client_ip",
+				Description: "The IP address of the requestor.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Http.ClientIp"),
+			},
+			{
+				Name:        "user_agent",
+				Description: "The request's user agent string.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Http.UserAgent"),
+			},
+			{
+				Name:        "revision",
+				Description: "The revision number of a trace.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "entry_point",
+				Description: "The root of a trace.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "annotations",
+				Description: "Annotations from the trace's segment documents.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "service_ids",
+				Description: "Service IDs from the trace's segment documents.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_arns",
+				Description: "A list of resource ARNs for any resource corresponding to the trace segments.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceARNs"),
+			},
+			{
+				Name:        "instance_ids",
+				Description: "A list of EC2 instance IDs for any instance corresponding to the trace segments.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "availability_zones",
+				Description: "A list of Availability Zones for any zone corresponding to the trace segments.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "users",
+				Description: "Users from the trace's segment documents.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "error_root_causes",
+				Description: "A collection of error root causes for the trace.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "fault_root_causes",
+				Description: "A collection of fault root causes for the trace.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "response_time_root_causes",
+				Description: "A collection of response time root causes for the trace.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Inputs to the table
+			{
+				Name:        "filter_expression",
+				Description: "The filter expression used to select traces.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter_expression"),
+			},
+			{
+				Name:        "time_range_type",
+				Description: "A parameter to indicate whether to query trace summaries by TraceId or Event time. Defaults to TraceId.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("time_range_type"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start of the time frame for which to retrieve traces. Defaults to one hour before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueryStartTime"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time frame for which to retrieve traces. Defaults to the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueryEndTime"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listXRayTraceSummaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create Session
+	svc, err := XRayClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("aws_xray_trace_summary.listXRayTraceSummaries", "connection_error", err)
+		return nil, err
+	}
+	if svc == nil {
+		// Unsupported region, return no data
+		return nil, nil
+	}
+
+	equalQuals := d.KeyColumnQuals
+
+	endTime := time.Now()
+	if equalQuals["end_time"] != nil {
+		endTime = equalQuals["end_time"].GetTimestampValue().AsTime()
+	}
+	startTime := endTime.Add(-1 * time.Hour)
+	if equalQuals["start_time"] != nil {
+		startTime = equalQuals["start_time"].GetTimestampValue().AsTime()
+	}
+
+	input := &xray.GetTraceSummariesInput{
+		StartTime: aws.Time(startTime),
+		EndTime:   aws.Time(endTime),
+	}
+	if equalQuals["filter_expression"] != nil {
+		input.FilterExpression = aws.String(equalQuals["filter_expression"].GetStringValue())
+	}
+	if equalQuals["time_range_type"] != nil {
+		input.TimeRangeType = types.TimeRangeType(equalQuals["time_range_type"].GetStringValue())
+	}
+
+	paginator := xray.NewGetTraceSummariesPaginator(svc, input, func(o *xray.GetTraceSummariesPaginatorOptions) {
+		o.StopOnDuplicateToken = true
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("aws_xray_trace_summary.listXRayTraceSummaries", "api_error", err)
+			return nil, err
+		}
+
+		for _, summary := range output.TraceSummaries {
+			d.StreamListItem(ctx, xrayTraceSummaryInfo{summary, startTime, endTime})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
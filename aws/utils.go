@@ -57,6 +57,30 @@ func extractNameFromSqsQueueURL(queue string) (string, error) {
 	return segments[2], nil
 }
 
+func extractRegionFromSqsQueueURL(queue string) (string, error) {
+	//https://sqs.us-west-2.amazonaws.com/123456789012/queueName
+	//https://us-west-2.queue.amazonaws.com/123456789012/queueName (legacy)
+	//https://queue.amazonaws.com/123456789012/queueName (legacy, us-east-1)
+	u, err := url.Parse(queue)
+	if err != nil {
+		return "", err
+	}
+	host := u.Hostname()
+	switch {
+	case host == "queue.amazonaws.com":
+		return "us-east-1", nil
+	case strings.HasSuffix(host, ".queue.amazonaws.com"):
+		return strings.TrimSuffix(host, ".queue.amazonaws.com"), nil
+	case strings.HasPrefix(host, "sqs."):
+		segments := strings.Split(host, ".")
+		if len(segments) > 2 {
+			return segments[1], nil
+		}
+	}
+
+	return "", fmt.Errorf("SQS Url not parsed correctly")
+}
+
 func handleNilString(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	value := types.SafeString(fmt.Sprintf("%v", d.Value))
 	if value == "" {
@@ -192,3 +216,40 @@ func getQualsValueByColumn(equalQuals plugin.KeyColumnQualMap, columnName string
 	}
 	return value
 }
+
+// pollUntilTerminal calls describe until it reports that an asynchronous
+// operation has finished or returns an error, backing off from 250ms to 5s
+// between calls. If the timeout passes or the context is cancelled first,
+// cancel is called so the operation does not keep running in AWS.
+func pollUntilTerminal(ctx context.Context, timeout time.Duration, describe func() (done bool, err error), cancel func()) error {
+	interval := 250 * time.Millisecond
+	maxInterval := 5 * time.Second
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := describe()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			cancel()
+			return fmt.Errorf("did not complete within %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -1,26 +1,36 @@
 module github.com/turbot/steampipe-plugin-aws
 
-go 1.19
+go 1.23
 
 require (
-	github.com/aws/aws-sdk-go v1.44.150
-	github.com/aws/aws-sdk-go-v2 v1.17.1
-	github.com/aws/aws-sdk-go-v2/config v1.17.8
-	github.com/aws/aws-sdk-go-v2/credentials v1.12.21
+	github.com/aws/aws-sdk-go v1.55.8
+	github.com/aws/aws-sdk-go-v2 v1.41.0
+	github.com/aws/aws-sdk-go-v2/config v1.32.5
+	github.com/aws/aws-sdk-go-v2/credentials v1.19.5
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.16.0
-	github.com/aws/aws-sdk-go-v2/service/account v1.7.8
+	github.com/aws/aws-sdk-go-v2/service/account v1.24.0
 	github.com/aws/aws-sdk-go-v2/service/acm v1.14.8
+	github.com/aws/aws-sdk-go-v2/service/amp v1.25.4
 	github.com/aws/aws-sdk-go-v2/service/amplify v1.11.18
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.15.10
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.12.8
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.13.7
+	github.com/aws/aws-sdk-go-v2/service/appflow v1.46.2
 	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.15.18
+	github.com/aws/aws-sdk-go-v2/service/applicationdiscoveryservice v1.29.0
+	github.com/aws/aws-sdk-go-v2/service/appmesh v1.29.3
+	github.com/aws/aws-sdk-go-v2/service/appsync v1.15.1
+	github.com/aws/aws-sdk-go-v2/service/athena v1.16.0
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.20.4
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.23.10
-	github.com/aws/aws-sdk-go-v2/service/backup v1.18.0
+	github.com/aws/aws-sdk-go-v2/service/backup v1.34.2
+	github.com/aws/aws-sdk-go-v2/service/billingconductor v1.24.0
+	github.com/aws/aws-sdk-go-v2/service/budgets v1.42.3
+	github.com/aws/aws-sdk-go-v2/service/cloud9 v1.24.4
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.10.13
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.22.10
-	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.20.0
+	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.35.4
+	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.4.4
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.13.19
 	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.16.8
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.21.6
@@ -29,69 +39,106 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/codebuild v1.19.13
 	github.com/aws/aws-sdk-go-v2/service/codecommit v1.13.17
 	github.com/aws/aws-sdk-go-v2/service/codedeploy v1.14.16
+	github.com/aws/aws-sdk-go-v2/service/codeguruprofiler v1.20.4
+	github.com/aws/aws-sdk-go-v2/service/codegurureviewer v1.25.4
 	github.com/aws/aws-sdk-go-v2/service/codepipeline v1.13.15
+	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.17.21
 	github.com/aws/aws-sdk-go-v2/service/configservice v1.28.0
-	github.com/aws/aws-sdk-go-v2/service/costexplorer v1.19.2
+	github.com/aws/aws-sdk-go-v2/service/costandusagereportservice v1.29.2
+	github.com/aws/aws-sdk-go-v2/service/costexplorer v1.43.3
 	github.com/aws/aws-sdk-go-v2/service/databasemigrationservice v1.21.10
+	github.com/aws/aws-sdk-go-v2/service/dataexchange v1.34.3
+	github.com/aws/aws-sdk-go-v2/service/datapipeline v1.26.2
+	github.com/aws/aws-sdk-go-v2/service/datasync v1.36.4
 	github.com/aws/aws-sdk-go-v2/service/dax v1.11.15
+	github.com/aws/aws-sdk-go-v2/service/devopsguru v1.20.1
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.14.11
 	github.com/aws/aws-sdk-go-v2/service/dlm v1.12.4
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.19.11
-	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.15.9
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.72.1
+	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.1.10
+	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.53.5
+	github.com/aws/aws-sdk-go-v2/service/dynamodbstreams v1.13.5
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.75.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.17.16
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.13.15
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.18.19
 	github.com/aws/aws-sdk-go-v2/service/efs v1.17.15
 	github.com/aws/aws-sdk-go-v2/service/eks v1.22.1
-	github.com/aws/aws-sdk-go-v2/service/elasticache v1.22.10
+	github.com/aws/aws-sdk-go-v2/service/elasticache v1.34.6
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.14.18
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.14.12
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.18.12
 	github.com/aws/aws-sdk-go-v2/service/elasticsearchservice v1.16.10
 	github.com/aws/aws-sdk-go-v2/service/emr v1.20.11
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.16.15
+	github.com/aws/aws-sdk-go-v2/service/evidently v1.24.2
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.14.19
 	github.com/aws/aws-sdk-go-v2/service/fsx v1.24.14
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.13.17
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.15.2
-	github.com/aws/aws-sdk-go-v2/service/glue v1.32.0
+	github.com/aws/aws-sdk-go-v2/service/glue v1.104.1
+	github.com/aws/aws-sdk-go-v2/service/grafana v1.27.2
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.40.0
 	github.com/aws/aws-sdk-go-v2/service/guardduty v1.15.9
 	github.com/aws/aws-sdk-go-v2/service/health v1.15.22
 	github.com/aws/aws-sdk-go-v2/service/iam v1.18.9
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.15.5
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.12.15
+	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.0.0
+	github.com/aws/aws-sdk-go-v2/service/iot v1.25.4
+	github.com/aws/aws-sdk-go-v2/service/ivs v1.43.2
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.17.15
+	github.com/aws/aws-sdk-go-v2/service/kafkaconnect v1.27.16
 	github.com/aws/aws-sdk-go-v2/service/kinesis v1.15.19
 	github.com/aws/aws-sdk-go-v2/service/kinesisanalyticsv2 v1.14.18
 	github.com/aws/aws-sdk-go-v2/service/kinesisvideo v1.12.14
 	github.com/aws/aws-sdk-go-v2/service/kms v1.18.11
+	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.31.5
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.26.0
+	github.com/aws/aws-sdk-go-v2/service/licensemanager v1.37.4
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.23.0
 	github.com/aws/aws-sdk-go-v2/service/macie2 v1.23.4
+	github.com/aws/aws-sdk-go-v2/service/marketplaceagreement v1.0.0
+	github.com/aws/aws-sdk-go-v2/service/marketplaceentitlementservice v1.24.0
+	github.com/aws/aws-sdk-go-v2/service/mediaconvert v1.73.0
+	github.com/aws/aws-sdk-go-v2/service/medialive v1.24.2
+	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.35.2
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.12.17
-	github.com/aws/aws-sdk-go-v2/service/neptune v1.17.12
+	github.com/aws/aws-sdk-go-v2/service/memorydb v1.19.8
+	github.com/aws/aws-sdk-go-v2/service/migrationhub v1.25.0
+	github.com/aws/aws-sdk-go-v2/service/mq v1.13.3
+	github.com/aws/aws-sdk-go-v2/service/neptune v1.28.1
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.20.0
+	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.31.3
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.10.10
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.16.8
 	github.com/aws/aws-sdk-go-v2/service/pinpoint v1.17.10
+	github.com/aws/aws-sdk-go-v2/service/pipes v1.0.2
 	github.com/aws/aws-sdk-go-v2/service/pricing v1.16.8
+	github.com/aws/aws-sdk-go-v2/service/qldb v1.14.8
+	github.com/aws/aws-sdk-go-v2/service/quicksight v1.86.0
 	github.com/aws/aws-sdk-go-v2/service/ram v1.16.18
-	github.com/aws/aws-sdk-go-v2/service/rds v1.26.1
+	github.com/aws/aws-sdk-go-v2/service/rds v1.66.1
 	github.com/aws/aws-sdk-go-v2/service/redshift v1.26.10
+	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.20.5
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.2.9
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.13.19
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.24.0
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.12.17
 	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.15.19
+	github.com/aws/aws-sdk-go-v2/service/rum v1.24.2
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.21.9
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.48.0
+	github.com/aws/aws-sdk-go-v2/service/savingsplans v1.31.1
+	github.com/aws/aws-sdk-go-v2/service/scheduler v1.0.2
+	github.com/aws/aws-sdk-go-v2/service/schemas v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.16.2
 	github.com/aws/aws-sdk-go-v2/service/securityhub v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/securitylake v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/serverlessapplicationrepository v1.11.17
+	github.com/aws/aws-sdk-go-v2/service/servicecatalog v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/servicequotas v1.13.18
 	github.com/aws/aws-sdk-go-v2/service/ses v1.14.18
 	github.com/aws/aws-sdk-go-v2/service/sfn v1.14.1
@@ -99,18 +146,26 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.19.10
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.30.0
 	github.com/aws/aws-sdk-go-v2/service/ssoadmin v1.15.11
-	github.com/aws/aws-sdk-go-v2/service/sts v1.16.19
+	github.com/aws/aws-sdk-go-v2/service/storagegateway v1.30.1
+	github.com/aws/aws-sdk-go-v2/service/sts v1.41.5
+	github.com/aws/aws-sdk-go-v2/service/swf v1.28.3
+	github.com/aws/aws-sdk-go-v2/service/synthetics v1.34.0
+	github.com/aws/aws-sdk-go-v2/service/timestreamquery v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/trustedadvisor v1.13.17
 	github.com/aws/aws-sdk-go-v2/service/waf v1.11.17
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.12.18
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.22.9
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.16.11
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.23.0
-	github.com/aws/smithy-go v1.13.4
+	github.com/aws/aws-sdk-go-v2/service/xray v1.36.16
+	github.com/aws/smithy-go v1.24.0
 	github.com/gocarina/gocsv v0.0.0-20201208093247-67c824bc04d4
 	github.com/golang/protobuf v1.5.2
 	github.com/turbot/go-kit v0.4.0
 	github.com/turbot/steampipe-plugin-sdk/v4 v4.1.8
 	golang.org/x/text v0.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -120,18 +175,19 @@ require (
 	github.com/allegro/bigcache/v3 v3.0.2 // indirect
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.8 // indirect
-	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.12.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.25 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.19 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.24 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.0.14 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.9.3 // indirect
+	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.18.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.4.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.7.16 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.4 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.5 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.13.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.1.9 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.7.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.19 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.11.16 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.13.16 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.13.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/sso v1.11.23 // indirect
-	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.13.6 // indirect
+	github.com/aws/aws-sdk-go-v2/service/signin v1.0.4 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sso v1.30.7 // indirect
+	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.35.12 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/bradfitz/gomemcache v0.0.0-20220106215444-fb4bf637b56d // indirect
 	github.com/btubbs/datetime v0.1.1 // indirect